@@ -168,6 +168,7 @@ func (c *ChainBase) addExistingBlock(node *blockcache.BlockCacheNode, replay boo
 		c.bCache.AddNodeToWAL(node)
 	}
 	c.bCache.UpdateLib(node)
+	c.indexIrreversibleBlocks()
 	// After UpdateLib, the block head active witness list will be right
 	// So AddLinkedNode need execute after UpdateLib
 	c.txPool.AddLinkedNode(node)
@@ -235,3 +236,21 @@ func (c *ChainBase) verifyBlock(blk, parent *block.Block, witnessList *blockcach
 		TxTimeLimit: common.MaxTxTimeLimit,
 	})
 }
+
+// indexIrreversibleBlocks feeds the irreversible blocks which are not indexed yet to the indexers.
+func (c *ChainBase) indexIrreversibleBlocks() {
+	lib := c.bCache.LinkedRoot().Head.Number
+	for _, indexer := range c.indexers {
+		for num := indexer.Height(); num <= lib; num++ {
+			blk, err := c.bChain.GetBlockByNumber(num)
+			if err != nil {
+				ilog.Warnf("Get block %v for %T failed: %v", num, indexer, err)
+				break
+			}
+			if err := indexer.Index(blk); err != nil {
+				ilog.Warnf("Index block %v by %T failed: %v", num, indexer, err)
+				break
+			}
+		}
+	}
+}
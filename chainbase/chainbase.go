@@ -7,6 +7,8 @@ import (
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/core/block"
 	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/core/txindex"
 	"github.com/iost-official/go-iost/v3/core/txpool"
 	"github.com/iost-official/go-iost/v3/db"
 	"github.com/iost-official/go-iost/v3/ilog"
@@ -18,14 +20,20 @@ type ChainBase struct {
 	bChain  block.Chain
 	bCache  blockcache.BlockCache
 	stateDB db.MVCCDB
+	archive *db.Archive
+	txIndex *txindex.Indexer
+	events  *event.Store
 	txPool  txpool.TxPool
+	clock   *common.Clock
+
+	indexers []blockIndexer
 
 	quitCh chan struct{}
 	done   *sync.WaitGroup
 }
 
-// New will return a ChainBase.
-func New(conf *common.Config) (*ChainBase, error) {
+// New will return a ChainBase. The tx pool and the rpc tell the time by clock, which is the local time if it is nil.
+func New(conf *common.Config, clock *common.Clock) (*ChainBase, error) {
 	bChain, err := block.NewBlockChain(conf.DB.LdbPath + "BlockChainDB")
 	if err != nil {
 		return nil, fmt.Errorf("new blockchain failed, stop the program. err: %v", err)
@@ -36,14 +44,53 @@ func New(conf *common.Config) (*ChainBase, error) {
 		return nil, fmt.Errorf("new statedb failed, stop the program. err: %v", err)
 	}
 
+	var archive *db.Archive
+	if conf.DB.Archive {
+		archive, err = db.NewArchive(conf.DB.LdbPath+"ArchiveDB", stateDB)
+		if err != nil {
+			return nil, fmt.Errorf("new archive failed, stop the program. err: %v", err)
+		}
+	}
+
+	var txIndex *txindex.Indexer
+	if conf.DB.TxIndex {
+		txIndex, err = txindex.NewIndexer(conf.DB.LdbPath + "TxIndexDB")
+		if err != nil {
+			return nil, fmt.Errorf("new tx index failed, stop the program. err: %v", err)
+		}
+	}
+
+	var events *event.Store
+	if conf.DB.EventStore {
+		events, err = event.NewStore(conf.DB.LdbPath + "EventDB")
+		if err != nil {
+			return nil, fmt.Errorf("new event store failed, stop the program. err: %v", err)
+		}
+		// the blocks in the chain before the event store was enabled, or imported from a snapshot, have no recorded
+		// contract events
+		if err := events.Begin(bChain.Length()); err != nil {
+			return nil, fmt.Errorf("begin event store failed, stop the program. err: %v", err)
+		}
+	}
+
 	c := &ChainBase{
 		config:  conf,
 		bChain:  bChain,
 		stateDB: stateDB,
+		archive: archive,
+		txIndex: txIndex,
+		events:  events,
+		clock:   clock,
 
 		quitCh: make(chan struct{}),
 		done:   new(sync.WaitGroup),
 	}
+	if txIndex != nil {
+		c.indexers = append(c.indexers, txIndex)
+	}
+	if events != nil {
+		c.indexers = append(c.indexers, &eventIndexer{events, bChain})
+	}
 	if conf.SPV != nil && conf.SPV.IsSPV {
 		if bChain.Length() == 0 {
 			syncFrom := conf.SPV.SyncFromBlock
@@ -76,17 +123,26 @@ func New(conf *common.Config) (*ChainBase, error) {
 	}
 	c.bCache = bCache
 
-	txPool, err := txpool.NewTxPoolImpl(bChain, bCache)
+	txPool, err := txpool.NewTxPoolImpl(bChain, bCache, conf.TxPool, clock)
 	if err != nil {
 		return nil, fmt.Errorf("initialize txpool failed: %v", err)
 	}
 	c.txPool = txPool
+	c.indexers = append(c.indexers, txPool.DeferServer())
 
 	if err := c.recoverBlockCache(); err != nil {
 		return nil, fmt.Errorf("recover chainbase failed: %v", err)
 	}
 	ilog.Info("recover block cache done")
 
+	if conf.TxPool != nil && conf.TxPool.Journal {
+		if err := txPool.LoadJournal(conf.DB.LdbPath + txpool.TxPoolWALDir); err != nil {
+			return nil, fmt.Errorf("load txpool journal failed: %v", err)
+		}
+	}
+
+	c.indexIrreversibleBlocks()
+
 	c.done.Add(1)
 	go c.metricsController()
 
@@ -100,6 +156,15 @@ func (c *ChainBase) Close() {
 
 	c.txPool.Close()
 	c.stateDB.Close()
+	if c.archive != nil {
+		c.archive.Close()
+	}
+	if c.txIndex != nil {
+		c.txIndex.Close()
+	}
+	if c.events != nil {
+		c.events.Close()
+	}
 	c.bChain.Close()
 
 	ilog.Infof("Closed chainbase.")
@@ -112,6 +177,26 @@ func (c *ChainBase) StateDB() db.MVCCDB {
 	return c.stateDB
 }
 
+// Archive return the state archive, it is nil if the archive mode is disabled.
+func (c *ChainBase) Archive() *db.Archive {
+	return c.archive
+}
+
+// TxIndex return the account transaction index, it is nil if the index is disabled.
+func (c *ChainBase) TxIndex() *txindex.Indexer {
+	return c.txIndex
+}
+
+// EventStore return the store of durable events, it is nil if the store is disabled.
+func (c *ChainBase) EventStore() *event.Store {
+	return c.events
+}
+
+// Clock return the clock of the chain.
+func (c *ChainBase) Clock() *common.Clock {
+	return c.clock
+}
+
 // BlockChain return the block chain database.
 func (c *ChainBase) BlockChain() block.Chain {
 	return c.bChain
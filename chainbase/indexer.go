@@ -0,0 +1,74 @@
+package chainbase
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/core/tx"
+)
+
+// blockIndexer is fed with the irreversible blocks in order.
+type blockIndexer interface {
+	Height() int64
+	Index(blk *block.Block) error
+}
+
+// eventIndexer stores the durable events of the blocks.
+type eventIndexer struct {
+	*event.Store
+	bChain block.Chain
+}
+
+func (e *eventIndexer) Index(blk *block.Block) error {
+	events, metas, err := blockEvents(blk, e.bChain)
+	if err != nil {
+		return err
+	}
+	return e.Append(blk.Head.Number, events, metas)
+}
+
+// blockEvents returns the durable events of the block: the contract receipts and then the contract events
+// of each successful tx in order, followed by the IrreversibleBlock event of the block itself.
+func blockEvents(blk *block.Block, bChain block.Chain) ([]*event.Event, []*event.Meta, error) {
+	events := make([]*event.Event, 0)
+	metas := make([]*event.Meta, 0)
+	add := func(topic event.Topic, rec *tx.Receipt, publisher string) {
+		events = append(events, &event.Event{
+			Topic: topic,
+			Data:  rec.Content,
+			Time:  blk.Head.Time,
+		})
+		fn := strings.SplitN(rec.FuncName, "/", 2)
+		actionName := ""
+		if len(fn) > 1 {
+			actionName = fn[1]
+		}
+		metas = append(metas, event.NewReceiptMeta(fn[0], actionName, rec.Content, publisher))
+	}
+	for i, r := range blk.Receipts {
+		if r.Status == nil || r.Status.Code != tx.Success {
+			continue
+		}
+		for _, rec := range r.Receipts {
+			add(event.ContractReceipt, rec, blk.Txs[i].Publisher)
+		}
+		contractEvents, err := bChain.GetContractEventsByTxHash(blk.Txs[i].Hash())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, rec := range contractEvents {
+			add(event.ContractEvent, rec, blk.Txs[i].Publisher)
+		}
+	}
+	data, _ := json.Marshal(blockcache.NewBlockEventData(blk))
+	events = append(events, &event.Event{
+		Topic: event.IrreversibleBlock,
+		Data:  string(data),
+		Time:  blk.Head.Time,
+	})
+	metas = append(metas, &event.Meta{Witness: blk.Head.Witness})
+	return events, metas, nil
+}
@@ -0,0 +1,77 @@
+package chainbase
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestEventIndexer(t *testing.T) {
+	convey.Convey("Test of event indexer", t, func() {
+		dir := t.TempDir()
+		bChain, err := block.NewBlockChain(filepath.Join(dir, "BlockChainDB"))
+		convey.So(err, convey.ShouldBeNil)
+		defer bChain.Close()
+		store, err := event.NewStore(filepath.Join(dir, "EventDB"))
+		convey.So(err, convey.ShouldBeNil)
+		defer store.Close()
+		indexer := &eventIndexer{store, bChain}
+
+		blk0 := &block.Block{Head: &block.BlockHead{Number: 0, Witness: "w0"}}
+		blk0.CalculateHeadHash()
+		convey.So(bChain.Push(blk0), convey.ShouldBeNil)
+		convey.So(indexer.Index(blk0), convey.ShouldBeNil)
+		evs, _, err := store.Read(&event.Cursor{}, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(evs), convey.ShouldEqual, 1)
+		cursor := evs[0].Cursor
+
+		// more contract events than the subscriber channel can hold
+		total := event.EventChSize + 10
+		t0 := tx.NewTx([]*tx.Action{{Contract: "Contractabc", ActionName: "deposit", Data: "[]"}}, nil, 1000000, 100, time.Now().UnixNano()+int64(time.Minute), 0, 0)
+		t0.Publisher = "user0"
+		r0 := tx.NewTxReceipt(t0.Hash())
+		ec := event.GetCollector()
+		ch := ec.Subscribe(1, []event.Topic{event.ContractEvent}, nil)
+		defer ec.Unsubscribe(1, []event.Topic{event.ContractEvent})
+		for i := 0; i < total; i++ {
+			data := fmt.Sprintf(`{"n":%d}`, i)
+			r0.Events = append(r0.Events, &tx.Receipt{FuncName: "Contractabc/deposit", Content: data})
+			ec.Post(event.NewEvent(event.ContractEvent, data), &event.Meta{ContractID: "Contractabc"})
+		}
+		for i := 0; i < 100 && len(ch) < event.EventChSize; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+		time.Sleep(50 * time.Millisecond)
+		convey.So(len(ch), convey.ShouldEqual, event.EventChSize)
+
+		blk1 := &block.Block{
+			Head:     &block.BlockHead{Number: 1, ParentHash: blk0.HeadHash(), Witness: "w0"},
+			Txs:      []*tx.Tx{t0},
+			Receipts: []*tx.TxReceipt{r0},
+		}
+		blk1.CalculateHeadHash()
+		convey.So(bChain.Push(blk1), convey.ShouldBeNil)
+		convey.So(indexer.Index(blk1), convey.ShouldBeNil)
+
+		// the events dropped by the live path are replayed from the cursor without gaps
+		evs, metas, err := store.Read(cursor, 1000)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(evs), convey.ShouldEqual, total+1)
+		for i := 0; i < total; i++ {
+			convey.So(evs[i].Topic, convey.ShouldEqual, event.ContractEvent)
+			convey.So(evs[i].Data, convey.ShouldEqual, fmt.Sprintf(`{"n":%d}`, i))
+			convey.So(evs[i].Cursor, convey.ShouldResemble, &event.Cursor{BlockNumber: 1, Index: int32(i + 1)})
+			convey.So(metas[i].ContractID, convey.ShouldEqual, "Contractabc")
+			convey.So(metas[i].ActionName, convey.ShouldEqual, "deposit")
+			convey.So(metas[i].Publisher, convey.ShouldEqual, "user0")
+		}
+		convey.So(evs[total].Topic, convey.ShouldEqual, event.IrreversibleBlock)
+	})
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"io"
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
@@ -8,6 +9,7 @@ import (
 	"syscall"
 
 	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/consensus/snapshot"
 	"github.com/iost-official/go-iost/v3/core/global"
 	"github.com/iost-official/go-iost/v3/core/version"
 	"github.com/iost-official/go-iost/v3/ilog"
@@ -17,8 +19,11 @@ import (
 )
 
 var (
-	configFile = flag.StringP("config", "f", "", "Configuration `file`")
-	help       = flag.BoolP("help", "h", false, "Display available options")
+	configFile     = flag.StringP("config", "f", "", "Configuration `file`")
+	exportSnapshot = flag.String("export_snapshot", "", "Export the state at the latest irreversible block to the `file` (- for stdout) and exit, the server must be stopped")
+	importSnapshot = flag.String("import_snapshot", "", "Import the state from the snapshot `file` (- for stdin) into an empty data directory and exit")
+	snapshotHash   = flag.String("snapshot_hash", "", "Manifest `hash` the imported or fast synced snapshot must have, which should come from a trusted source")
+	help           = flag.BoolP("help", "h", false, "Display available options")
 )
 
 func initMetrics(metricsConfig *common.MetricsConfig) error {
@@ -54,6 +59,61 @@ func initLogger(logConfig *common.LogConfig) {
 	ilog.InitLogger(logger)
 }
 
+func runExportSnapshot(conf *common.Config, file string) error {
+	w := io.Writer(os.Stdout)
+	if file != "-" {
+		f, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	m, err := snapshot.Export(conf, w)
+	if err != nil {
+		return err
+	}
+	hash, err := m.Hash()
+	if err != nil {
+		return err
+	}
+	ilog.Infof("Exported snapshot of block %v with %v entries, manifest hash %v",
+		m.Block.Head.Number, m.EntryNumber, common.Base58Encode(hash))
+	return nil
+}
+
+func runImportSnapshot(conf *common.Config, file string, hash string) error {
+	r := io.Reader(os.Stdin)
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	if hash == "" {
+		ilog.Warnf("No snapshot hash given, the snapshot is only checked against its own manifest")
+	}
+	m, err := snapshot.Import(conf, r, hash)
+	if err != nil {
+		return err
+	}
+	ilog.Infof("Imported snapshot of block %v with %v entries", m.Block.Head.Number, m.EntryNumber)
+	return nil
+}
+
+// initSnapshot imports the snapshot of the config if the node has no state yet.
+func initSnapshot(conf *common.Config) error {
+	if conf.Snapshot == nil || !conf.Snapshot.Enable {
+		return nil
+	}
+	if _, err := os.Stat(conf.DB.LdbPath + "StateDB"); err == nil {
+		return nil
+	}
+	return runImportSnapshot(conf, conf.Snapshot.FilePath, *snapshotHash)
+}
+
 func main() {
 	flag.Parse()
 	if *help {
@@ -73,8 +133,29 @@ func main() {
 	global.SetGlobalConf(conf)
 	version.InitChainConf(conf)
 
+	if *exportSnapshot == "-" {
+		// the snapshot is written to stdout, so are the console logs
+		if conf.Log == nil {
+			conf.Log = &common.LogConfig{}
+		}
+		conf.Log.ConsoleLog = nil
+	}
 	initLogger(conf.Log)
 
+	if *exportSnapshot != "" || *importSnapshot != "" {
+		var err error
+		if *exportSnapshot != "" {
+			err = runExportSnapshot(conf, *exportSnapshot)
+		} else {
+			err = runImportSnapshot(conf, *importSnapshot, *snapshotHash)
+		}
+		if err != nil {
+			ilog.Fatalf("snapshot failed. err=%v", err)
+		}
+		ilog.Stop()
+		return
+	}
+
 	ilog.Infof("Config Information:\n%v", strings.ReplaceAll(conf.YamlString(), conf.ACC.SecKey, conf.ACC.SecKey[:3]+"******"))
 
 	ilog.Infof("build time:%v", global.BuildTime)
@@ -86,6 +167,14 @@ func main() {
 		ilog.Errorf("init metrics failed. err=%v", err)
 	}
 
+	if *snapshotHash != "" && conf.Snapshot != nil {
+		conf.Snapshot.FastSyncHash = *snapshotHash
+	}
+	err = initSnapshot(conf)
+	if err != nil {
+		ilog.Fatalf("import snapshot failed. err=%v", err)
+	}
+
 	server := iserver.New(conf)
 	err = server.Start()
 	if err != nil {
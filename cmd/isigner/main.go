@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"golang.org/x/term"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/isigner"
+	"github.com/iost-official/go-iost/v3/sdk"
+)
+
+var (
+	listen     = flag.StringP("listen", "l", "127.0.0.1:30010", "listen `address` of the signer")
+	keystore   = flag.StringP("keystore", "k", "", "`directory` of the account json files whose keys are used to sign")
+	policyFile = flag.StringP("policy", "p", "config/isigner.yml", "policy `file`")
+	help       = flag.BoolP("help", "h", false, "Display available options")
+)
+
+// The password of encrypted keys and the token of requests are read from env instead of flags.
+const (
+	passwordEnv = "ISIGNER_PASSWORD"
+	tokenEnv    = "ISIGNER_TOKEN"
+)
+
+func readPassword() ([]byte, error) {
+	if pw, ok := os.LookupEnv(passwordEnv); ok {
+		return []byte(pw), nil
+	}
+	fmt.Fprint(os.Stderr, "Enter Password:  ")
+	pw, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	return pw, err
+}
+
+func loadKeys(dir string) ([]*account.KeyPair, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var password []byte
+	passwordRead := false
+	keys := make([]*account.KeyPair, 0)
+	for _, f := range files {
+		a, err := sdk.LoadAccountFrom(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %v: %v", f, err)
+		}
+		for perm, kp := range a.Keypairs {
+			if kp.EncryptedKey != "" {
+				if !passwordRead {
+					password, err = readPassword()
+					if err != nil {
+						return nil, err
+					}
+					passwordRead = true
+				}
+				if err := kp.Decrypt(password); err != nil {
+					return nil, fmt.Errorf("failed to decrypt %v@%v in %v: %v", a.Name, perm, f, err)
+				}
+			}
+			if kp.RawKey == "" {
+				continue
+			}
+			key, err := kp.ToKeyPair()
+			if err != nil {
+				return nil, fmt.Errorf("invalid key %v@%v in %v: %v", a.Name, perm, f, err)
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func main() {
+	flag.Parse()
+	if *help || *keystore == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	policy, err := isigner.LoadPolicy(*policyFile)
+	if err != nil {
+		ilog.Fatalf("Failed to load policy: %v", err)
+	}
+	keys, err := loadKeys(*keystore)
+	if err != nil {
+		ilog.Fatalf("Failed to load keys: %v", err)
+	}
+	token := os.Getenv(tokenEnv)
+	if token == "" && !strings.HasPrefix(*listen, "127.0.0.1:") && !strings.HasPrefix(*listen, "localhost:") {
+		ilog.Warnf("The signer listens on %v without %v set, anyone reaching it can request signatures", *listen, tokenEnv)
+	}
+
+	server := isigner.NewServer(keys, policy, token)
+	ilog.Infof("Signer listening on %v with %v keys", *listen, len(keys))
+	s := &http.Server{
+		Addr:              *listen,
+		Handler:           server.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	err = s.ListenAndServe()
+	if err != nil {
+		ilog.Fatalf("Signer stopped: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"google.golang.org/grpc"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/ilog"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/spv"
+)
+
+var (
+	server      = flag.StringP("server", "s", "localhost:30002", "grpc `address` of the full node to follow, which is not trusted")
+	listen      = flag.StringP("listen", "l", "127.0.0.1:30012", "listen `address` of the verified http api")
+	checkpoint  = flag.StringP("checkpoint", "c", "ispv_checkpoint.json", "`file` keeping the trusted checkpoint")
+	blockNumber = flag.Int64("block_number", 0, "`number` of the trusted vote block to start from, when there is no checkpoint")
+	blockHash   = flag.String("block_hash", "", "`hash` of the trusted vote block to start from, when there is no checkpoint")
+	cacheSize   = flag.Int("cache", int(common.VoteInterval), "`number` of the latest verified headers kept in memory")
+	interval    = flag.Duration("interval", 3*time.Second, "`interval` of following the full node")
+	help        = flag.BoolP("help", "h", false, "Display available options")
+)
+
+func main() {
+	flag.Parse()
+	if *help {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	conn, err := grpc.Dial(*server, grpc.WithInsecure())
+	if err != nil {
+		ilog.Fatalf("Failed to dial %v: %v", *server, err)
+	}
+	defer conn.Close()
+
+	client := spv.NewClient(rpcpb.NewApiServiceClient(conn), *checkpoint, *cacheSize)
+	err = client.Start(*blockNumber, *blockHash)
+	if err == spv.ErrNoCheckpoint {
+		ilog.Fatalf("No checkpoint in %v, start with --block_number and --block_hash of a vote block from a trusted source", *checkpoint)
+	}
+	if err != nil {
+		ilog.Fatalf("Failed to start the light client: %v", err)
+	}
+	go client.Run(context.Background(), *interval)
+
+	ilog.Infof("Light client of %v listening on %v", *server, *listen)
+	s := &http.Server{
+		Addr:              *listen,
+		Handler:           spv.NewServer(client).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	err = s.ListenAndServe()
+	if err != nil {
+		ilog.Fatalf("Light client stopped: %v", err)
+	}
+}
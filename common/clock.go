@@ -0,0 +1,32 @@
+package common
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Clock tells the time of a chain to its tx pool and rpc, which is the local time unless the clock is shifted.
+// A nil clock is the local time. Only a local development chain shifts its clock to travel in time, consensus
+// always uses the local time.
+type Clock struct {
+	// offset is added to the local time, in nanoseconds
+	offset int64
+}
+
+// Now returns the time of the clock.
+func (c *Clock) Now() time.Time {
+	return time.Now().Add(c.Offset())
+}
+
+// Offset returns how far the clock is shifted from the local time.
+func (c *Clock) Offset() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return time.Duration(atomic.LoadInt64(&c.offset))
+}
+
+// SetOffset shifts the clock from the local time.
+func (c *Clock) SetOffset(d time.Duration) {
+	atomic.StoreInt64(&c.offset, int64(d))
+}
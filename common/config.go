@@ -52,7 +52,11 @@ type GenesisConfig struct {
 
 // DBConfig config of the database
 type DBConfig struct {
-	LdbPath string
+	LdbPath    string
+	Archive    bool
+	TxIndex    bool
+	EventStore bool
+	StateDiff  bool
 }
 
 // VMConfig config of the v8vm
@@ -115,10 +119,26 @@ type MetricsConfig struct {
 	ID       string
 }
 
-// SnapshotConfig is the config of snapshot
+// SnapshotConfig is the config of snapshot. An empty node imports the snapshot file if Enable is set, or fast syncs
+// the state from the snapshot served by FastSyncQuorum peers if FastSync is set. Zero values use the default values.
 type SnapshotConfig struct {
-	Enable   bool
-	FilePath string
+	Enable         bool
+	FilePath       string
+	FastSync       bool
+	FastSyncQuorum int
+	// FastSyncHash is the manifest hash the fast synced snapshot must have, which should come from a trusted source.
+	// It is required if the block of the snapshot does not commit to the state root.
+	FastSyncHash string
+	// Serve serves a snapshot of the state every ServeInterval blocks to the fast syncing peers.
+	Serve         bool
+	ServeInterval int64
+}
+
+// TxPoolConfig is the config of the tx pool. Zero values use the default limits.
+type TxPoolConfig struct {
+	MaxSize         int
+	MaxPerPublisher int
+	Journal         bool
 }
 
 // DebugConfig is the config of debug.
@@ -145,6 +165,7 @@ type Config struct {
 	VM       *VMConfig
 	DB       *DBConfig
 	Snapshot *SnapshotConfig
+	TxPool   *TxPoolConfig
 	P2P      *P2PConfig
 	RPC      *RPCConfig
 	Log      *LogConfig
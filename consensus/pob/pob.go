@@ -56,7 +56,11 @@ func New(conf *common.Config, cBase *chainbase.ChainBase, p2pService p2p.Service
 	accSecKey := conf.ACC.SecKey
 	accAlgo := conf.ACC.Algorithm
 
-	producerKeypair, err := account.NewKeyPair(common.Base58Decode(accSecKey), crypto.NewAlgorithm(accAlgo))
+	algo, err := crypto.ParseAlgorithm(accAlgo)
+	if err != nil {
+		ilog.Fatalf("Unknown algorithm of the producer, stop the program! err:%v", err)
+	}
+	producerKeypair, err := account.NewKeyPair(common.Base58Decode(accSecKey), algo)
 	if err != nil {
 		ilog.Fatalf("NewKeyPair failed, stop the program! err:%v", err)
 	}
@@ -0,0 +1,333 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/p2p"
+)
+
+// DefaultFastSyncQuorum is the default number of the peers which must serve the same snapshot to fast sync from it.
+const DefaultFastSyncQuorum = 3
+
+var (
+	manifestRequestInterval = 3 * time.Second
+	checkpointTimeout       = 10 * time.Minute
+	chunkTimeout            = 30 * time.Second
+)
+
+const (
+	maxRequestsPerPeer = 2
+	maxBufferedChunks  = 32
+	maxChunkTimeouts   = 3
+
+	// the scores of the peers by the manifests and chunks they send
+	goodChunkScore    = 1
+	timeoutChunkScore = -10
+	badChunkScore     = -50
+	badManifestScore  = -50
+)
+
+// Errors of fast sync.
+var (
+	ErrNoQuorum = errors.New("no snapshot is served by the quorum of peers")
+	ErrNoPeer   = errors.New("no peer serves the snapshot")
+	ErrNoHash   = errors.New("the snapshot hash is required to fast sync to a block without the state root")
+)
+
+type chunkRequest struct {
+	peer     p2p.PeerID
+	deadline time.Time
+}
+
+type fastSync struct {
+	p      p2p.Service
+	msgCh  chan p2p.IncomingMessage
+	quorum int
+	hash   string
+}
+
+// FastSync imports the state of the latest snapshot served by the quorum of peers into the data directory of the
+// config, which must not have the state db or the block chain db yet. The peers with the same ip count as one in the
+// quorum. The chunks are downloaded from these peers in parallel and verified one by one, the peers sending invalid
+// data are scored down by the p2p service. If the hash is not empty, only the snapshot with the manifest hash is
+// accepted, and one peer serving it is enough. The hash is required if the block of the snapshot does not commit to
+// the state root, since nothing but the peers vouches for the state then.
+//
+// The blocks after the snapshot are synced as usual once the node starts from the imported state.
+func FastSync(conf *common.Config, p p2p.Service, hash string) (*Manifest, error) {
+	fs := &fastSync{
+		p:      p,
+		quorum: DefaultFastSyncQuorum,
+		hash:   hash,
+	}
+	if conf.Snapshot != nil && conf.Snapshot.FastSyncQuorum > 0 {
+		fs.quorum = conf.Snapshot.FastSyncQuorum
+	}
+	if hash != "" {
+		fs.quorum = 1
+	}
+	fs.msgCh = p.Register("snapshot fast sync", p2p.SnapshotManifestResponse, p2p.SnapshotChunkResponse)
+	defer p.Deregister("snapshot fast sync", p2p.SnapshotManifestResponse, p2p.SnapshotChunkResponse)
+
+	m, mHash, peers, err := fs.checkpoint()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTrust(m, hash); err != nil {
+		return nil, err
+	}
+	ilog.Infof("Fast syncing the state of block %v from %v peers, %v chunks, manifest hash %v",
+		m.Block.Head.Number, len(peers), len(m.ChunkHashes), common.Base58Encode(mHash))
+
+	im, err := NewImporter(conf, m)
+	if err != nil {
+		return nil, err
+	}
+	defer im.Close()
+	if err := fs.download(im, m, mHash, peers); err != nil {
+		return nil, err
+	}
+	return m, im.Finish()
+}
+
+// checkTrust refuses the snapshot of a block without the state root unless its manifest hash is given.
+func checkTrust(m *Manifest, hash string) error {
+	if hash == "" && m.Block.Head.Version < block.V2 {
+		return ErrNoHash
+	}
+	return nil
+}
+
+// checkpoint asks the peers for their snapshots until one is served by the quorum of peers, and returns its manifest,
+// manifest hash and peers. The latest one is chosen if there are several.
+func (fs *fastSync) checkpoint() (*Manifest, []byte, []p2p.PeerID, error) {
+	manifests := make(map[string]*Manifest)
+	votes := make(map[string]map[p2p.PeerID]bool)
+	rejected := make(map[string]bool)
+
+	deadline := time.After(checkpointTimeout)
+	ticker := time.NewTicker(manifestRequestInterval)
+	defer ticker.Stop()
+	fs.p.Broadcast(nil, p2p.SnapshotManifestRequest, p2p.NormalMessage)
+	for {
+		select {
+		case msg := <-fs.msgCh:
+			if msg.Type() != p2p.SnapshotManifestResponse || len(msg.Data()) == 0 {
+				continue
+			}
+			hash := common.Sha3(msg.Data())
+			if fs.hash != "" && common.Base58Encode(hash) != fs.hash {
+				continue
+			}
+			key := string(hash)
+			if rejected[key] {
+				fs.p.AdjustPeerScore(msg.From(), badManifestScore)
+				continue
+			}
+			if manifests[key] == nil {
+				m := &Manifest{}
+				if err := proto.Unmarshal(msg.Data(), m); err != nil {
+					fs.p.AdjustPeerScore(msg.From(), badManifestScore)
+					continue
+				}
+				if _, err := VerifyManifest(m); err != nil {
+					ilog.Warnf("Invalid snapshot manifest from %v: %v", msg.From().Pretty(), err)
+					rejected[key] = true
+					fs.p.AdjustPeerScore(msg.From(), badManifestScore)
+					continue
+				}
+				manifests[key] = m
+				votes[key] = make(map[p2p.PeerID]bool)
+			}
+			votes[key][msg.From()] = true
+		case <-ticker.C:
+			var best string
+			ips := fs.peerIPs()
+			for key, peers := range votes {
+				if quorumOf(peers, ips) < fs.quorum {
+					continue
+				}
+				if best == "" || manifests[key].Block.Head.Number > manifests[best].Block.Head.Number {
+					best = key
+				}
+			}
+			if best != "" {
+				peers := make([]p2p.PeerID, 0, len(votes[best]))
+				for peer := range votes[best] {
+					peers = append(peers, peer)
+				}
+				return manifests[best], []byte(best), peers, nil
+			}
+			ilog.Infof("Waiting for %v peers serving the same snapshot, %v snapshots found.", fs.quorum, len(manifests))
+			fs.p.Broadcast(nil, p2p.SnapshotManifestRequest, p2p.NormalMessage)
+		case <-deadline:
+			return nil, nil, nil, ErrNoQuorum
+		}
+	}
+}
+
+// peerIPs returns the ip addresses of the neighbors by their ids.
+func (fs *fastSync) peerIPs() map[string]string {
+	ips := make(map[string]string)
+	for _, peer := range fs.p.GetAllNeighbors() {
+		ips[peer.ID()] = peer.IP()
+	}
+	return ips
+}
+
+// quorumOf returns the number of the distinct hosts of the peers. Peers with the same ip count as one, since peer
+// ids cost nothing to create.
+func quorumOf(peers map[p2p.PeerID]bool, ips map[string]string) int {
+	hosts := make(map[string]bool)
+	for peer := range peers {
+		if ip := ips[peer.Pretty()]; ip != "" {
+			hosts["ip/"+ip] = true
+		} else {
+			hosts["id/"+string(peer)] = true
+		}
+	}
+	return len(hosts)
+}
+
+// pickPeer returns the peer with the fewest pending requests, and the best score if there are several.
+func (fs *fastSync) pickPeer(peers []p2p.PeerID, pending map[int64]*chunkRequest) (p2p.PeerID, bool) {
+	requests := make(map[p2p.PeerID]int)
+	for _, req := range pending {
+		requests[req.peer]++
+	}
+	var best p2p.PeerID
+	found := false
+	for _, peer := range peers {
+		if requests[peer] >= maxRequestsPerPeer {
+			continue
+		}
+		if !found || requests[peer] < requests[best] ||
+			(requests[peer] == requests[best] && fs.p.PeerScore(peer) > fs.p.PeerScore(best)) {
+			best = peer
+			found = true
+		}
+	}
+	return best, found
+}
+
+func (fs *fastSync) request(peer p2p.PeerID, hash []byte, index int64) {
+	req, err := proto.Marshal(&ChunkRequest{
+		ManifestHash: hash,
+		Index:        index,
+	})
+	if err != nil {
+		ilog.Errorf("Marshal chunk request failed: %v", err)
+		return
+	}
+	fs.p.SendToPeer(peer, req, p2p.SnapshotChunkRequest, p2p.NormalMessage)
+}
+
+func removePeer(peers []p2p.PeerID, peer p2p.PeerID) []p2p.PeerID {
+	ret := make([]p2p.PeerID, 0, len(peers))
+	for _, p := range peers {
+		if p != peer {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+// download requests the chunks from the peers in parallel, and adds them to the importer in order. The chunks timed
+// out or invalid are requested again from the other peers.
+func (fs *fastSync) download(im *Importer, m *Manifest, hash []byte, peers []p2p.PeerID) error {
+	total := int64(len(m.ChunkHashes))
+	pending := make(map[int64]*chunkRequest)
+	received := make(map[int64][]byte)
+	timeouts := make(map[p2p.PeerID]int)
+	retry := make([]int64, 0)
+	var next, added int64
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for added < total {
+		for len(peers) > 0 {
+			index := int64(-1)
+			if len(retry) > 0 {
+				index = retry[0]
+			} else if next < total && next < added+maxBufferedChunks {
+				index = next
+			}
+			if index < 0 {
+				break
+			}
+			peer, ok := fs.pickPeer(peers, pending)
+			if !ok {
+				break
+			}
+			if len(retry) > 0 {
+				retry = retry[1:]
+			} else {
+				next++
+			}
+			fs.request(peer, hash, index)
+			pending[index] = &chunkRequest{peer: peer, deadline: time.Now().Add(chunkTimeout)}
+		}
+		if len(peers) == 0 {
+			return ErrNoPeer
+		}
+
+		select {
+		case msg := <-fs.msgCh:
+			if msg.Type() != p2p.SnapshotChunkResponse {
+				continue
+			}
+			resp := &ChunkResponse{}
+			if err := proto.Unmarshal(msg.Data(), resp); err != nil || !bytes.Equal(resp.ManifestHash, hash) {
+				continue
+			}
+			req := pending[resp.Index]
+			if req == nil || req.peer != msg.From() {
+				continue
+			}
+			delete(pending, resp.Index)
+			if _, err := VerifyChunk(m, int(resp.Index), resp.Chunk); err != nil {
+				ilog.Warnf("Invalid chunk %v from %v: %v", resp.Index, msg.From().Pretty(), err)
+				fs.p.AdjustPeerScore(msg.From(), badChunkScore)
+				peers = removePeer(peers, msg.From())
+				retry = append(retry, resp.Index)
+				continue
+			}
+			fs.p.AdjustPeerScore(msg.From(), goodChunkScore)
+			received[resp.Index] = resp.Chunk
+		case <-ticker.C:
+			now := time.Now()
+			for index, req := range pending {
+				if now.Before(req.deadline) {
+					continue
+				}
+				delete(pending, index)
+				retry = append(retry, index)
+				fs.p.AdjustPeerScore(req.peer, timeoutChunkScore)
+				timeouts[req.peer]++
+				if timeouts[req.peer] >= maxChunkTimeouts {
+					ilog.Warnf("Peer %v timed out %v times, stop downloading from it.", req.peer.Pretty(), timeouts[req.peer])
+					peers = removePeer(peers, req.peer)
+				}
+			}
+		}
+
+		for b, ok := received[added]; ok; b, ok = received[added] {
+			if err := im.Add(b); err != nil {
+				return err
+			}
+			delete(received, added)
+			added++
+			if added%100 == 0 {
+				ilog.Infof("Fast sync imported %v/%v chunks.", added, total)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package snapshot
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	blockpb "github.com/iost-official/go-iost/v3/core/block/pb"
+	"github.com/iost-official/go-iost/v3/db"
+	"github.com/iost-official/go-iost/v3/p2p"
+)
+
+// fakeNet delivers the messages between the fake nodes in memory.
+type fakeNet struct {
+	mu    sync.Mutex
+	nodes map[p2p.PeerID]*fakeNode
+}
+
+func (n *fakeNet) newNode(id string) *fakeNode {
+	node := &fakeNode{
+		net:    n,
+		id:     p2p.PeerID(id),
+		subs:   make(map[p2p.MessageType]chan p2p.IncomingMessage),
+		scores: make(map[p2p.PeerID]int),
+	}
+	n.mu.Lock()
+	n.nodes[node.id] = node
+	n.mu.Unlock()
+	return node
+}
+
+// fakeNode is a p2p service connected to all the other nodes. A node tampering sends invalid chunks.
+type fakeNode struct {
+	net    *fakeNet
+	id     p2p.PeerID
+	subs   map[p2p.MessageType]chan p2p.IncomingMessage
+	scores map[p2p.PeerID]int
+	tamper bool
+}
+
+func (n *fakeNode) Start() error                 { return nil }
+func (n *fakeNode) Stop()                        {}
+func (n *fakeNode) ID() string                   { return string(n.id) }
+func (n *fakeNode) ConnectBPs([]string)          {}
+func (n *fakeNode) PutPeerToBlack(string)        {}
+func (n *fakeNode) GetAllNeighbors() []*p2p.Peer { return nil }
+
+func (n *fakeNode) AdjustPeerScore(id p2p.PeerID, delta int) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+	n.scores[id] += delta
+}
+
+func (n *fakeNode) PeerScore(id p2p.PeerID) int {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+	return n.scores[id]
+}
+
+func (n *fakeNode) Broadcast(data []byte, typ p2p.MessageType, mp p2p.MessagePriority) {
+	n.net.mu.Lock()
+	ids := make([]p2p.PeerID, 0, len(n.net.nodes))
+	for id := range n.net.nodes {
+		if id != n.id {
+			ids = append(ids, id)
+		}
+	}
+	n.net.mu.Unlock()
+	for _, id := range ids {
+		n.SendToPeer(id, data, typ, mp)
+	}
+}
+
+func (n *fakeNode) SendToPeer(id p2p.PeerID, data []byte, typ p2p.MessageType, mp p2p.MessagePriority) {
+	if n.tamper && typ == p2p.SnapshotChunkResponse {
+		resp := &ChunkResponse{}
+		if err := proto.Unmarshal(data, resp); err == nil {
+			resp.Chunk[len(resp.Chunk)-1] ^= 1
+			data, _ = proto.Marshal(resp)
+		}
+	}
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+	to := n.net.nodes[id]
+	if to == nil || to.subs[typ] == nil {
+		return
+	}
+	select {
+	case to.subs[typ] <- *p2p.NewIncomingMessage(n.id, data, typ):
+	default:
+	}
+}
+
+func (n *fakeNode) Register(id string, typs ...p2p.MessageType) chan p2p.IncomingMessage {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+	c := make(chan p2p.IncomingMessage, 1024)
+	for _, typ := range typs {
+		n.subs[typ] = c
+	}
+	return c
+}
+
+func (n *fakeNode) Deregister(id string, typs ...p2p.MessageType) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+	for _, typ := range typs {
+		delete(n.subs, typ)
+	}
+}
+
+func servedNumber(s *Server) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.served)
+}
+
+func TestFastSync(t *testing.T) {
+	manifestRequestInterval, chunkTimeout = 100*time.Millisecond, 2*time.Second
+	defer func() {
+		manifestRequestInterval, chunkTimeout = 3*time.Second, 30*time.Second
+	}()
+	Convey("Test of fast sync", t, func() {
+		dir := t.TempDir()
+		src := newConfig(filepath.Join(dir, "src"))
+		So(newNode(src, 3000, 2048), ShouldBeNil)
+		want, wantTag, err := states(src)
+		So(err, ShouldBeNil)
+		stateDB, err := db.NewMVCCDB(src.DB.LdbPath + "StateDB")
+		So(err, ShouldBeNil)
+		defer stateDB.Close()
+		bChain, err := block.NewBlockChain(src.DB.LdbPath + "BlockChainDB")
+		So(err, ShouldBeNil)
+		defer bChain.Close()
+
+		net := &fakeNet{nodes: make(map[p2p.PeerID]*fakeNode)}
+		honest := net.newNode("honest")
+		tampering := net.newNode("tampering")
+		tampering.tamper = true
+		client := net.newNode("client")
+
+		// the tampering server serves the same snapshot taken directly
+		bad := NewServer(stateDB, bChain, tampering, 100)
+		So(bad.Start(), ShouldBeNil)
+		defer bad.Stop()
+		snap, err := db.NewSnapshot(stateDB)
+		So(err, ShouldBeNil)
+		So(bad.serve(snap), ShouldBeNil)
+
+		// the honest server takes the snapshot when the state of block 100 is flushed
+		good := NewServer(stateDB, bChain, honest, 100)
+		So(good.Start(), ShouldBeNil)
+		defer good.Stop()
+		So(stateDB.Flush(stateDB.CurrentTag()), ShouldBeNil)
+		for i := 0; i < 100 && servedNumber(good) == 0; i++ {
+			time.Sleep(100 * time.Millisecond)
+		}
+		So(servedNumber(good), ShouldEqual, 1)
+
+		Convey("fast sync with the quorum", func() {
+			dst := newConfig(filepath.Join(dir, "dst"))
+			dst.Snapshot = &common.SnapshotConfig{FastSync: true, FastSyncQuorum: 2}
+			m, err := FastSync(dst, client, "")
+			So(err, ShouldBeNil)
+			So(m.Block.Head.Number, ShouldEqual, 100)
+			So(len(m.ChunkHashes), ShouldEqual, 2)
+
+			got, gotTag, err := states(dst)
+			So(err, ShouldBeNil)
+			So(got, ShouldResemble, want)
+			So(gotTag, ShouldEqual, wantTag)
+
+			So(client.PeerScore(honest.id), ShouldBeGreaterThan, 0)
+			So(client.PeerScore(tampering.id), ShouldBeLessThan, 0)
+		})
+
+		Convey("fast sync with a trusted hash", func() {
+			checkpointTimeout = time.Second
+			defer func() {
+				checkpointTimeout = 10 * time.Minute
+			}()
+			hash := common.Base58Encode(good.served[0].hash)
+			dst := newConfig(filepath.Join(dir, "dst"))
+			dst.Snapshot = &common.SnapshotConfig{FastSync: true, FastSyncQuorum: 3}
+			_, err := FastSync(dst, client, common.Base58Encode(common.Sha3(good.served[0].hash)))
+			So(err, ShouldEqual, ErrNoQuorum)
+
+			m, err := FastSync(dst, client, hash)
+			So(err, ShouldBeNil)
+			So(m.Block.Head.Number, ShouldEqual, 100)
+			got, _, err := states(dst)
+			So(err, ShouldBeNil)
+			So(got, ShouldResemble, want)
+		})
+
+		Convey("chunk requests over the budget", func() {
+			defer func(n int) { chunkBudget = n }(chunkBudget)
+			chunkBudget = 3
+			ch := client.Register("test", p2p.SnapshotChunkResponse)
+			defer client.Deregister("test", p2p.SnapshotChunkResponse)
+			req, err := proto.Marshal(&ChunkRequest{ManifestHash: good.served[0].hash, Index: 0})
+			So(err, ShouldBeNil)
+			for i := 0; i < 5; i++ {
+				client.SendToPeer(honest.id, req, p2p.SnapshotChunkRequest, p2p.NormalMessage)
+			}
+			for i := 0; i < 3; i++ {
+				select {
+				case <-ch:
+				case <-time.After(5 * time.Second):
+					t.Fatal("chunk in the budget not served")
+				}
+			}
+			select {
+			case <-ch:
+				t.Fatal("chunk over the budget served")
+			case <-time.After(500 * time.Millisecond):
+			}
+			So(honest.PeerScore(client.id), ShouldEqual, 2*overBudgetScore)
+		})
+
+		Convey("fast sync without the quorum", func() {
+			checkpointTimeout = time.Second
+			defer func() {
+				checkpointTimeout = 10 * time.Minute
+			}()
+			dst := newConfig(filepath.Join(dir, "dst"))
+			dst.Snapshot = &common.SnapshotConfig{FastSync: true, FastSyncQuorum: 3}
+			_, err := FastSync(dst, client, "")
+			So(err, ShouldEqual, ErrNoQuorum)
+		})
+	})
+}
+
+func TestQuorumOf(t *testing.T) {
+	Convey("Test of quorum of peers", t, func() {
+		peers := map[p2p.PeerID]bool{"a": true, "b": true, "c": true, "d": true}
+		So(quorumOf(peers, nil), ShouldEqual, 4)
+		ips := map[string]string{
+			p2p.PeerID("a").Pretty(): "1.1.1.1",
+			p2p.PeerID("b").Pretty(): "1.1.1.1",
+			p2p.PeerID("c").Pretty(): "2.2.2.2",
+		}
+		So(quorumOf(peers, ips), ShouldEqual, 3)
+	})
+}
+
+func TestCheckTrust(t *testing.T) {
+	Convey("Test of the trust of snapshots", t, func() {
+		v1 := &Manifest{Block: &blockpb.Block{Head: &blockpb.BlockHead{Version: block.V1}}}
+		v2 := &Manifest{Block: &blockpb.Block{Head: &blockpb.BlockHead{Version: block.V2}}}
+		So(checkTrust(v1, ""), ShouldEqual, ErrNoHash)
+		So(checkTrust(v1, "hash"), ShouldBeNil)
+		So(checkTrust(v2, ""), ShouldBeNil)
+	})
+}
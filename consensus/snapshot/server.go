@@ -0,0 +1,310 @@
+package snapshot
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/db"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/p2p"
+)
+
+// DefaultServeInterval is the default number of blocks between the snapshots served.
+const DefaultServeInterval = 7200
+
+// maxServed is the number of the latest snapshots served, so the peers downloading the previous one can finish.
+const maxServed = 2
+
+const (
+	// chunkWorkers is the number of the goroutines reading the chunks requested.
+	chunkWorkers = 4
+	// overBudgetScore is the score of a peer requesting chunks over its budget.
+	overBudgetScore = -10
+)
+
+// A peer can request chunkBudget chunks in a budgetWindow, which is about 8MB/s, the requests over it are dropped.
+var (
+	chunkBudget  = 120
+	budgetWindow = time.Minute
+)
+
+// budget is the chunks a peer has requested in the current window.
+type budget struct {
+	start time.Time
+	used  int
+}
+
+// served is a snapshot served to the peers.
+type served struct {
+	manifest []byte
+	hash     []byte
+	stateDB  db.MVCCDB
+	starts   []string
+}
+
+// Server takes a snapshot of the state at every irreversible block whose number is a multiple of the interval, and
+// serves the latest snapshots to the fast syncing peers over p2p.
+//
+// The snapshot is a read only view of the state db taken when the state of the block is flushed, so the chunks are
+// read from it while the node goes on. A peer requesting chunks over its budget is dropped and scored down.
+type Server struct {
+	stateDB  db.MVCCDB
+	bChain   block.Chain
+	p        p2p.Service
+	interval int64
+
+	snapCh  chan db.MVCCDB
+	msgCh   chan p2p.IncomingMessage
+	chunkCh chan p2p.IncomingMessage
+	budgets map[p2p.PeerID]*budget
+
+	mu     sync.RWMutex
+	served []*served
+
+	quitCh chan struct{}
+	done   *sync.WaitGroup
+}
+
+// NewServer returns a snapshot server of the state db. The snapshots are taken every interval blocks, or
+// DefaultServeInterval blocks if interval is not positive.
+func NewServer(stateDB db.MVCCDB, bChain block.Chain, p p2p.Service, interval int64) *Server {
+	if interval <= 0 {
+		interval = DefaultServeInterval
+	}
+	return &Server{
+		stateDB:  stateDB,
+		bChain:   bChain,
+		p:        p,
+		interval: interval,
+		snapCh:   make(chan db.MVCCDB, 1),
+		chunkCh:  make(chan p2p.IncomingMessage, chunkWorkers),
+		budgets:  make(map[p2p.PeerID]*budget),
+		quitCh:   make(chan struct{}),
+		done:     new(sync.WaitGroup),
+	}
+}
+
+// Start starts taking and serving the snapshots.
+func (s *Server) Start() error {
+	if err := db.SetFlushHook(s.stateDB, s.flushed); err != nil {
+		return err
+	}
+	s.msgCh = s.p.Register("snapshot server", p2p.SnapshotManifestRequest, p2p.SnapshotChunkRequest)
+	s.done.Add(2 + chunkWorkers)
+	go s.buildController()
+	go s.handleRequestController()
+	for i := 0; i < chunkWorkers; i++ {
+		go s.chunkWorker()
+	}
+	return nil
+}
+
+// Stop stops the server, it should be stopped after the state db is no longer flushed.
+func (s *Server) Stop() {
+	if err := db.SetFlushHook(s.stateDB, nil); err != nil {
+		ilog.Warnf("Remove flush hook failed: %v", err)
+	}
+	s.p.Deregister("snapshot server", p2p.SnapshotManifestRequest, p2p.SnapshotChunkRequest)
+	close(s.quitCh)
+	s.done.Wait()
+
+	s.mu.Lock()
+	for _, sv := range s.served {
+		sv.stateDB.Close()
+	}
+	s.served = nil
+	s.mu.Unlock()
+	ilog.Infof("Stopped snapshot server.")
+}
+
+// flushed takes a snapshot if the state of a block at the interval is flushed. The block is pushed into the block
+// chain before its state is flushed, so it is the top of the chain.
+func (s *Server) flushed(tag string) {
+	number := s.bChain.Length() - 1
+	if number <= 0 || number%s.interval != 0 {
+		return
+	}
+	hash, err := s.bChain.GetHashByNumber(number)
+	if err != nil || string(hash) != tag {
+		return
+	}
+	snap, err := db.NewSnapshot(s.stateDB)
+	if err != nil {
+		ilog.Errorf("Take snapshot of block %v failed: %v", number, err)
+		return
+	}
+	select {
+	case s.snapCh <- snap:
+	default:
+		ilog.Warnf("Snapshot of block %v is skipped, the previous one is still being built.", number)
+		snap.Close()
+	}
+}
+
+func (s *Server) buildController() {
+	for {
+		select {
+		case snap := <-s.snapCh:
+			if err := s.serve(snap); err != nil {
+				ilog.Errorf("Build snapshot failed: %v", err)
+				snap.Close()
+			}
+		case <-s.quitCh:
+			select {
+			case snap := <-s.snapCh:
+				snap.Close()
+			default:
+			}
+			s.done.Done()
+			return
+		}
+	}
+}
+
+// serve builds the manifest of the snapshot and serves it in place of the oldest one.
+func (s *Server) serve(snap db.MVCCDB) error {
+	blk, err := s.bChain.GetBlockByHash([]byte(snap.CurrentTag()))
+	if err != nil {
+		return err
+	}
+	m, starts, err := newManifest(blk, snap, s.bChain)
+	if err != nil {
+		return err
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	sv := &served{
+		manifest: b,
+		hash:     common.Sha3(b),
+		stateDB:  snap,
+		starts:   starts,
+	}
+
+	s.mu.Lock()
+	s.served = append(s.served, sv)
+	if len(s.served) > maxServed {
+		s.served[0].stateDB.Close()
+		s.served = s.served[1:]
+	}
+	s.mu.Unlock()
+
+	ilog.Infof("Serving snapshot of block %v with %v chunks, manifest hash %v",
+		blk.Head.Number, len(m.ChunkHashes), common.Base58Encode(sv.hash))
+	return nil
+}
+
+func (s *Server) handleRequestController() {
+	for {
+		select {
+		case msg := <-s.msgCh:
+			switch msg.Type() {
+			case p2p.SnapshotManifestRequest:
+				s.handleManifestRequest(&msg)
+			case p2p.SnapshotChunkRequest:
+				if !s.allow(msg.From()) {
+					ilog.Debugf("Peer %v requests chunks over its budget.", msg.From().Pretty())
+					s.p.AdjustPeerScore(msg.From(), overBudgetScore)
+					continue
+				}
+				select {
+				case s.chunkCh <- msg:
+				case <-s.quitCh:
+					s.done.Done()
+					return
+				}
+			}
+		case <-s.quitCh:
+			s.done.Done()
+			return
+		}
+	}
+}
+
+// allow counts a chunk request of the peer, and returns whether it is in the budget of the peer.
+func (s *Server) allow(peer p2p.PeerID) bool {
+	now := time.Now()
+	b := s.budgets[peer]
+	if b == nil || now.Sub(b.start) >= budgetWindow {
+		for id, other := range s.budgets {
+			if now.Sub(other.start) >= budgetWindow {
+				delete(s.budgets, id)
+			}
+		}
+		b = &budget{start: now}
+		s.budgets[peer] = b
+	}
+	b.used++
+	return b.used <= chunkBudget
+}
+
+func (s *Server) chunkWorker() {
+	for {
+		select {
+		case msg := <-s.chunkCh:
+			s.handleChunkRequest(&msg)
+		case <-s.quitCh:
+			s.done.Done()
+			return
+		}
+	}
+}
+
+// handleManifestRequest responds the manifest of the latest snapshot, which is empty if there is no snapshot yet.
+func (s *Server) handleManifestRequest(msg *p2p.IncomingMessage) {
+	s.mu.RLock()
+	var manifest []byte
+	if len(s.served) > 0 {
+		manifest = s.served[len(s.served)-1].manifest
+	}
+	s.mu.RUnlock()
+	s.p.SendToPeer(msg.From(), manifest, p2p.SnapshotManifestResponse, p2p.NormalMessage)
+}
+
+func (s *Server) handleChunkRequest(msg *p2p.IncomingMessage) {
+	req := &ChunkRequest{}
+	if err := proto.Unmarshal(msg.Data(), req); err != nil {
+		ilog.Warnf("Unmarshal chunk request failed: %v", err)
+		return
+	}
+	// the lock is held while reading, so the snapshot is not closed as expired
+	s.mu.RLock()
+	var sv *served
+	for _, v := range s.served {
+		if bytes.Equal(v.hash, req.ManifestHash) {
+			sv = v
+		}
+	}
+	if sv == nil || req.Index < 0 || req.Index >= int64(len(sv.starts)) {
+		s.mu.RUnlock()
+		ilog.Debugf("Chunk %v of snapshot %v is not served.", req.Index, common.Base58Encode(req.ManifestHash))
+		return
+	}
+	c, _, err := readChunk(sv.stateDB, req.Index, sv.starts[req.Index])
+	s.mu.RUnlock()
+	if err != nil {
+		ilog.Errorf("Read chunk %v failed: %v", req.Index, err)
+		return
+	}
+	b, err := proto.Marshal(c)
+	if err != nil {
+		ilog.Errorf("Marshal chunk %v failed: %v", req.Index, err)
+		return
+	}
+	resp, err := proto.Marshal(&ChunkResponse{
+		ManifestHash: req.ManifestHash,
+		Index:        req.Index,
+		Chunk:        b,
+	})
+	if err != nil {
+		ilog.Errorf("Marshal chunk response failed: %v", err)
+		return
+	}
+	s.p.SendToPeer(msg.From(), resp, p2p.SnapshotChunkResponse, p2p.NormalMessage)
+}
@@ -1,184 +1,528 @@
+// Package snapshot exports and imports the state at an irreversible block in a format independent of the database.
+//
+// A snapshot is the magic followed by length prefixed protobuf messages: the Manifest, then the chunks of the state
+// sorted by the keys. The manifest records the block, its witness lists and the hash of every chunk, so a snapshot is
+// identified by the hash of its manifest and every chunk is verified on its own.
+//
+// Server serves the snapshots to the peers over p2p, and FastSync imports the snapshot served by a quorum of peers.
 package snapshot
 
 import (
-	"archive/tar"
 	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
-	"strings"
 
-	"compress/gzip"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/iost-official/go-iost/v3/common"
-	"github.com/iost-official/go-iost/v3/db/kv/leveldb"
+	"github.com/iost-official/go-iost/v3/core/block"
+	blockpb "github.com/iost-official/go-iost/v3/core/block/pb"
+	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	txpb "github.com/iost-official/go-iost/v3/core/tx/pb"
+	"github.com/iost-official/go-iost/v3/db"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/verifier"
+	"github.com/iost-official/go-iost/v3/vm/database"
 )
 
-/*
-// Save the function for saving block's head from snapshot.
-func Save(db db.MVCCDB, blk *block.Block) error {
-	bhJSON, err := json.Marshal(blk.Head)
-	if err != nil {
-		return fmt.Errorf("json fail: %v", err)
-	}
-	err = db.Put("snapshot", "blockHead", string(bhJSON))
+// Version is the version of the snapshot format.
+const Version = 1
+
+// ChunkSize is the size of the keys and values in a chunk, the last entry of a chunk may exceed it.
+const ChunkSize = 4 << 20
+
+const (
+	maxMessageSize = 64 << 20
+	keysPageSize   = 10000
+	// importingTag is the tag of the state db while importing, so a node never starts from a partly imported state.
+	importingTag = "importing snapshot"
+)
+
+var (
+	magic  = []byte("IOSTSNAP")
+	maxKey = string([]byte{0xff})
+)
+
+// Errors of snapshots.
+var (
+	ErrInvalidSnapshot = errors.New("invalid snapshot")
+	ErrManifestHash    = errors.New("manifest hash not match")
+	ErrChunkHash       = errors.New("chunk hash not match")
+	ErrStateRoot       = errors.New("state root not match")
+)
+
+// Hash returns the hash of the manifest, which identifies the snapshot.
+func (m *Manifest) Hash() ([]byte, error) {
+	b, err := proto.Marshal(m)
 	if err != nil {
-		return fmt.Errorf("state db put fail: %v", err)
+		return nil, err
 	}
-	return nil
+	return common.Sha3(b), nil
+}
+
+// NewManifest returns the manifest of the snapshot of the state db at the block. The outstanding delay txs of the
+// state are read from the chain.
+func NewManifest(blk *block.Block, stateDB db.MVCCDB, bChain block.Chain) (*Manifest, error) {
+	m, _, err := newManifest(blk, stateDB, bChain)
+	return m, err
 }
 
-// Load the function for loading block's head from snapshot.
-func Load(db db.MVCCDB) (*block.Block, error) {
-	bhJSON, err := db.Get("snapshot", "blockHead")
+// newManifest returns the manifest and the keys the chunks start from.
+func newManifest(blk *block.Block, stateDB db.MVCCDB, bChain block.Chain) (*Manifest, []string, error) {
+	if stateDB.CurrentTag() != string(blk.HeadHash()) {
+		return nil, nil, fmt.Errorf("state db is not at block %v", blk.Head.Number)
+	}
+	m := &Manifest{
+		Version: Version,
+		Block:   blk.ToPb(blockpb.BlockType_NORMAL),
+	}
+	vi := database.NewVisitor(0, stateDB, blk.Head.Rules())
+	pending, err := producerList(vi, "pendingProducerList")
 	if err != nil {
-		return nil, fmt.Errorf("get current block head from state db failed. err: %v", err)
+		return nil, nil, err
 	}
-	bh := &block.BlockHead{}
-	err = json.Unmarshal([]byte(bhJSON), bh)
+	current, err := producerList(vi, "currentProducerList")
 	if err != nil {
-		return nil, fmt.Errorf("block head decode failed. err: %v", err)
+		return nil, nil, err
 	}
-
-	blk := &block.Block{Head: bh}
-	return blk, blk.CalculateHeadHash()
-}
-*/
-
-// ToSnapshot the function for saving db to snapshot.
-func ToSnapshot(conf *common.Config) error {
-	src := filepath.Join(conf.DB.LdbPath, "StateDB")
-	if _, err := os.Stat(src); err != nil {
-		return fmt.Errorf("Unable to tar files - %v", err.Error())
+	m.PendingWitnessList = pending
+	m.ActiveWitnessList = activeWitness(blk, pending, current)
+	if m.ActiveWitnessList == nil {
+		return nil, nil, fmt.Errorf("witness %v of block %v is not in the producer lists", blk.Head.Witness, blk.Head.Number)
 	}
-
-	file, err := os.Create(filepath.Join(conf.DB.LdbPath, "Snapshot.tar.gz"))
+	m.DelayTxs, err = delayTxs(stateDB, bChain)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	defer file.Close()
-
-	gzw := gzip.NewWriter(file)
-	defer gzw.Close()
-
-	tw := tar.NewWriter(gzw)
-	defer tw.Close()
-
-	return filepath.Walk(src, func(file string, fi os.FileInfo, err error) error {
+	starts := make([]string, 0)
+	m.EntryNumber, err = chunks(stateDB, func(c *Chunk, from string) error {
+		b, err := proto.Marshal(c)
 		if err != nil {
 			return err
 		}
-		header, err := tar.FileInfoHeader(fi, fi.Name())
+		m.ChunkHashes = append(m.ChunkHashes, common.Sha3(b))
+		starts = append(starts, from)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return m, starts, nil
+}
+
+func producerList(vi *database.Visitor, key string) ([]string, error) {
+	jwl := database.MustUnmarshal(vi.Get("vote_producer.iost-" + key))
+	if jwl == nil {
+		return nil, fmt.Errorf("%v not found in the state", key)
+	}
+	result := make([]string, 0)
+	err := json.Unmarshal([]byte(jwl.(string)), &result)
+	return result, err
+}
+
+// delayTxs returns the outstanding delay txs of the state, whose bodies are kept by the chain only.
+func delayTxs(stateDB db.MVCCDB, bChain block.Chain) ([]*txpb.Tx, error) {
+	result := make([]*txpb.Tx, 0)
+	from := database.DelaytxStateKey("")
+	to := from[:len(from)-1] + string(from[len(from)-1]+1)
+	for {
+		keys, err := stateDB.KeysByRange(database.StateTable, from, to, keysPageSize)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		header.Name = strings.TrimPrefix(file, conf.DB.LdbPath)
-		if err := tw.WriteHeader(header); err != nil {
-			return err
+		for _, k := range keys {
+			hash := []byte(k[len(from):])
+			t, err := bChain.GetTx(hash)
+			if err != nil {
+				return nil, fmt.Errorf("delay tx %v not found in the chain: %v", common.Base58Encode(hash), err)
+			}
+			result = append(result, t.ToPb())
+		}
+		if len(keys) < keysPageSize {
+			return result, nil
 		}
-		if !fi.Mode().IsRegular() {
-			return nil
+		from = keys[len(keys)-1] + "\x00"
+	}
+}
+
+// activeWitness returns the producer list scheduling the witness of the block. The pending list of the state takes
+// effect a while after the vote, so the current list is still active until then.
+func activeWitness(blk *block.Block, pending, current []string) []string {
+	for _, l := range [][]string{pending, current} {
+		if len(l) > 0 && common.WitnessOfNanoSec(blk.Head.Time, l) == blk.Head.Witness {
+			return l
 		}
-		f, err := os.Open(file)
+	}
+	return nil
+}
+
+// readChunk returns the chunk of the index starting from the key, and the key the next chunk starts from, which is
+// empty after the last chunk.
+func readChunk(stateDB db.MVCCDB, index int64, from string) (*Chunk, string, error) {
+	c := &Chunk{Index: index}
+	size := 0
+	for {
+		keys, err := stateDB.KeysByRange(database.StateTable, from, maxKey, keysPageSize)
 		if err != nil {
-			return err
+			return nil, "", err
 		}
-		if _, err := io.Copy(tw, f); err != nil {
-			return err
+		for _, k := range keys {
+			if size >= ChunkSize {
+				return c, k, nil
+			}
+			v, err := stateDB.Get(database.StateTable, k)
+			if err != nil {
+				return nil, "", err
+			}
+			c.Entries = append(c.Entries, &Entry{Key: []byte(k), Value: []byte(v)})
+			size += len(k) + len(v)
 		}
-		f.Close()
-		return nil
-	})
+		if len(keys) < keysPageSize {
+			return c, "", nil
+		}
+		from = keys[len(keys)-1] + "\x00"
+	}
 }
 
-// FromSnapshot the function for loading db from snapshot.
-func FromSnapshot(conf *common.Config) error {
-	src := filepath.Join(conf.DB.LdbPath, "/StateDB")
+// chunks calls fn with the chunks of the state in order and the keys they start from, and returns the number of the
+// entries.
+func chunks(stateDB db.MVCCDB, fn func(c *Chunk, from string) error) (int64, error) {
+	var n int64
+	from := ""
+	for index := int64(0); ; index++ {
+		c, next, err := readChunk(stateDB, index, from)
+		if err != nil {
+			return 0, err
+		}
+		if len(c.Entries) == 0 {
+			return n, nil
+		}
+		if err := fn(c, from); err != nil {
+			return 0, err
+		}
+		n += int64(len(c.Entries))
+		if next == "" {
+			return n, nil
+		}
+		from = next
+	}
+}
 
-	s, err := os.Stat(src)
-	if err == nil && s.IsDir() {
-		return errors.New("state db already has")
+func writeMessage(w io.Writer, m proto.Message) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
 	}
-	err = os.MkdirAll(src, os.ModePerm)
+	var l [binary.MaxVarintLen64]byte
+	if _, err := w.Write(l[:binary.PutUvarint(l[:], uint64(len(b)))]); err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// readMessage returns the bytes of the next message.
+func readMessage(r *bufio.Reader) ([]byte, error) {
+	l, err := binary.ReadUvarint(r)
 	if err != nil {
+		return nil, err
+	}
+	if l > maxMessageSize {
+		return nil, fmt.Errorf("%v: message of %v bytes", ErrInvalidSnapshot, l)
+	}
+	b := make([]byte, l)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
+
+// Write writes the snapshot of the manifest with the state db.
+func Write(w io.Writer, m *Manifest, stateDB db.MVCCDB) error {
+	bw := bufio.NewWriterSize(w, 1<<20)
+	if _, err := bw.Write(magic); err != nil {
 		return err
 	}
-	fr, err := os.Open(conf.Snapshot.FilePath)
+	if err := writeMessage(bw, m); err != nil {
+		return err
+	}
+	_, err := chunks(stateDB, func(c *Chunk, _ string) error {
+		return writeMessage(bw, c)
+	})
 	if err != nil {
 		return err
 	}
-	defer fr.Close()
+	return bw.Flush()
+}
 
-	gr, err := gzip.NewReader(fr)
+// Export writes the snapshot of the state at the latest irreversible block in the data directory of the config.
+// The node should be stopped.
+func Export(conf *common.Config, w io.Writer) (*Manifest, error) {
+	bChain, err := block.NewBlockChain(conf.DB.LdbPath + "BlockChainDB")
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer gr.Close()
+	defer bChain.Close()
+	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	if err != nil {
+		return nil, err
+	}
+	defer stateDB.Close()
 
-	tr := tar.NewReader(gr)
+	blk, err := bChain.GetBlockByHash([]byte(stateDB.CurrentTag()))
+	if err != nil {
+		return nil, fmt.Errorf("block of the state db not found: %v", err)
+	}
+	m, err := NewManifest(blk, stateDB, bChain)
+	if err != nil {
+		return nil, err
+	}
+	return m, Write(w, m, stateDB)
+}
 
-	for {
-		h, err := tr.Next()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return err
+// VerifyManifest checks the block of the manifest is signed by its witness scheduled by the active witness list,
+// and returns the block.
+func VerifyManifest(m *Manifest) (*block.Block, error) {
+	if m.Version != Version {
+		return nil, fmt.Errorf("%v: version %v", ErrInvalidSnapshot, m.Version)
+	}
+	if m.Block == nil || m.Block.Head == nil {
+		return nil, fmt.Errorf("%v: block missing", ErrInvalidSnapshot)
+	}
+	blk := &block.Block{}
+	blk.FromPb(m.Block)
+	if err := blk.VerifySelf(); err != nil {
+		return nil, fmt.Errorf("%v: %v", ErrInvalidSnapshot, err)
+	}
+	if !bytes.Equal(blk.CalculateTxMerkleHash(), blk.Head.TxMerkleHash) ||
+		!bytes.Equal(blk.CalculateTxReceiptMerkleHash(), blk.Head.TxReceiptMerkleHash) {
+		return nil, fmt.Errorf("%v: txs of block %v not match the merkle hashes", ErrInvalidSnapshot, blk.Head.Number)
+	}
+	if activeWitness(blk, m.ActiveWitnessList, nil) == nil {
+		return nil, fmt.Errorf("%v: witness %v of block %v is not scheduled by the active witness list", ErrInvalidSnapshot, blk.Head.Witness, blk.Head.Number)
+	}
+	if len(m.PendingWitnessList) == 0 {
+		return nil, fmt.Errorf("%v: pending witness list missing", ErrInvalidSnapshot)
+	}
+	return blk, nil
+}
+
+// VerifyChunk checks the bytes of the chunk of the index match the hash in the manifest, and returns the chunk.
+func VerifyChunk(m *Manifest, index int, b []byte) (*Chunk, error) {
+	if index >= len(m.ChunkHashes) || !bytes.Equal(common.Sha3(b), m.ChunkHashes[index]) {
+		return nil, fmt.Errorf("%v: chunk %v", ErrChunkHash, index)
+	}
+	c := &Chunk{}
+	if err := proto.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	if c.Index != int64(index) || len(c.Entries) == 0 {
+		return nil, fmt.Errorf("%v: chunk %v", ErrInvalidSnapshot, index)
+	}
+	for i := 1; i < len(c.Entries); i++ {
+		if bytes.Compare(c.Entries[i-1].Key, c.Entries[i].Key) >= 0 {
+			return nil, fmt.Errorf("%v: keys of chunk %v not sorted", ErrInvalidSnapshot, index)
 		}
+	}
+	return c, nil
+}
+
+// Importer restores the state from the chunks of a manifest, which are added in order.
+type Importer struct {
+	conf     *common.Config
+	manifest *Manifest
+	blk      *block.Block
+	stateDB  db.MVCCDB
+	tree     *merkletree.SparseMerkleTree
+	root     []byte
+	next     int
+	entries  int64
+	lastKey  []byte
+	// delaytxs are the values of the delay txs in the state by the tx hashes.
+	delaytxs map[string]string
+	finished bool
+}
 
-		if h.Typeflag == tar.TypeDir {
-			continue
+// NewImporter returns an importer of the manifest into the data directory of the config, which must not have the
+// state db or the block chain db yet.
+func NewImporter(conf *common.Config, m *Manifest) (*Importer, error) {
+	blk, err := VerifyManifest(m)
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range []string{"StateDB", "BlockChainDB"} {
+		if _, err := os.Stat(conf.DB.LdbPath + dir); err == nil {
+			return nil, fmt.Errorf("%v already exists in %v", dir, conf.DB.LdbPath)
 		}
+	}
+	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	if err != nil {
+		return nil, err
+	}
+	return &Importer{
+		conf:     conf,
+		manifest: m,
+		blk:      blk,
+		stateDB:  stateDB,
+		tree:     verifier.NewStateTree(stateDB),
+		root:     merkletree.SMTEmptyRoot,
+		delaytxs: make(map[string]string),
+	}, nil
+}
 
-		fw, err := os.OpenFile(filepath.Join(conf.DB.LdbPath, h.Name), os.O_CREATE|os.O_WRONLY, os.FileMode(h.Mode))
-		if err != nil {
+// Add verifies and writes the next chunk.
+func (im *Importer) Add(b []byte) error {
+	c, err := VerifyChunk(im.manifest, im.next, b)
+	if err != nil {
+		return err
+	}
+	if im.lastKey != nil && bytes.Compare(im.lastKey, c.Entries[0].Key) >= 0 {
+		return fmt.Errorf("%v: keys of chunk %v not sorted", ErrInvalidSnapshot, im.next)
+	}
+	keys := make([][]byte, 0, len(c.Entries))
+	values := make([][]byte, 0, len(c.Entries))
+	for _, e := range c.Entries {
+		if err := im.stateDB.Put(database.StateTable, string(e.Key), string(e.Value)); err != nil {
 			return err
 		}
-
-		_, err = io.Copy(fw, tr)
+		keys = append(keys, e.Key)
+		values = append(values, e.Value)
+		if prefix := database.DelaytxStateKey(""); bytes.HasPrefix(e.Key, []byte(prefix)) {
+			im.delaytxs[string(e.Key[len(prefix):])] = string(e.Value)
+		}
+	}
+	if im.blk.Head.Version >= block.V2 {
+		im.root, err = im.tree.Update(im.root, keys, values)
 		if err != nil {
 			return err
 		}
-
-		fw.Close()
 	}
+	im.stateDB.Commit(importingTag)
+	if err := im.stateDB.Flush(importingTag); err != nil {
+		return err
+	}
+	im.next++
+	im.entries += int64(len(c.Entries))
+	im.lastKey = c.Entries[len(c.Entries)-1].Key
 	return nil
 }
 
-// ToFile the function for saving db to File.
-func ToFile(conf *common.Config) error {
-	src := filepath.Join(conf.DB.LdbPath, "StateDB")
-
-	if _, err := os.Stat(src); err != nil {
-		return fmt.Errorf("Unable to tar files - %v", err.Error())
+// Finish checks all the chunks are added, and commits the state at the block of the manifest. The state is checked
+// against the state root of the block if the block commits to it.
+func (im *Importer) Finish() error {
+	if im.next != len(im.manifest.ChunkHashes) || im.entries != im.manifest.EntryNumber {
+		return fmt.Errorf("%v: %v of %v chunks imported", ErrInvalidSnapshot, im.next, len(im.manifest.ChunkHashes))
+	}
+	if im.blk.Head.Version >= block.V2 && !bytes.Equal(im.root, im.blk.Head.StateRoot) {
+		return fmt.Errorf("%v: %v != %v", ErrStateRoot, common.Base58Encode(im.root), common.Base58Encode(im.blk.Head.StateRoot))
 	}
-	db, err := leveldb.NewDB(src)
+	delayTxs, err := im.verifyDelayTxs()
 	if err != nil {
 		return err
 	}
-	defer db.Close()
-
-	file, err := os.OpenFile(filepath.Join(conf.DB.LdbPath, "Snapshot.iost"), os.O_WRONLY|os.O_CREATE, 0666)
-
+	hash := string(im.blk.HeadHash())
+	im.stateDB.Commit(hash)
+	if err := im.stateDB.Flush(hash); err != nil {
+		return err
+	}
+	bChain, err := block.NewBlockChain(im.conf.DB.LdbPath + "BlockChainDB")
 	if err != nil {
 		return err
 	}
-	defer file.Close()
-	iter := db.NewIteratorByPrefix([]byte("")).(*leveldb.Iter)
-	writer := bufio.NewWriterSize(file, 100000)
-	for iter.Next() {
-		k := string(iter.Key())
-		v := string(iter.Value())
-		writer.WriteString(k + "\n")
-		writer.WriteString(v + "\n")
+	defer bChain.Close()
+	if err := bChain.Push(im.blk); err != nil {
+		return err
 	}
-	writer.Flush()
+	for _, t := range delayTxs {
+		if err := bChain.RestoreDelaytx(t); err != nil {
+			return err
+		}
+	}
+	if err := blockcache.WriteRootActiveWAL(im.conf, im.blk, im.manifest.ActiveWitnessList); err != nil {
+		return err
+	}
+	im.finished = true
 	return nil
 }
 
-// FromFile the function for loading db from File.
-func FromFile() error {
-	return nil
+// verifyDelayTxs checks the delay txs of the manifest are exactly the delay txs in the state, and returns them.
+func (im *Importer) verifyDelayTxs() ([]*tx.Tx, error) {
+	if len(im.manifest.DelayTxs) != len(im.delaytxs) {
+		return nil, fmt.Errorf("%v: %v delay txs in the manifest, %v in the state", ErrInvalidSnapshot, len(im.manifest.DelayTxs), len(im.delaytxs))
+	}
+	result := make([]*tx.Tx, 0, len(im.manifest.DelayTxs))
+	for _, tpb := range im.manifest.DelayTxs {
+		t := (&tx.Tx{}).FromPb(tpb)
+		hash := string(t.Hash())
+		value, ok := im.delaytxs[hash]
+		if !ok || t.Delay <= 0 || t.IsDefer() {
+			return nil, fmt.Errorf("%v: delay tx %v not in the state", ErrInvalidSnapshot, common.Base58Encode([]byte(hash)))
+		}
+		publisher, deferTxHash := database.ParseDelaytx(value)
+		if publisher != t.Publisher || deferTxHash != string(t.DeferTx().Hash()) {
+			return nil, fmt.Errorf("%v: delay tx %v not match the state", ErrInvalidSnapshot, common.Base58Encode([]byte(hash)))
+		}
+		delete(im.delaytxs, hash)
+		result = append(result, t)
+	}
+	return result, nil
+}
+
+// Close closes the importer. The data written is removed if the import is not finished.
+func (im *Importer) Close() {
+	im.stateDB.Close()
+	if !im.finished {
+		for _, dir := range []string{"StateDB", "BlockChainDB", blockcache.BlockCacheWALDir} {
+			if err := os.RemoveAll(im.conf.DB.LdbPath + dir); err != nil {
+				ilog.Errorf("Failed to remove %v: %v", dir, err)
+			}
+		}
+	}
+}
+
+// Import restores the state and the block of the snapshot read from r into the data directory of the config. If
+// the hash is not empty, the manifest must have the hash.
+func Import(conf *common.Config, r io.Reader, hash string) (*Manifest, error) {
+	br := bufio.NewReaderSize(r, 1<<20)
+	head := make([]byte, len(magic))
+	if _, err := io.ReadFull(br, head); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(head, magic) {
+		return nil, fmt.Errorf("%v: not a snapshot", ErrInvalidSnapshot)
+	}
+	b, err := readMessage(br)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err := proto.Unmarshal(b, m); err != nil {
+		return nil, err
+	}
+	if hash != "" && common.Base58Encode(common.Sha3(b)) != hash {
+		return nil, ErrManifestHash
+	}
+	im, err := NewImporter(conf, m)
+	if err != nil {
+		return nil, err
+	}
+	defer im.Close()
+	for range m.ChunkHashes {
+		b, err := readMessage(br)
+		if err != nil {
+			return nil, err
+		}
+		if err := im.Add(b); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := br.ReadByte(); err != io.EOF {
+		return nil, fmt.Errorf("%v: data after the last chunk", ErrInvalidSnapshot)
+	}
+	return m, im.Finish()
 }
@@ -1,14 +1,24 @@
 package snapshot
 
 import (
-	"fmt"
+	"bytes"
+	"encoding/json"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/iost-official/go-iost/v3/account"
 	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/crypto"
 	"github.com/iost-official/go-iost/v3/db"
-	. "github.com/smartystreets/goconvey/convey"
+	"github.com/iost-official/go-iost/v3/verifier"
+	"github.com/iost-official/go-iost/v3/vm/database"
 )
 
 const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
@@ -21,70 +31,207 @@ func randString(n int) string {
 	return string(b)
 }
 
-func TestSnapshot(t *testing.T) {
-	Convey("Test of Snapshot", t, func() {
-		os.RemoveAll("DB")
-		defer os.RemoveAll("DB")
-		stateDB, err := db.NewMVCCDB("DB/StateDB")
-		So(err, ShouldBeNil)
+func newConfig(dir string) *common.Config {
+	return &common.Config{
+		DB: &common.DBConfig{
+			LdbPath: dir + "/",
+		},
+	}
+}
+
+// newDelayTx returns the delay tx packed in the block of newNode.
+func newDelayTx() *tx.Tx {
+	t := tx.NewTx([]*tx.Action{{Contract: "token.iost", ActionName: "transfer", Data: "[]"}}, nil, 1000000, 100, common.SlotInterval.Nanoseconds()*200, common.SlotInterval.Nanoseconds()*1000, 0)
+	t.Time = common.SlotInterval.Nanoseconds() * 100
+	t.Publisher = "admin"
+	return t
+}
 
-		for i := 0; i < 100; i++ {
-			err = stateDB.Put("state", randString(64), randString(32))
-			if err != nil {
-				fmt.Println(err)
-			}
+// newNode writes a state of n entries with a delay tx and a block committing to it into the data directory of the
+// config.
+func newNode(conf *common.Config, n int, valueSize int) error {
+	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	if err != nil {
+		return err
+	}
+	producers, err := json.Marshal([]string{kp.ReadablePubkey()})
+	if err != nil {
+		return err
+	}
+	keys := make([][]byte, 0, n+2)
+	values := make([][]byte, 0, n+2)
+	put := func(k, v string) error {
+		keys = append(keys, []byte(k))
+		values = append(values, []byte(v))
+		return stateDB.Put(database.StateTable, k, v)
+	}
+	for _, k := range []string{"pendingProducerList", "currentProducerList"} {
+		if err := put(database.BasicPrefix+"vote_producer.iost-"+k, database.MustMarshal(string(producers))); err != nil {
+			return err
 		}
-		stateDB.Commit("abc")
-		stateDB.Flush("abc")
-		stateDB.Close()
-		config := &common.Config{
-			DB: &common.DBConfig{
-				LdbPath: "DB/",
-			},
-			Snapshot: &common.SnapshotConfig{
-				Enable:   true,
-				FilePath: "DB/Snapshot.tar.gz",
-			},
+	}
+	for i := 0; i < n; i++ {
+		if err := put(randString(64), randString(valueSize)+"\n"); err != nil {
+			return err
 		}
-		err = ToSnapshot(config)
-		So(err, ShouldBeNil)
-		err = ToFile(config)
-		So(err, ShouldBeNil)
-		os.RemoveAll("DB/StateDB/")
-		err = FromSnapshot(config)
-		So(err, ShouldBeNil)
-	})
-}
+	}
+	delayTx := newDelayTx()
+	if err := put(database.DelaytxStateKey(string(delayTx.Hash())), delayTx.Publisher+"@"+string(delayTx.DeferTx().Hash())); err != nil {
+		return err
+	}
+	root, err := verifier.NewStateTree(stateDB).Update(merkletree.SMTEmptyRoot, keys, values)
+	if err != nil {
+		return err
+	}
 
-func BenchmarkSnapshot(b *testing.B) {
-	os.RemoveAll("DB")
-	defer os.RemoveAll("DB")
-	stateDB, err := db.NewMVCCDB("DB/StateDB")
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Version:   block.V2,
+			Number:    100,
+			Witness:   kp.ReadablePubkey(),
+			Time:      common.SlotInterval.Nanoseconds() * 100,
+			StateRoot: root,
+		},
+		Txs:      []*tx.Tx{delayTx},
+		Receipts: []*tx.TxReceipt{{TxHash: delayTx.Hash(), Status: &tx.Status{Code: tx.Success}}},
+	}
+	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+	blk.CalculateHeadHash()
+	blk.Sign = kp.Sign(blk.HeadHash())
 
+	bChain, err := block.NewBlockChain(conf.DB.LdbPath + "BlockChainDB")
 	if err != nil {
-		fmt.Println(err)
+		return err
 	}
-	for i := 0; i < 1000000; i++ {
-		stateDB.Put("state", randString(64), randString(32))
+	defer bChain.Close()
+	if err := bChain.Push(blk); err != nil {
+		return err
 	}
-	stateDB.Commit("abc")
-	stateDB.Flush("abc")
-	stateDB.Close()
-	config := &common.Config{
-		DB: &common.DBConfig{
-			LdbPath: "DB/",
-		},
+	stateDB.Commit(string(blk.HeadHash()))
+	return stateDB.Flush(string(blk.HeadHash()))
+}
+
+func states(conf *common.Config) (map[string]string, string, error) {
+	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	if err != nil {
+		return nil, "", err
+	}
+	defer stateDB.Close()
+	keys, err := stateDB.KeysByRange(database.StateTable, "", string([]byte{0xff}), 0)
+	if err != nil {
+		return nil, "", err
 	}
-	b.Run("ToSnapshot", func(b *testing.B) {
-		for n := 0; n < b.N; n++ {
-			err = ToSnapshot(config)
+	ret := make(map[string]string)
+	for _, k := range keys {
+		ret[k], err = stateDB.Get(database.StateTable, k)
+		if err != nil {
+			return nil, "", err
 		}
-	})
+	}
+	return ret, stateDB.CurrentTag(), nil
+}
 
-	b.Run("ToFile", func(b *testing.B) {
-		for n := 0; n < b.N; n++ {
-			err = ToFile(config)
-		}
+func TestSnapshot(t *testing.T) {
+	Convey("Test of Snapshot", t, func() {
+		dir := t.TempDir()
+		src := newConfig(filepath.Join(dir, "src"))
+		So(newNode(src, 3000, 2048), ShouldBeNil)
+
+		buf := &bytes.Buffer{}
+		m, err := Export(src, buf)
+		So(err, ShouldBeNil)
+		So(len(m.ChunkHashes), ShouldEqual, 2)
+		So(m.EntryNumber, ShouldEqual, 3003)
+		So(len(m.DelayTxs), ShouldEqual, 1)
+		So(len(m.ActiveWitnessList), ShouldEqual, 1)
+		hash, err := m.Hash()
+		So(err, ShouldBeNil)
+		data := buf.Bytes()
+
+		Convey("import", func() {
+			dst := newConfig(filepath.Join(dir, "dst"))
+			_, err := Import(dst, bytes.NewReader(data), common.Base58Encode(hash))
+			So(err, ShouldBeNil)
+
+			want, wantTag, err := states(src)
+			So(err, ShouldBeNil)
+			got, gotTag, err := states(dst)
+			So(err, ShouldBeNil)
+			So(got, ShouldResemble, want)
+			So(gotTag, ShouldEqual, wantTag)
+
+			bChain, err := block.NewBlockChain(dst.DB.LdbPath + "BlockChainDB")
+			So(err, ShouldBeNil)
+			defer bChain.Close()
+			blk, err := bChain.GetBlockByHash([]byte(gotTag))
+			So(err, ShouldBeNil)
+			So(blk.Head.Number, ShouldEqual, 100)
+			delayTxs, err := bChain.AllDelaytx()
+			So(err, ShouldBeNil)
+			So(len(delayTxs), ShouldEqual, 1)
+			So(delayTxs[0].Hash(), ShouldResemble, newDelayTx().Hash())
+
+			_, err = Import(dst, bytes.NewReader(data), "")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("import with a wrong manifest hash", func() {
+			dst := newConfig(filepath.Join(dir, "dst"))
+			_, err := Import(dst, bytes.NewReader(data), common.Base58Encode(common.Sha3(hash)))
+			So(err, ShouldEqual, ErrManifestHash)
+		})
+
+		Convey("import a manifest without the delay txs", func() {
+			stateDB, err := db.NewMVCCDB(src.DB.LdbPath + "StateDB")
+			So(err, ShouldBeNil)
+			m.DelayTxs = nil
+			tampered := &bytes.Buffer{}
+			err = Write(tampered, m, stateDB)
+			stateDB.Close()
+			So(err, ShouldBeNil)
+
+			dst := newConfig(filepath.Join(dir, "dst"))
+			_, err = Import(dst, tampered, "")
+			So(err, ShouldNotBeNil)
+			_, err = os.Stat(dst.DB.LdbPath + "BlockChainDB")
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("import a tampered chunk", func() {
+			dst := newConfig(filepath.Join(dir, "dst"))
+			tampered := append([]byte{}, data...)
+			tampered[len(tampered)-2] ^= 1
+			_, err := Import(dst, bytes.NewReader(tampered), "")
+			So(err, ShouldNotBeNil)
+			_, err = os.Stat(dst.DB.LdbPath + "StateDB")
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("import a truncated snapshot", func() {
+			dst := newConfig(filepath.Join(dir, "dst"))
+			_, err := Import(dst, bytes.NewReader(data[:len(data)-100]), "")
+			So(err, ShouldNotBeNil)
+			_, err = os.Stat(dst.DB.LdbPath + "StateDB")
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
 	})
+}
 
+func BenchmarkExport(b *testing.B) {
+	conf := newConfig(b.TempDir())
+	if err := newNode(conf, 100000, 32); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := Export(conf, &bytes.Buffer{}); err != nil {
+			b.Fatal(err)
+		}
+	}
 }
@@ -18,6 +18,8 @@ import (
 const (
 	V0 int64 = iota
 	V1
+	// V2 commits to the state root
+	V2
 )
 
 // BlockHead is the struct of block head.
@@ -31,6 +33,7 @@ type BlockHead struct { // nolint
 	Witness             string
 	Time                int64
 	GasUsage            int64
+	StateRoot           []byte
 }
 
 // ToPb convert BlockHead to proto buf data structure.
@@ -44,6 +47,7 @@ func (b *BlockHead) ToPb() *blockpb.BlockHead {
 		Number:              b.Number,
 		Witness:             b.Witness,
 		Time:                b.Time,
+		StateRoot:           b.StateRoot,
 	}
 }
 
@@ -58,6 +62,9 @@ func (b *BlockHead) ToBytes() []byte {
 	se.WriteInt64(b.Number)
 	se.WriteString(b.Witness)
 	se.WriteInt64(b.Time)
+	if b.Version >= V2 {
+		se.WriteBytes(b.StateRoot)
+	}
 	return se.Bytes()
 }
 
@@ -71,6 +78,7 @@ func (b *BlockHead) FromPb(bh *blockpb.BlockHead) *BlockHead {
 	b.Number = bh.Number
 	b.Witness = bh.Witness
 	b.Time = bh.Time
+	b.StateRoot = bh.StateRoot
 	return b
 }
 
@@ -31,6 +31,9 @@ var (
 	receiptPrefix     = []byte("r")      // receiptPrefix + receipt hash -> block hash + receipt hash
 	bReceiptPrefix    = []byte("b")      // bReceiptPrefix + block hash + receipt hash -> receipt data
 	delaytxPrefix     = []byte("delay-") // delaytxPrefix + tx hash -> tx data
+	stateDiffPrefix   = []byte("s")      // stateDiffPrefix + tx hash -> state diff data
+	eventPrefix       = []byte("e")      // eventPrefix + tx hash -> contract events data
+	restoredTxPrefix  = []byte("x")      // restoredTxPrefix + tx hash -> tx data of the delay txs restored from a snapshot
 )
 
 // NewBlockChain returns a Chain instance
@@ -179,6 +182,12 @@ func (bc *BlockChain) Push(block *Block) error {
 		bc.blockChainDB.Put(append(txReceiptPrefix, tHash...), append(hash, rHash...))
 		bc.blockChainDB.Put(append(receiptPrefix, rHash...), append(hash, rHash...))
 		bc.blockChainDB.Put(append(bReceiptPrefix, append(hash, rHash...)...), block.Receipts[i].Encode())
+		if block.Receipts[i].StateDiff != nil {
+			bc.blockChainDB.Put(append(stateDiffPrefix, tHash...), tx.EncodeStateDiff(block.Receipts[i].StateDiff))
+		}
+		if len(block.Receipts[i].Events) > 0 {
+			bc.blockChainDB.Put(append(eventPrefix, tHash...), tx.EncodeContractEvents(block.Receipts[i].Events))
+		}
 
 		if t.Delay > 0 && block.Receipts[i].Status.Code == tx.Success {
 			bc.blockChainDB.Put(append(delaytxPrefix, tHash...), txBytes)
@@ -359,7 +368,12 @@ func (bc *BlockChain) GetTx(hash []byte) (*tx.Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to Get the tx: %v", err)
 	}
-	txData, err := bc.blockChainDB.Get(append(bTxPrefix, bTx...))
+	var txData []byte
+	if len(bTx) == 0 {
+		txData, err = bc.blockChainDB.Get(append(restoredTxPrefix, hash...))
+	} else {
+		txData, err = bc.blockChainDB.Get(append(bTxPrefix, bTx...))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to Get the tx: %v", err)
 	}
@@ -420,6 +434,47 @@ func (bc *BlockChain) GetReceiptByTxHash(hash []byte) (*tx.TxReceipt, error) {
 	return &re, nil
 }
 
+// GetStateDiffByTxHash gets the contract storage changes of the tx, which are recorded only if the node enables it.
+func (bc *BlockChain) GetStateDiffByTxHash(hash []byte) ([]*tx.StateChange, error) {
+	ok, err := bc.blockChainDB.Has(append(stateDiffPrefix, hash...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the state diff: %v", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("failed to Get the state diff: not found")
+	}
+	data, err := bc.blockChainDB.Get(append(stateDiffPrefix, hash...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the state diff: %v", err)
+	}
+	diff, err := tx.DecodeStateDiff(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Decode the state diff: %v", err)
+	}
+	return diff, nil
+}
+
+// GetContractEventsByTxHash gets the contract events of the tx, which are recorded only if the node enables the
+// event store. It returns nil if the tx has no recorded events.
+func (bc *BlockChain) GetContractEventsByTxHash(hash []byte) ([]*tx.Receipt, error) {
+	ok, err := bc.blockChainDB.Has(append(eventPrefix, hash...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the contract events: %v", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	data, err := bc.blockChainDB.Get(append(eventPrefix, hash...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the contract events: %v", err)
+	}
+	events, err := tx.DecodeContractEvents(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Decode the contract events: %v", err)
+	}
+	return events, nil
+}
+
 // HasReceipt checks if database has receipt.
 func (bc *BlockChain) HasReceipt(hash []byte) (bool, error) {
 	return bc.blockChainDB.Has(append(receiptPrefix, hash...))
@@ -435,6 +490,23 @@ func (bc *BlockChain) Close() {
 	bc.blockChainDB.Close()
 }
 
+// RestoreDelaytx stores the outstanding delay tx of the state restored from a snapshot, whose block is not in the
+// chain, so it is found by GetTx and AllDelaytx.
+func (bc *BlockChain) RestoreDelaytx(t *tx.Tx) error {
+	err := bc.blockChainDB.BeginBatch()
+	if err != nil {
+		return errors.New("fail to begin batch")
+	}
+	txBytes := t.Encode()
+	bc.blockChainDB.Put(append(restoredTxPrefix, t.Hash()...), txBytes)
+	bc.blockChainDB.Put(append(delaytxPrefix, t.Hash()...), txBytes)
+	err = bc.blockChainDB.CommitBatch()
+	if err != nil {
+		return fmt.Errorf("fail to commit batch, %v", err)
+	}
+	return nil
+}
+
 // AllDelaytx returns all delay transactions.
 func (bc *BlockChain) AllDelaytx() ([]*tx.Tx, error) {
 	iter := bc.blockChainDB.NewIteratorByPrefix(delaytxPrefix)
@@ -19,10 +19,13 @@ type Chain interface {
 	HasTx(hash []byte) (bool, error)
 	GetReceipt(Hash []byte) (*tx.TxReceipt, error)
 	GetReceiptByTxHash(Hash []byte) (*tx.TxReceipt, error)
+	GetStateDiffByTxHash(hash []byte) ([]*tx.StateChange, error)
+	GetContractEventsByTxHash(hash []byte) ([]*tx.Receipt, error)
 	HasReceipt(hash []byte) (bool, error)
 	Size() (int64, error)
 	Close()
 	AllDelaytx() ([]*tx.Tx, error)
+	RestoreDelaytx(t *tx.Tx) error
 	Draw(int64, int64) string
 	GetBlockNumberByTxHash(hash []byte) (int64, error)
 }
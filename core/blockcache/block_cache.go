@@ -8,6 +8,7 @@ import (
 
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/event"
 	"github.com/iost-official/go-iost/v3/db"
 	"github.com/iost-official/go-iost/v3/db/wal"
 	"github.com/iost-official/go-iost/v3/ilog"
@@ -451,6 +452,7 @@ func (bc *BlockCacheImpl) updateLib(node *BlockCacheNode, confirmLimit int) {
 		// bc.updateLinkedRoot() will change node.ValidWitness, bc.LinkedRoot() and bc.linkedRootWitness
 		bc.updateLinkedRoot(blockList[bc.LinkedRoot().Head.Number+1])
 		bc.flush()
+		postBlockEvent(event.IrreversibleBlock, bc.LinkedRoot())
 	}
 }
 
@@ -509,8 +511,14 @@ func (bc *BlockCacheImpl) Link(bcn *BlockCacheNode) {
 		}
 	}
 
-	if bcn.Head.Number > bc.Head().Head.Number || (bcn.Head.Number == bc.Head().Head.Number && bcn.Head.Time < bc.Head().Head.Time) {
+	postBlockEvent(event.NewBlock, bcn)
+
+	oldHead := bc.Head()
+	if bcn.Head.Number > oldHead.Head.Number || (bcn.Head.Number == oldHead.Head.Number && bcn.Head.Time < oldHead.Head.Time) {
 		bc.SetHead(bcn)
+		if bcn.GetParent() != oldHead {
+			postChainReorg(forkBranches(oldHead, bcn))
+		}
 	}
 }
 
@@ -671,6 +679,12 @@ func (bc *BlockCacheImpl) updateLinkedRoot(bcn *BlockCacheNode) {
 	if parent != bc.LinkedRoot() {
 		ilog.Errorf("block isn't blockcache root's child")
 	}
+	// The head may be on a branch deleted below, so its blocks are collected in advance for the reorg event.
+	oldHead := bc.Head()
+	abandoned, _ := forkBranches(oldHead, parent)
+	if len(abandoned) > 0 && abandoned[len(abandoned)-1] == bcn {
+		abandoned = nil
+	}
 	for child := range parent.Children {
 		if child != bcn {
 			bc.del(child)
@@ -697,6 +711,14 @@ func (bc *BlockCacheImpl) updateLinkedRoot(bcn *BlockCacheNode) {
 			bc.SetHead(bcn)
 		}
 	}
+	if bc.Head() == oldHead {
+		return
+	}
+	adopted := make([]*BlockCacheNode, 0)
+	for n := bc.Head(); n != nil; n = n.GetParent() {
+		adopted = append(adopted, n)
+	}
+	postChainReorg(abandoned, adopted)
 }
 
 func (bc *BlockCacheImpl) flush() {
@@ -764,6 +786,32 @@ func (bc *BlockCacheImpl) writeUpdateActiveWAL(h *BlockCacheNode) (err error) {
 	return
 }
 
+// WriteRootActiveWAL writes the active witness list of the block to the WAL of the block cache, which is applied when
+// the block cache starts from the block. It is for the blocks not linked by the block cache, such as the block of a
+// snapshot.
+func WriteRootActiveWAL(conf *common.Config, blk *block.Block, active []string) error {
+	w, err := wal.Create(conf.DB.LdbPath+BlockCacheWALDir, []byte("block_cache_wal"))
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	bcn := NewBCN(nil, blk)
+	bcn.SetActive(active)
+	hb, err := encodeUpdateActive(bcn)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(&BcMessage{
+		Data: hb,
+		Type: BcMessageType_UpdateActiveType,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.SaveSingle(&wal.Entry{Data: data})
+	return err
+}
+
 func (bc *BlockCacheImpl) writeAddNodeWAL(h *BlockCacheNode) (uint64, error) {
 	hb, err := encodeBCN(h)
 	if err != nil {
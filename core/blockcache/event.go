@@ -0,0 +1,84 @@
+package blockcache
+
+import (
+	"encoding/json"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/ilog"
+)
+
+// BlockEventData is the data of the NewBlock and IrreversibleBlock events.
+type BlockEventData struct {
+	Number     int64  `json:"number"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parent_hash"`
+	Witness    string `json:"witness"`
+	Time       int64  `json:"time"`
+	TxCount    int    `json:"tx_count"`
+}
+
+// ChainReorgEventData is the data of the ChainReorg event.
+// The blocks are ordered by number, from the fork point to the head.
+type ChainReorgEventData struct {
+	Abandoned []*BlockEventData `json:"abandoned"`
+	Adopted   []*BlockEventData `json:"adopted"`
+}
+
+// NewBlockEventData returns the event data of the block.
+func NewBlockEventData(blk *block.Block) *BlockEventData {
+	return &BlockEventData{
+		Number:     blk.Head.Number,
+		Hash:       common.Base58Encode(blk.HeadHash()),
+		ParentHash: common.Base58Encode(blk.Head.ParentHash),
+		Witness:    blk.Head.Witness,
+		Time:       blk.Head.Time,
+		TxCount:    len(blk.Txs),
+	}
+}
+
+func postEvent(topic event.Topic, data interface{}, meta *event.Meta) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		ilog.Errorf("Marshal %v event failed: %v", topic, err)
+		return
+	}
+	event.GetCollector().Post(event.NewEvent(topic, string(b)), meta)
+}
+
+func postBlockEvent(topic event.Topic, bcn *BlockCacheNode) {
+	postEvent(topic, NewBlockEventData(bcn.Block), &event.Meta{Witness: bcn.Head.Witness})
+}
+
+// postChainReorg posts the ChainReorg event, abandoned and adopted are ordered from head to the fork point.
+func postChainReorg(abandoned, adopted []*BlockCacheNode) {
+	if len(abandoned) == 0 {
+		return
+	}
+	data := &ChainReorgEventData{
+		Abandoned: make([]*BlockEventData, 0, len(abandoned)),
+		Adopted:   make([]*BlockEventData, 0, len(adopted)),
+	}
+	for i := len(abandoned) - 1; i >= 0; i-- {
+		data.Abandoned = append(data.Abandoned, NewBlockEventData(abandoned[i].Block))
+	}
+	for i := len(adopted) - 1; i >= 0; i-- {
+		data.Adopted = append(data.Adopted, NewBlockEventData(adopted[i].Block))
+	}
+	postEvent(event.ChainReorg, data, nil)
+}
+
+// forkBranches returns the blocks of the old and new branches above their common ancestor, ordered from head to the fork point.
+func forkBranches(oldHead, newHead *BlockCacheNode) (abandoned, adopted []*BlockCacheNode) {
+	for oldHead != nil && newHead != nil && oldHead != newHead {
+		if oldHead.Head.Number >= newHead.Head.Number {
+			abandoned = append(abandoned, oldHead)
+			oldHead = oldHead.GetParent()
+		} else {
+			adopted = append(adopted, newHead)
+			newHead = newHead.GetParent()
+		}
+	}
+	return abandoned, adopted
+}
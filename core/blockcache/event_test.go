@@ -0,0 +1,40 @@
+package blockcache
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForkBranches(t *testing.T) {
+	b0 := &block.Block{
+		Head: &block.BlockHead{
+			ParentHash: []byte("nothing"),
+			Witness:    "w0",
+		},
+	}
+	b0.CalculateHeadHash()
+	b1 := genBlock(b0, "w1", 1)
+	b2 := genBlock(b1, "w2", 2)
+	b2a := genBlock(b1, "w3", 2)
+	b3a := genBlock(b2a, "w4", 3)
+
+	n0 := NewBCN(nil, b0)
+	n1 := NewBCN(n0, b1)
+	n2 := NewBCN(n1, b2)
+	n2a := NewBCN(n1, b2a)
+	n3a := NewBCN(n2a, b3a)
+
+	abandoned, adopted := forkBranches(n2, n3a)
+	assert.Equal(t, []*BlockCacheNode{n2}, abandoned)
+	assert.Equal(t, []*BlockCacheNode{n3a, n2a}, adopted)
+
+	abandoned, adopted = forkBranches(n1, n3a)
+	assert.Empty(t, abandoned)
+	assert.Equal(t, []*BlockCacheNode{n3a, n2a}, adopted)
+
+	abandoned, adopted = forkBranches(n3a, n3a)
+	assert.Empty(t, abandoned)
+	assert.Empty(t, adopted)
+}
@@ -0,0 +1,119 @@
+package contract
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ABIChangeKind is the kind of change of an api between two versions of a contract.
+type ABIChangeKind string
+
+// kinds of abi change
+const (
+	ABIAdded              ABIChangeKind = "added"
+	ABIRemoved            ABIChangeKind = "removed"
+	ABIArgsChanged        ABIChangeKind = "args_changed"
+	ABIAmountLimitChanged ABIChangeKind = "amount_limit_changed"
+)
+
+// ErrIncompatibleUpgrade is returned when an upgrade breaks the abi of a contract.
+var ErrIncompatibleUpgrade = errors.New("incompatible contract upgrade")
+
+// ABIChange is a change of an api between two versions of a contract.
+type ABIChange struct {
+	Name string
+	Kind ABIChangeKind
+	Old  string
+	New  string
+}
+
+// Breaking returns whether the change may break the callers of the api. Only adding an api is
+// safe, since callers check the args, and the amount limit bounds what a call may spend.
+func (c *ABIChange) Breaking() bool {
+	return c.Kind != ABIAdded
+}
+
+func (c *ABIChange) String() string {
+	switch c.Kind {
+	case ABIAdded:
+		return fmt.Sprintf("%v: added %v", c.Name, c.New)
+	case ABIRemoved:
+		return fmt.Sprintf("%v: removed %v", c.Name, c.Old)
+	default:
+		return fmt.Sprintf("%v: %v %v -> %v", c.Name, c.Kind, c.Old, c.New)
+	}
+}
+
+func formatArgs(a *ABI) string {
+	return "(" + strings.Join(a.Args, ",") + ")"
+}
+
+func formatAmountLimit(a *ABI) string {
+	s := make([]string, 0, len(a.AmountLimit))
+	for _, l := range a.AmountLimit {
+		s = append(s, l.Token+":"+l.Val)
+	}
+	sort.Strings(s)
+	return "[" + strings.Join(s, ",") + "]"
+}
+
+func abiMap(info *Info) map[string]*ABI {
+	m := make(map[string]*ABI)
+	if info == nil {
+		return m
+	}
+	for _, a := range info.Abi {
+		// init is only called on deploying, so it is not part of the interface
+		if a.Name == "init" {
+			continue
+		}
+		m[a.Name] = a
+	}
+	return m
+}
+
+// CompareABI returns the changes of apis from the old version of a contract to the new one,
+// sorted by api name. The order of amount limits does not matter.
+func CompareABI(old, new *Info) []*ABIChange {
+	oldABI, newABI := abiMap(old), abiMap(new)
+	changes := make([]*ABIChange, 0)
+	for name, o := range oldABI {
+		n, ok := newABI[name]
+		if !ok {
+			changes = append(changes, &ABIChange{Name: name, Kind: ABIRemoved, Old: formatArgs(o)})
+			continue
+		}
+		if oa, na := formatArgs(o), formatArgs(n); oa != na {
+			changes = append(changes, &ABIChange{Name: name, Kind: ABIArgsChanged, Old: oa, New: na})
+		}
+		if ol, nl := formatAmountLimit(o), formatAmountLimit(n); ol != nl {
+			changes = append(changes, &ABIChange{Name: name, Kind: ABIAmountLimitChanged, Old: ol, New: nl})
+		}
+	}
+	for name, n := range newABI {
+		if _, ok := oldABI[name]; !ok {
+			changes = append(changes, &ABIChange{Name: name, Kind: ABIAdded, New: formatArgs(n)})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// CheckUpgrade returns ErrIncompatibleUpgrade with the breaking changes if upgrading the contract
+// from the old version to the new one may break its callers.
+func CheckUpgrade(old, new *Info) error {
+	breaking := make([]string, 0)
+	for _, c := range CompareABI(old, new) {
+		if c.Breaking() {
+			breaking = append(breaking, c.String())
+		}
+	}
+	if len(breaking) > 0 {
+		return fmt.Errorf("%w: %v", ErrIncompatibleUpgrade, strings.Join(breaking, "; "))
+	}
+	return nil
+}
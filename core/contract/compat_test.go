@@ -0,0 +1,57 @@
+package contract
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCompareABI(t *testing.T) {
+	old := &Info{
+		Lang:    "javascript",
+		Version: "1.0.0",
+		Abi: []*ABI{
+			{Name: "init"},
+			{Name: "transfer", Args: []string{"string", "number"}, AmountLimit: []*Amount{{Token: "iost", Val: "10"}, {Token: "ram", Val: "100"}}},
+			{Name: "get", Args: []string{"string"}},
+			{Name: "set", Args: []string{"string", "string"}},
+			{Name: "can_update", Args: []string{"string"}},
+		},
+	}
+	new := &Info{
+		Lang:    "javascript",
+		Version: "1.0.0",
+		Abi: []*ABI{
+			{Name: "init", Args: []string{"string"}},
+			{Name: "transfer", Args: []string{"string", "number"}, AmountLimit: []*Amount{{Token: "ram", Val: "100"}, {Token: "iost", Val: "10"}}},
+			{Name: "get", Args: []string{"string"}},
+			{Name: "can_update", Args: []string{"string"}},
+			{Name: "list", Args: []string{}},
+		},
+	}
+	changes := CompareABI(old, new)
+	if len(changes) != 2 || changes[0].Kind != ABIAdded || changes[0].Name != "list" ||
+		changes[1].Kind != ABIRemoved || changes[1].Name != "set" {
+		t.Fatalf("unexpected changes %v", changes)
+	}
+	if err := CheckUpgrade(old, new); !errors.Is(err, ErrIncompatibleUpgrade) {
+		t.Fatalf("expected incompatible upgrade, got %v", err)
+	}
+
+	new.Abi = append(new.Abi, &ABI{Name: "set", Args: []string{"string", "string"}})
+	if err := CheckUpgrade(old, new); err != nil {
+		t.Fatal(err)
+	}
+
+	new.Abi[1].Args = []string{"string", "string"}
+	new.Abi[1].AmountLimit = []*Amount{{Token: "iost", Val: "unlimited"}}
+	changes = CompareABI(old, new)
+	if len(changes) != 3 || changes[1].Kind != ABIArgsChanged || changes[2].Kind != ABIAmountLimitChanged {
+		t.Fatalf("unexpected changes %v", changes)
+	}
+	if changes[1].Old != "(string,number)" || changes[1].New != "(string,string)" {
+		t.Fatalf("unexpected args change %v", changes[1])
+	}
+	if changes[2].Old != "[iost:10,ram:100]" || changes[2].New != "[iost:unlimited]" {
+		t.Fatalf("unexpected amount limit change %v", changes[2])
+	}
+}
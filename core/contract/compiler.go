@@ -20,6 +20,10 @@ func (c *Compiler) Parse(id, code, abi string) (*Contract, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = info.ValidateStorage()
+	if err != nil {
+		return nil, err
+	}
 
 	return &Contract{
 		Info: info,
@@ -0,0 +1,214 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/iost-official/go-iost/v3/common"
+)
+
+// types of storage values
+const (
+	StorageString = "string"
+	StorageNumber = "number"
+	StorageBool   = "bool"
+	StorageJSON   = "json"
+)
+
+var paramRegexp = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	locs := paramRegexp.FindAllStringSubmatchIndex(pattern, -1)
+	var b strings.Builder
+	b.WriteString("^")
+	last := 0
+	for _, loc := range locs {
+		lit := pattern[last:loc[0]]
+		if strings.ContainsAny(lit, "{}") {
+			return nil, fmt.Errorf("invalid storage pattern %v", pattern)
+		}
+		b.WriteString(regexp.QuoteMeta(lit))
+		b.WriteString("(?P<" + pattern[loc[2]:loc[3]] + ">.+?)")
+		last = loc[1]
+	}
+	lit := pattern[last:]
+	if strings.ContainsAny(lit, "{}") {
+		return nil, fmt.Errorf("invalid storage pattern %v", pattern)
+	}
+	b.WriteString(regexp.QuoteMeta(lit))
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
+
+func matchPattern(pattern, s string, params map[string]string) bool {
+	re, err := compilePattern(pattern)
+	if err != nil {
+		return false
+	}
+	m := re.FindStringSubmatch(s)
+	if m == nil {
+		return false
+	}
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			params[name] = m[i]
+		}
+	}
+	return true
+}
+
+// IsMap returns whether the item describes map storage.
+func (s *StorageItem) IsMap() bool {
+	return s.Field != ""
+}
+
+// Validate checks the patterns and the type of the item.
+func (s *StorageItem) Validate() error {
+	if s.Key == "" {
+		return fmt.Errorf("empty storage key")
+	}
+	names := make(map[string]bool)
+	for _, p := range []string{s.Key, s.Field} {
+		if _, err := compilePattern(p); err != nil {
+			return err
+		}
+		for _, m := range paramRegexp.FindAllStringSubmatch(p, -1) {
+			if names[m[1]] {
+				return fmt.Errorf("duplicate param %v in storage %v", m[1], s.Key)
+			}
+			names[m[1]] = true
+		}
+	}
+	switch s.Type {
+	case StorageString, StorageNumber, StorageBool, StorageJSON:
+	default:
+		return fmt.Errorf("invalid type %v of storage %v", s.Type, s.Key)
+	}
+	return nil
+}
+
+// Match returns the params of the key and field if they match the patterns of the item.
+func (s *StorageItem) Match(key, field string) (map[string]string, bool) {
+	if s.IsMap() != (field != "") {
+		return nil, false
+	}
+	params := make(map[string]string)
+	if !matchPattern(s.Key, key, params) {
+		return nil, false
+	}
+	if s.IsMap() && !matchPattern(s.Field, field, params) {
+		return nil, false
+	}
+	return params, true
+}
+
+// Decode converts a stored value to the type of the item. Javascript contracts store every value
+// as a string, so strings are parsed as the type.
+func (s *StorageItem) Decode(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch s.Type {
+	case StorageString:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case *common.Fixed:
+			return v.ToString(), nil
+		}
+		return fmt.Sprint(value), nil
+	case StorageNumber:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case *common.Fixed:
+			return json.Number(v.ToString()), nil
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err == nil && json.Valid([]byte(v)) {
+				return json.Number(v), nil
+			}
+		}
+	case StorageBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b, nil
+			}
+		}
+	case StorageJSON:
+		var b []byte
+		if v, ok := value.(string); ok {
+			b = []byte(v)
+		} else if rv := reflect.ValueOf(value); rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			// database.SerializedJSON
+			b = rv.Bytes()
+		} else if j, err := json.Marshal(value); err == nil {
+			b = j
+		}
+		if json.Valid(b) {
+			return json.RawMessage(bytes.TrimSpace(b)), nil
+		}
+	}
+	return nil, fmt.Errorf("value %v is not %v", value, s.Type)
+}
+
+// ValidateStorage checks the storage schema of the contract.
+func (i *Info) ValidateStorage() error {
+	for _, s := range i.GetStorage() {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// infoWithoutStorage and contractWithoutStorage decode the json of a contract as before the storage schemas, any
+// "storage" of the info is skipped.
+type infoWithoutStorage struct {
+	Lang    string          `json:"lang,omitempty"`
+	Version string          `json:"version,omitempty"`
+	Abi     []*ABI          `json:"abi,omitempty"`
+	Storage json.RawMessage `json:"storage,omitempty"`
+}
+
+type contractWithoutStorage struct {
+	ID       string              `json:"ID,omitempty"`
+	Info     *infoWithoutStorage `json:"info,omitempty"`
+	Code     string              `json:"code,omitempty"`
+	OrigCode string              `json:"origCode,omitempty"`
+}
+
+// DecodeJSON decodes the contract from json. The storage schemas are decoded only if withStorage, otherwise the
+// "storage" of the info is skipped whatever it is, as the contracts deployed before the schemas were known.
+func (c *Contract) DecodeJSON(data []byte, withStorage bool) error {
+	if withStorage {
+		return json.Unmarshal(data, c)
+	}
+	old := &contractWithoutStorage{}
+	if err := json.Unmarshal(data, old); err != nil {
+		return err
+	}
+	c.ID, c.Code, c.OrigCode = old.ID, old.Code, old.OrigCode
+	c.Info = nil
+	if old.Info != nil {
+		c.Info = &Info{Lang: old.Info.Lang, Version: old.Info.Version, Abi: old.Info.Abi}
+	}
+	return nil
+}
+
+// MatchStorage returns the first item of the schema matching the key and field, with the params matched.
+func MatchStorage(schema []*StorageItem, key, field string) (*StorageItem, map[string]string) {
+	for _, s := range schema {
+		if params, ok := s.Match(key, field); ok {
+			return s, params
+		}
+	}
+	return nil, nil
+}
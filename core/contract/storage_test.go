@@ -0,0 +1,139 @@
+package contract
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStorageItem_Match(t *testing.T) {
+	schema := []*StorageItem{
+		{Key: "T721M{symbol}#{account}", Field: "{tokenID}", Type: StorageJSON},
+		{Key: "TI{symbol}", Field: "decimal", Type: StorageNumber},
+		{Key: "TI{symbol}", Field: "{field}", Type: StorageString},
+		{Key: "owner", Type: StorageString},
+	}
+	for _, s := range schema {
+		if err := s.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cases := []struct {
+		key, field string
+		item       int
+		params     map[string]string
+	}{
+		{"T721Mcat#user-1", "0", 0, map[string]string{"symbol": "cat", "account": "user-1", "tokenID": "0"}},
+		{"TIiost", "decimal", 1, map[string]string{"symbol": "iost"}},
+		{"TIiost", "issuer", 2, map[string]string{"symbol": "iost", "field": "issuer"}},
+		{"owner", "", 3, map[string]string{}},
+		{"owner", "x", -1, nil},
+		{"TI", "decimal", -1, nil},
+		{"TIiost", "", -1, nil},
+	}
+	for _, c := range cases {
+		item, params := MatchStorage(schema, c.key, c.field)
+		if c.item < 0 {
+			if item != nil {
+				t.Fatalf("%v %v matched %v", c.key, c.field, item)
+			}
+			continue
+		}
+		if item != schema[c.item] || len(params) != len(c.params) {
+			t.Fatalf("%v %v matched %v %v", c.key, c.field, item, params)
+		}
+		for k, v := range c.params {
+			if params[k] != v {
+				t.Fatalf("%v %v matched %v", c.key, c.field, params)
+			}
+		}
+	}
+}
+
+func TestStorageItem_Validate(t *testing.T) {
+	invalid := []*StorageItem{
+		{Key: "", Type: StorageString},
+		{Key: "a{b", Type: StorageString},
+		{Key: "a{}", Type: StorageString},
+		{Key: "a{b}", Field: "{b}", Type: StorageString},
+		{Key: "a", Type: "int"},
+	}
+	for _, s := range invalid {
+		if s.Validate() == nil {
+			t.Fatalf("%v should be invalid", s)
+		}
+	}
+	_, err := (&Compiler{}).Parse("", "", `{"lang":"javascript","version":"1.0.0","abi":[],"storage":[{"key":"a","type":"uint"}]}`)
+	if err == nil {
+		t.Fatal("storage should be validated")
+	}
+	c, err := (&Compiler{}).Parse("", "", `{"lang":"javascript","version":"1.0.0","abi":[],"storage":[{"key":"b_{account}","type":"number"}]}`)
+	if err != nil || len(c.Info.Storage) != 1 || c.Info.Storage[0].Key != "b_{account}" {
+		t.Fatal(c, err)
+	}
+}
+
+func TestStorageItem_Decode(t *testing.T) {
+	cases := []struct {
+		typ   string
+		value interface{}
+		json  string
+	}{
+		{StorageString, "abc", `"abc"`},
+		{StorageNumber, "12.5", `12.5`},
+		{StorageNumber, int64(3), `3`},
+		{StorageNumber, "abc", ""},
+		{StorageBool, "true", `true`},
+		{StorageBool, false, `false`},
+		{StorageJSON, `{"a":[1,2]}`, `{"a":[1,2]}`},
+		{StorageJSON, []byte(`[1]`), `[1]`},
+		{StorageJSON, "{", ""},
+		{StorageNumber, nil, `null`},
+	}
+	for _, c := range cases {
+		v, err := (&StorageItem{Key: "k", Type: c.typ}).Decode(c.value)
+		if c.json == "" {
+			if err == nil {
+				t.Fatalf("%v should not be decoded as %v", c.value, c.typ)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := json.Marshal(v)
+		if err != nil || string(b) != c.json {
+			t.Fatalf("%v decoded as %v: %s %v", c.value, c.typ, b, err)
+		}
+	}
+}
+
+func TestContract_DecodeJSON(t *testing.T) {
+	cases := []struct {
+		storage     string
+		err         bool
+		withStorage int
+	}{
+		{`[{"key":"owner","type":"string"}]`, false, 1},
+		{`"owner"`, true, 0},
+		{`[1, 2]`, true, 0},
+		{`{"key":"owner"}`, true, 0},
+	}
+	for _, c := range cases {
+		data := []byte(`{"ID":"Contract1","info":{"lang":"javascript","version":"1.0.0","abi":[{"name":"f","args":["string"]}],"storage":` + c.storage + `},"code":"c"}`)
+		con := &Contract{}
+		if err := con.DecodeJSON(data, false); err != nil {
+			t.Fatalf("decode %v before storage schemas: %v", c.storage, err)
+		}
+		if con.ID != "Contract1" || con.Code != "c" || con.Info.Lang != "javascript" || len(con.Info.Abi) != 1 || con.Info.Storage != nil {
+			t.Fatalf("decode %v before storage schemas: %v", c.storage, con)
+		}
+		con = &Contract{}
+		err := con.DecodeJSON(data, true)
+		if (err != nil) != c.err {
+			t.Fatalf("decode %v with storage schemas: %v", c.storage, err)
+		}
+		if err == nil && len(con.Info.Storage) != c.withStorage {
+			t.Fatalf("decode %v with storage schemas: %v", c.storage, con.Info.Storage)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package event
 
 import (
+	"encoding/json"
 	"strconv"
 	"sync"
 	"time"
@@ -18,6 +19,10 @@ type Topic int
 const (
 	ContractReceipt Topic = iota
 	ContractEvent
+	NewBlock
+	IrreversibleBlock
+	ChainReorg
+	TxDropped
 )
 
 func (t Topic) String() string {
@@ -26,6 +31,14 @@ func (t Topic) String() string {
 		return "ContractReceipt"
 	case ContractEvent:
 		return "ContractEvent"
+	case NewBlock:
+		return "NewBlock"
+	case IrreversibleBlock:
+		return "IrreversibleBlock"
+	case ChainReorg:
+		return "ChainReorg"
+	case TxDropped:
+		return "TxDropped"
 	default:
 		return "unknown_topic:" + strconv.Itoa(int(t))
 	}
@@ -33,9 +46,10 @@ func (t Topic) String() string {
 
 // Event is the struct sent to subscriber.
 type Event struct {
-	Topic Topic
-	Data  string
-	Time  int64
+	Topic  Topic
+	Data   string
+	Time   int64
+	Cursor *Cursor // only set for events read from the Store
 }
 
 // NewEvent generate new event with topic and data
@@ -47,23 +61,78 @@ func NewEvent(topic Topic, data string) *Event {
 	}
 }
 
-// Meta is the information abount event.
+// Meta is the information abount event. When used as a filter, the empty fields match anything,
+// and each field only applies to the topics which have such information.
 type Meta struct {
-	ContractID string
+	ContractID string // contract topics
+	ActionName string // contract topics
+	Recipient  string // contract topics, the recipient of token.iost transfers
+	Witness    string // block topics
+	Publisher  string // contract topics and TxDropped
+
+	Predicates []*Predicate // predicates on the event data as json, only for filters
 }
 
-// Match checks whether the given meta argument is matched to self.
-func (m *Meta) Match(meta *Meta) bool {
-	if meta == nil {
-		return true
+// NewReceiptMeta returns the meta of the receipt generated by the action of a tx published by the publisher.
+func NewReceiptMeta(contractID, actionName, content, publisher string) *Meta {
+	m := &Meta{
+		ContractID: contractID,
+		ActionName: actionName,
+		Publisher:  publisher,
+	}
+	if contractID == "token.iost" && (actionName == "transfer" || actionName == "transferFreeze") {
+		var args []interface{}
+		if err := json.Unmarshal([]byte(content), &args); err == nil && len(args) > 2 {
+			m.Recipient, _ = args[2].(string)
+		}
 	}
+	return m
+}
 
-	if m.ContractID != "" && m.ContractID != meta.ContractID {
+func isContractTopic(t Topic) bool {
+	return t == ContractReceipt || t == ContractEvent
+}
+
+func isBlockTopic(t Topic) bool {
+	return t == NewBlock || t == IrreversibleBlock
+}
+
+// Match checks whether the event with the meta is matched to self.
+func (m *Meta) Match(e *Event, meta *Meta) bool {
+	if meta == nil {
+		meta = &Meta{}
+	}
+	if isContractTopic(e.Topic) {
+		if !matchField(m.ContractID, meta.ContractID) ||
+			!matchField(m.ActionName, meta.ActionName) ||
+			!matchField(m.Recipient, meta.Recipient) {
+			return false
+		}
+	}
+	if isBlockTopic(e.Topic) && !matchField(m.Witness, meta.Witness) {
 		return false
 	}
+	if (isContractTopic(e.Topic) || e.Topic == TxDropped) && !matchField(m.Publisher, meta.Publisher) {
+		return false
+	}
+	if len(m.Predicates) > 0 {
+		var data interface{}
+		if err := json.Unmarshal([]byte(e.Data), &data); err != nil {
+			return false
+		}
+		for _, p := range m.Predicates {
+			if !p.Eval(data) {
+				return false
+			}
+		}
+	}
 	return true
 }
 
+func matchField(filter, value string) bool {
+	return filter == "" || filter == value
+}
+
 // Subscription is a struct used for listening specific topics
 type Subscription struct {
 	C      chan<- *Event
@@ -112,7 +181,7 @@ func (ec *Collector) sendEvent(e *Event, meta *Meta) {
 	if m, exist := ec.subMap.Load(e.Topic); exist {
 		m.(*sync.Map).Range(func(k, v interface{}) bool {
 			sub := v.(*Subscription)
-			if sub.filter != nil && !sub.filter.Match(meta) {
+			if sub.filter != nil && !sub.filter.Match(e, meta) {
 				return true
 			}
 			select {
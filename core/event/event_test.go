@@ -1,6 +1,7 @@
 package event_test
 
 import (
+	"encoding/json"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/iost-official/go-iost/v3/core/event"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEventCollectorPost(t *testing.T) {
@@ -81,6 +83,68 @@ func TestEventCollectorPost(t *testing.T) {
 	assert.EqualValues(t, 4, atomic.LoadInt32(&count3))
 }
 
+func TestMetaMatch(t *testing.T) {
+	receipt := event.NewEvent(event.ContractReceipt, `["iost","alice","bob","12.5",""]`)
+	block := event.NewEvent(event.NewBlock, `{"number":10,"witness":"w0"}`)
+	dropped := event.NewEvent(event.TxDropped, `{"publisher":"alice"}`)
+	transferMeta := event.NewReceiptMeta("token.iost", "transfer", receipt.Data, "alice")
+	assert.Equal(t, "bob", transferMeta.Recipient)
+
+	filter := &event.Meta{ContractID: "token.iost", Witness: "w0"}
+	assert.True(t, filter.Match(receipt, transferMeta))
+	assert.False(t, filter.Match(receipt, &event.Meta{ContractID: "base.iost"}))
+	assert.False(t, filter.Match(receipt, nil))
+	assert.True(t, filter.Match(block, &event.Meta{Witness: "w0"}))
+	assert.False(t, filter.Match(block, &event.Meta{Witness: "w1"}))
+	assert.True(t, filter.Match(dropped, &event.Meta{Publisher: "admin"}))
+
+	filter = &event.Meta{ActionName: "transfer", Recipient: "bob", Publisher: "alice"}
+	assert.True(t, filter.Match(receipt, transferMeta))
+	assert.False(t, filter.Match(receipt, event.NewReceiptMeta("token.iost", "transfer", `["iost","alice","carol","1",""]`, "alice")))
+	assert.False(t, filter.Match(receipt, event.NewReceiptMeta("token.iost", "issue", receipt.Data, "alice")))
+	assert.True(t, filter.Match(block, nil))
+	assert.False(t, filter.Match(dropped, &event.Meta{Publisher: "bob"}))
+
+	amount, err := event.NewPredicate("$[3]", event.OpGte, "10")
+	require.Nil(t, err)
+	filter = &event.Meta{Predicates: []*event.Predicate{amount}}
+	assert.True(t, filter.Match(receipt, transferMeta))
+	assert.False(t, filter.Match(event.NewEvent(event.ContractReceipt, `["iost","alice","bob","9",""]`), transferMeta))
+	assert.False(t, filter.Match(event.NewEvent(event.ContractReceipt, "not json"), transferMeta))
+}
+
+func TestPredicate(t *testing.T) {
+	var data interface{}
+	require.Nil(t, json.Unmarshal([]byte(`{"a":{"b":[1,"x",{"c":true}]},"n":"2.5"}`), &data))
+	cases := []struct {
+		path  string
+		op    event.PredicateOp
+		value string
+		ok    bool
+	}{
+		{"$.a.b[0]", event.OpEq, "1", true},
+		{"a.b[1]", event.OpEq, "x", true},
+		{"$.a.b[1]", event.OpNe, "x", false},
+		{"$.a.b[2].c", event.OpEq, "true", true},
+		{"$.n", event.OpGt, "10", false},
+		{"$.n", event.OpLt, "10", true},
+		{"$.n", event.OpLte, "2.5", true},
+		{"$.a.b[3]", event.OpExists, "", false},
+		{"$.a.b", event.OpExists, "", true},
+		{"$.missing", event.OpNe, "x", false},
+	}
+	for _, c := range cases {
+		p, err := event.NewPredicate(c.path, c.op, c.value)
+		require.Nil(t, err)
+		assert.Equal(t, c.ok, p.Eval(data), "%v %v %v", c.path, c.op, c.value)
+	}
+
+	_, err := event.NewPredicate("$.a[x]", event.OpEq, "")
+	assert.NotNil(t, err)
+	_, err = event.NewPredicate("$.a", event.PredicateOp(10), "")
+	assert.NotNil(t, err)
+}
+
 func TestEventCollectorFullPost(t *testing.T) {
 	ilog.Stop()
 
@@ -0,0 +1,161 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PredicateOp is the comparison operator of a predicate.
+type PredicateOp int
+
+// predicate operators
+const (
+	OpEq PredicateOp = iota
+	OpNe
+	OpGt
+	OpGte
+	OpLt
+	OpLte
+	OpExists
+)
+
+// Predicate compares the value at the path of the event data with Value.
+// The path is like `$.a.b[0]`, `$[2]` or `a.b`. Values are compared as numbers if both of them
+// are numbers, otherwise as strings.
+type Predicate struct {
+	Path  string
+	Op    PredicateOp
+	Value string
+
+	keys []interface{}
+}
+
+// NewPredicate returns a predicate after checking the path.
+func NewPredicate(path string, op PredicateOp, value string) (*Predicate, error) {
+	if op < OpEq || op > OpExists {
+		return nil, fmt.Errorf("invalid predicate op %v", op)
+	}
+	keys, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Predicate{
+		Path:  path,
+		Op:    op,
+		Value: value,
+		keys:  keys,
+	}, nil
+}
+
+// parsePath splits the path into object keys and array indexes.
+func parsePath(path string) ([]interface{}, error) {
+	p := strings.TrimPrefix(path, "$")
+	keys := make([]interface{}, 0)
+	for len(p) > 0 {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+		case '[':
+			end := strings.IndexByte(p, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %v", path)
+			}
+			idx, err := strconv.Atoi(p[1:end])
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("invalid index in path %v", path)
+			}
+			keys = append(keys, idx)
+			p = p[end+1:]
+		default:
+			end := strings.IndexAny(p, ".[")
+			if end < 0 {
+				end = len(p)
+			}
+			keys = append(keys, p[:end])
+			p = p[end:]
+		}
+	}
+	return keys, nil
+}
+
+func (p *Predicate) lookup(data interface{}) (interface{}, bool) {
+	keys := p.keys
+	if keys == nil {
+		var err error
+		if keys, err = parsePath(p.Path); err != nil {
+			return nil, false
+		}
+	}
+	for _, k := range keys {
+		switch key := k.(type) {
+		case string:
+			obj, ok := data.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if data, ok = obj[key]; !ok {
+				return nil, false
+			}
+		case int:
+			arr, ok := data.([]interface{})
+			if !ok || key >= len(arr) {
+				return nil, false
+			}
+			data = arr[key]
+		}
+	}
+	return data, true
+}
+
+// Eval checks whether the data decoded from json satisfies the predicate.
+func (p *Predicate) Eval(data interface{}) bool {
+	v, ok := p.lookup(data)
+	if p.Op == OpExists {
+		return ok
+	}
+	if !ok {
+		return false
+	}
+	var s string
+	switch value := v.(type) {
+	case string:
+		s = value
+	case float64:
+		s = strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		b, _ := json.Marshal(value)
+		s = string(b)
+	}
+
+	var cmp int
+	l, err1 := strconv.ParseFloat(s, 64)
+	r, err2 := strconv.ParseFloat(p.Value, 64)
+	switch {
+	case err1 == nil && err2 == nil && l < r:
+		cmp = -1
+	case err1 == nil && err2 == nil && l > r:
+		cmp = 1
+	case err1 == nil && err2 == nil:
+		cmp = 0
+	default:
+		cmp = strings.Compare(s, p.Value)
+	}
+
+	switch p.Op {
+	case OpEq:
+		return cmp == 0
+	case OpNe:
+		return cmp != 0
+	case OpGt:
+		return cmp > 0
+	case OpGte:
+		return cmp >= 0
+	case OpLt:
+		return cmp < 0
+	case OpLte:
+		return cmp <= 0
+	}
+	return false
+}
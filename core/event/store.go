@@ -0,0 +1,220 @@
+package event
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/iost-official/go-iost/v3/db/kv"
+)
+
+// The key layout of the event storage
+//
+//	e/<blockNumber><index>  event
+//	/height                 number of stored blocks
+//	/start                  number of the first stored block
+var (
+	eventPrefix = []byte("e/")
+	heightKey   = []byte("/height")
+	startKey    = []byte("/start")
+)
+
+// error of event store
+var (
+	ErrBlockNotNext   = fmt.Errorf("block is not the next block to store")
+	ErrCursorNotStart = fmt.Errorf("cursor is before the first block of the event store")
+)
+
+// Cursor is the position of a stored event. Events of a block are indexed from 1,
+// so the cursor with index 0 is right before the first event of the block.
+type Cursor struct {
+	BlockNumber int64
+	Index       int32
+}
+
+func (c *Cursor) key() []byte {
+	k := make([]byte, len(eventPrefix)+12)
+	copy(k, eventPrefix)
+	binary.BigEndian.PutUint64(k[len(eventPrefix):], uint64(c.BlockNumber))
+	binary.BigEndian.PutUint32(k[len(eventPrefix)+8:], uint32(c.Index))
+	return k
+}
+
+type storedEvent struct {
+	Topic Topic  `json:"topic"`
+	Data  string `json:"data"`
+	Time  int64  `json:"time"`
+	Meta  *Meta  `json:"meta"`
+}
+
+// Store persists the events of irreversible blocks, so subscribers can replay them from a cursor.
+type Store struct {
+	storage *kv.Storage
+	height  int64
+	start   int64
+	begun   bool
+	notify  chan struct{}
+	mu      sync.RWMutex
+}
+
+// NewStore returns a Store stored at path.
+func NewStore(path string) (*Store, error) {
+	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to new storage: %v", err)
+	}
+	height, err := storage.Get(heightKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get height: %v", err)
+	}
+	s := &Store{
+		storage: storage,
+		notify:  make(chan struct{}),
+	}
+	if len(height) == 8 {
+		s.height = int64(binary.BigEndian.Uint64(height))
+	}
+	start, err := storage.Get(startKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get start: %v", err)
+	}
+	if len(start) == 8 {
+		s.start = int64(binary.BigEndian.Uint64(start))
+		s.begun = true
+	}
+	return s, nil
+}
+
+// Begin starts storing the events from the block, which is the first block whose contract events are recorded. The
+// blocks before it are never stored. It does nothing if the store has begun, and a store without the start from
+// before begins at its height.
+func (s *Store) Begin(number int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.begun {
+		return nil
+	}
+	if s.height > 0 {
+		number = s.height
+	}
+	if err := s.storage.BeginBatch(); err != nil {
+		return err
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(number))
+	if err := s.storage.Put(startKey, b); err != nil {
+		return err
+	}
+	if err := s.storage.Put(heightKey, b); err != nil {
+		return err
+	}
+	if err := s.storage.CommitBatch(); err != nil {
+		return err
+	}
+	s.start, s.height, s.begun = number, number, true
+	return nil
+}
+
+// Start returns the number of the first stored block.
+func (s *Store) Start() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.start
+}
+
+// Height returns the number of stored blocks, which is also the number of the next block to store.
+func (s *Store) Height() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.height
+}
+
+// Append stores the events of the block. Blocks must be appended in order.
+func (s *Store) Append(number int64, events []*Event, metas []*Meta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if number != s.height {
+		return ErrBlockNotNext
+	}
+	if err := s.storage.BeginBatch(); err != nil {
+		return err
+	}
+	for i, e := range events {
+		c := &Cursor{BlockNumber: number, Index: int32(i + 1)}
+		v, err := json.Marshal(&storedEvent{Topic: e.Topic, Data: e.Data, Time: e.Time, Meta: metas[i]})
+		if err != nil {
+			return err
+		}
+		if err := s.storage.Put(c.key(), v); err != nil {
+			return err
+		}
+	}
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(number+1))
+	if err := s.storage.Put(heightKey, height); err != nil {
+		return err
+	}
+	if err := s.storage.CommitBatch(); err != nil {
+		return err
+	}
+	s.height = number + 1
+	close(s.notify)
+	s.notify = make(chan struct{})
+	return nil
+}
+
+// Read returns at most limit events after the cursor with their metas. A cursor before the first stored block is
+// refused, since the events there are not kept.
+func (s *Store) Read(after *Cursor, limit int) ([]*Event, []*Meta, error) {
+	if after.BlockNumber < s.Start() {
+		return nil, nil, ErrCursorNotStart
+	}
+	from := append(after.key(), 0)
+	keys, err := s.storage.KeysByRange(from, []byte{eventPrefix[0], eventPrefix[1] + 1}, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	events := make([]*Event, 0, len(keys))
+	metas := make([]*Meta, 0, len(keys))
+	for _, k := range keys {
+		v, err := s.storage.Get(k)
+		if err != nil {
+			return nil, nil, err
+		}
+		var se storedEvent
+		if err := json.Unmarshal(v, &se); err != nil {
+			return nil, nil, err
+		}
+		events = append(events, &Event{
+			Topic: se.Topic,
+			Data:  se.Data,
+			Time:  se.Time,
+			Cursor: &Cursor{
+				BlockNumber: int64(binary.BigEndian.Uint64(k[len(eventPrefix):])),
+				Index:       int32(binary.BigEndian.Uint32(k[len(eventPrefix)+8:])),
+			},
+		})
+		metas = append(metas, se.Meta)
+	}
+	return events, metas, nil
+}
+
+// Wait returns a channel which is closed when new events are appended.
+func (s *Store) Wait() <-chan struct{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.notify
+}
+
+// Close closes the event storage.
+func (s *Store) Close() error {
+	return s.storage.Close()
+}
+
+// IsDurable returns whether the events of the topic are kept in the Store.
+func IsDurable(topic Topic) bool {
+	return topic == ContractReceipt || topic == ContractEvent || topic == IrreversibleBlock
+}
@@ -0,0 +1,86 @@
+package event_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	defer os.RemoveAll("event_store_test")
+	store, err := event.NewStore("event_store_test")
+	require.Nil(t, err)
+
+	wait := store.Wait()
+	require.Nil(t, store.Append(0, []*event.Event{event.NewEvent(event.IrreversibleBlock, "b0")}, []*event.Meta{{Witness: "w0"}}))
+	select {
+	case <-wait:
+	default:
+		t.Fatal("wait channel is not closed after append")
+	}
+	require.Nil(t, store.Append(1, []*event.Event{
+		event.NewEvent(event.ContractReceipt, "r1"),
+		event.NewEvent(event.ContractReceipt, "r2"),
+		event.NewEvent(event.IrreversibleBlock, "b1"),
+	}, []*event.Meta{{ContractID: "token.iost"}, {ContractID: "base.iost"}, {Witness: "w1"}}))
+	assert.Equal(t, event.ErrBlockNotNext, store.Append(3, nil, nil))
+	assert.Equal(t, int64(2), store.Height())
+
+	evs, metas, err := store.Read(&event.Cursor{BlockNumber: 0, Index: 0}, 10)
+	require.Nil(t, err)
+	require.Len(t, evs, 4)
+	assert.Equal(t, "b0", evs[0].Data)
+	assert.Equal(t, &event.Cursor{BlockNumber: 0, Index: 1}, evs[0].Cursor)
+	assert.Equal(t, "w0", metas[0].Witness)
+	assert.Equal(t, event.ContractReceipt, evs[1].Topic)
+	assert.Equal(t, "base.iost", metas[2].ContractID)
+	assert.Equal(t, &event.Cursor{BlockNumber: 1, Index: 3}, evs[3].Cursor)
+
+	evs, _, err = store.Read(&event.Cursor{BlockNumber: 1, Index: 1}, 1)
+	require.Nil(t, err)
+	require.Len(t, evs, 1)
+	assert.Equal(t, "r2", evs[0].Data)
+
+	evs, _, err = store.Read(&event.Cursor{BlockNumber: 1, Index: 3}, 10)
+	require.Nil(t, err)
+	assert.Empty(t, evs)
+	require.Nil(t, store.Close())
+
+	store, err = event.NewStore("event_store_test")
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), store.Height())
+	require.Nil(t, store.Close())
+}
+
+func TestStoreBegin(t *testing.T) {
+	defer os.RemoveAll("event_store_begin_test")
+	store, err := event.NewStore("event_store_begin_test")
+	require.Nil(t, err)
+
+	// the store begins at the first block whose contract events are recorded, e.g. after a snapshot import
+	require.Nil(t, store.Begin(100))
+	assert.Equal(t, int64(100), store.Start())
+	assert.Equal(t, int64(100), store.Height())
+	assert.Equal(t, event.ErrBlockNotNext, store.Append(0, nil, nil))
+	require.Nil(t, store.Append(100, []*event.Event{event.NewEvent(event.IrreversibleBlock, "b100")}, []*event.Meta{{Witness: "w0"}}))
+
+	_, _, err = store.Read(&event.Cursor{}, 10)
+	assert.Equal(t, event.ErrCursorNotStart, err)
+	_, _, err = store.Read(&event.Cursor{BlockNumber: 99, Index: 5}, 10)
+	assert.Equal(t, event.ErrCursorNotStart, err)
+	evs, _, err := store.Read(&event.Cursor{BlockNumber: 100}, 10)
+	require.Nil(t, err)
+	require.Len(t, evs, 1)
+	require.Nil(t, store.Close())
+
+	// the start is kept across restarts
+	store, err = event.NewStore("event_store_begin_test")
+	require.Nil(t, err)
+	require.Nil(t, store.Begin(200))
+	assert.Equal(t, int64(100), store.Start())
+	assert.Equal(t, int64(101), store.Height())
+	require.Nil(t, store.Close())
+}
@@ -1,6 +1,7 @@
 package merkletree
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"math"
@@ -69,6 +70,36 @@ func (m *MerkleTree) MerklePath(hash []byte) ([][]byte, error) {
 	return mp, nil
 }
 
+// MerkleProof returns the index of the hash in the data and the merkle path of it, which is checked by VerifyMerklePath.
+func (m *MerkleTree) MerkleProof(hash []byte) (int32, [][]byte, error) {
+	mp, err := m.MerklePath(hash)
+	if err != nil {
+		return 0, nil, err
+	}
+	idx := m.Hash2Idx[hex.EncodeToString(hash)] - (m.LeafNum - 1)
+	if m.LeafNum == 1 {
+		// the root of a single hash is the hash of it doubled
+		mp = [][]byte{hash}
+	}
+	return idx, mp, nil
+}
+
+// VerifyMerklePath checks that the hash at the index of the data is in the merkle tree of the root.
+func VerifyMerklePath(rootHash []byte, hash []byte, idx int32, mp [][]byte) bool {
+	if idx < 0 || len(mp) == 0 || len(mp) > 31 || int64(idx) >= int64(1)<<uint(len(mp)) {
+		return false
+	}
+	for _, p := range mp {
+		if idx%2 == 0 {
+			hash = common.Sha3(append(append([]byte{}, hash...), p...))
+		} else {
+			hash = common.Sha3(append(append([]byte{}, p...), hash...))
+		}
+		idx /= 2
+	}
+	return bytes.Equal(hash, rootHash)
+}
+
 // MerkleProve is prove of the merkle tree
 //func (m *MerkleTree) MerkleProve(hash []byte, rootHash []byte, mp [][]byte) (bool, error) {
 //	if hash == nil {
@@ -65,6 +65,32 @@ func TestMerkleTree(t *testing.T) {
 	})
 }
 
+func TestMerkleProof(t *testing.T) {
+	Convey("Test of MT proof", t, func() {
+		for n := 1; n <= 17; n++ {
+			var data [][]byte
+			for i := 0; i < n; i++ {
+				data = append(data, RandHash(32))
+			}
+			m := MerkleTree{}
+			m.Build(data)
+			root := m.RootHash()
+			for i, datum := range data {
+				idx, mp, err := m.MerkleProof(datum)
+				So(err, ShouldBeNil)
+				So(idx, ShouldEqual, i)
+				So(VerifyMerklePath(root, datum, idx, mp), ShouldBeTrue)
+				So(VerifyMerklePath(root, RandHash(32), idx, mp), ShouldBeFalse)
+				So(VerifyMerklePath(root, datum, idx, mp[:len(mp)-1]), ShouldBeFalse)
+				mp[len(mp)-1] = RandHash(32)
+				So(VerifyMerklePath(root, datum, idx, mp), ShouldBeFalse)
+			}
+			_, _, err := m.MerkleProof(RandHash(32))
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
+
 func BenchmarkBuild(b *testing.B) { // 646503ns = 0.6ms，vs 117729ns = 0.1ms
 	rand.Seed(time.Now().UnixNano())
 	var data [][]byte
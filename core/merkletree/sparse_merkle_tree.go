@@ -0,0 +1,350 @@
+package merkletree
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/iost-official/go-iost/v3/common"
+)
+
+// errors of the sparse merkle tree
+var (
+	ErrNodeNotFound  = errors.New("sparse merkle tree node not found")
+	ErrInvalidNode   = errors.New("invalid sparse merkle tree node")
+	ErrInvalidProof  = errors.New("invalid sparse merkle proof")
+	ErrProofNotMatch = errors.New("sparse merkle proof does not match the root")
+)
+
+const (
+	smtHashLen  = 32
+	smtDepth    = smtHashLen * 8
+	smtLeaf     = byte(0)
+	smtInternal = byte(1)
+)
+
+// SMTEmptyRoot is the root of the sparse merkle tree without any key.
+var SMTEmptyRoot = make([]byte, smtHashLen)
+
+// NodeStore stores the nodes of a sparse merkle tree by their hash.
+type NodeStore interface {
+	// Get returns nil if the node does not exist.
+	Get(hash []byte) ([]byte, error)
+	Put(hash []byte, node []byte) error
+}
+
+// SparseMerkleTree is a merkle tree of 256 levels indexed by the hash of keys. A subtree holding a single key is
+// stored as the leaf of the key, so the tree only has about log(n) levels, and its root only depends on the keys
+// and values in it, not on the order they are updated.
+//
+// leaf     = sha3(0x00 | sha3(key) | sha3(value))
+// internal = sha3(0x01 | left | right)
+// empty    = 32 zero bytes
+//
+// The nodes are never deleted by Update, so every root updated before stays readable. UpdateStale also returns the
+// nodes only the old root reaches, for a store keeping the latest root only.
+type SparseMerkleTree struct {
+	store NodeStore
+	// visited and created are the nodes read on the changed paths and written by UpdateStale.
+	visited map[string]bool
+	created map[string]bool
+}
+
+// NewSparseMerkleTree returns a sparse merkle tree whose nodes are stored in store.
+func NewSparseMerkleTree(store NodeStore) *SparseMerkleTree {
+	return &SparseMerkleTree{store: store}
+}
+
+// SparseMerkleProof proves the value of a key, or that the key does not exist, with the path from the root to the key.
+type SparseMerkleProof struct {
+	// Siblings are the hashes of the siblings along the path from the root.
+	Siblings [][]byte
+	// LeafKey and LeafValue are the hashes of the leaf at the end of the path, they are nil if the path ends at an
+	// empty subtree. A leaf of another key proves that the key does not exist.
+	LeafKey   []byte
+	LeafValue []byte
+}
+
+type smtLeafNode struct {
+	key   []byte
+	value []byte // nil to delete the key
+}
+
+func smtBit(key []byte, depth int) byte {
+	return (key[depth/8] >> (7 - uint(depth%8))) & 1
+}
+
+func isEmptyHash(h []byte) bool {
+	return len(h) == 0 || bytes.Equal(h, SMTEmptyRoot)
+}
+
+func encodeLeaf(key, value []byte) []byte {
+	return append(append([]byte{smtLeaf}, key...), value...)
+}
+
+func encodeInternal(left, right []byte) []byte {
+	return append(append([]byte{smtInternal}, left...), right...)
+}
+
+func (t *SparseMerkleTree) put(node []byte) ([]byte, error) {
+	h := common.Sha3(node)
+	if err := t.store.Put(h, node); err != nil {
+		return nil, err
+	}
+	if t.created != nil {
+		t.created[string(h)] = true
+	}
+	return h, nil
+}
+
+func (t *SparseMerkleTree) get(hash []byte) ([]byte, error) {
+	node, err := t.store.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, ErrNodeNotFound
+	}
+	if len(node) != 1+2*smtHashLen || (node[0] != smtLeaf && node[0] != smtInternal) {
+		return nil, ErrInvalidNode
+	}
+	return node, nil
+}
+
+// Update sets the values of the keys in the tree of root, and returns the new root. A nil value deletes the key.
+func (t *SparseMerkleTree) Update(root []byte, keys, values [][]byte) ([]byte, error) {
+	if len(keys) != len(values) {
+		return nil, errors.New("keys and values have different lengths")
+	}
+	changes := make(map[string]*smtLeafNode, len(keys))
+	for i, k := range keys {
+		l := &smtLeafNode{key: common.Sha3(k)}
+		if values[i] != nil {
+			l.value = common.Sha3(values[i])
+		}
+		changes[string(l.key)] = l
+	}
+	leaves := make([]*smtLeafNode, 0, len(changes))
+	for _, l := range changes {
+		leaves = append(leaves, l)
+	}
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].key, leaves[j].key) < 0
+	})
+	h, _, err := t.update(root, 0, leaves)
+	if err != nil {
+		return nil, err
+	}
+	if isEmptyHash(h) {
+		return SMTEmptyRoot, nil
+	}
+	return h, nil
+}
+
+// UpdateStale is Update which also returns the hashes of the nodes of the old tree that are not in the new tree.
+func (t *SparseMerkleTree) UpdateStale(root []byte, keys, values [][]byte) ([]byte, [][]byte, error) {
+	t.visited = make(map[string]bool)
+	t.created = make(map[string]bool)
+	defer func() {
+		t.visited = nil
+		t.created = nil
+	}()
+	newRoot, err := t.Update(root, keys, values)
+	if err != nil {
+		return nil, nil, err
+	}
+	stale := make([][]byte, 0, len(t.visited))
+	for h := range t.visited {
+		if !t.created[h] {
+			stale = append(stale, []byte(h))
+		}
+	}
+	return newRoot, stale, nil
+}
+
+// update applies the sorted changes to the subtree at depth, and returns the new subtree and whether it is a leaf.
+func (t *SparseMerkleTree) update(h []byte, depth int, changes []*smtLeafNode) ([]byte, bool, error) {
+	if isEmptyHash(h) {
+		return t.build(depth, changes)
+	}
+	node, err := t.get(h)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(changes) == 0 {
+		return h, node[0] == smtLeaf, nil
+	}
+	if t.visited != nil {
+		t.visited[string(h)] = true
+	}
+	if node[0] == smtLeaf {
+		key := node[1 : 1+smtHashLen]
+		i := sort.Search(len(changes), func(i int) bool {
+			return bytes.Compare(changes[i].key, key) >= 0
+		})
+		if i == len(changes) || !bytes.Equal(changes[i].key, key) {
+			merged := make([]*smtLeafNode, 0, len(changes)+1)
+			merged = append(merged, changes[:i]...)
+			merged = append(merged, &smtLeafNode{key: key, value: node[1+smtHashLen:]})
+			changes = append(merged, changes[i:]...)
+		}
+		return t.build(depth, changes)
+	}
+	if depth >= smtDepth {
+		return nil, false, ErrInvalidNode
+	}
+	i := splitByBit(changes, depth)
+	left, leftIsLeaf, err := t.update(node[1:1+smtHashLen], depth+1, changes[:i])
+	if err != nil {
+		return nil, false, err
+	}
+	right, rightIsLeaf, err := t.update(node[1+smtHashLen:], depth+1, changes[i:])
+	if err != nil {
+		return nil, false, err
+	}
+	return t.join(left, leftIsLeaf, right, rightIsLeaf)
+}
+
+// build builds the subtree at depth from the sorted leaves, skipping the deleted ones.
+func (t *SparseMerkleTree) build(depth int, leaves []*smtLeafNode) ([]byte, bool, error) {
+	n := 0
+	var last *smtLeafNode
+	for _, l := range leaves {
+		if l.value != nil {
+			n++
+			last = l
+		}
+	}
+	switch {
+	case n == 0:
+		return SMTEmptyRoot, false, nil
+	case n == 1:
+		h, err := t.put(encodeLeaf(last.key, last.value))
+		return h, true, err
+	case depth >= smtDepth:
+		return nil, false, ErrInvalidNode
+	}
+	i := splitByBit(leaves, depth)
+	left, leftIsLeaf, err := t.build(depth+1, leaves[:i])
+	if err != nil {
+		return nil, false, err
+	}
+	right, rightIsLeaf, err := t.build(depth+1, leaves[i:])
+	if err != nil {
+		return nil, false, err
+	}
+	return t.join(left, leftIsLeaf, right, rightIsLeaf)
+}
+
+// join makes the parent of two subtrees, a leaf beside an empty subtree is lifted up as the parent.
+func (t *SparseMerkleTree) join(left []byte, leftIsLeaf bool, right []byte, rightIsLeaf bool) ([]byte, bool, error) {
+	switch {
+	case isEmptyHash(left) && isEmptyHash(right):
+		return SMTEmptyRoot, false, nil
+	case isEmptyHash(left) && rightIsLeaf:
+		return right, true, nil
+	case isEmptyHash(right) && leftIsLeaf:
+		return left, true, nil
+	}
+	h, err := t.put(encodeInternal(left, right))
+	return h, false, err
+}
+
+// splitByBit returns the index of the first key whose bit at depth is 1 in the sorted leaves.
+func splitByBit(leaves []*smtLeafNode, depth int) int {
+	return sort.Search(len(leaves), func(i int) bool {
+		return smtBit(leaves[i].key, depth) == 1
+	})
+}
+
+// Prove returns the proof of the key in the tree of root.
+func (t *SparseMerkleTree) Prove(root, key []byte) (*SparseMerkleProof, error) {
+	k := common.Sha3(key)
+	proof := &SparseMerkleProof{}
+	h := root
+	for depth := 0; !isEmptyHash(h); depth++ {
+		node, err := t.get(h)
+		if err != nil {
+			return nil, err
+		}
+		if node[0] == smtLeaf {
+			proof.LeafKey = node[1 : 1+smtHashLen]
+			proof.LeafValue = node[1+smtHashLen:]
+			break
+		}
+		if depth >= smtDepth {
+			return nil, ErrInvalidNode
+		}
+		left, right := node[1:1+smtHashLen], node[1+smtHashLen:]
+		if smtBit(k, depth) == 0 {
+			proof.Siblings = append(proof.Siblings, right)
+			h = left
+		} else {
+			proof.Siblings = append(proof.Siblings, left)
+			h = right
+		}
+	}
+	return proof, nil
+}
+
+// Get returns the hash of the value of the key in the tree of root, or nil if the key does not exist.
+func (t *SparseMerkleTree) Get(root, key []byte) ([]byte, error) {
+	proof, err := t.Prove(root, key)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(proof.LeafKey, common.Sha3(key)) {
+		return nil, nil
+	}
+	return proof.LeafValue, nil
+}
+
+// VerifySparseMerkleProof checks that the key has the value in the tree of root, or that the key does not exist if
+// value is nil.
+func VerifySparseMerkleProof(root, key, value []byte, proof *SparseMerkleProof) error {
+	if proof == nil || len(proof.Siblings) > smtDepth {
+		return ErrInvalidProof
+	}
+	k := common.Sha3(key)
+	var h []byte
+	switch {
+	case proof.LeafKey == nil:
+		if value != nil {
+			return ErrProofNotMatch
+		}
+		h = SMTEmptyRoot
+	case len(proof.LeafKey) != smtHashLen || len(proof.LeafValue) != smtHashLen:
+		return ErrInvalidProof
+	case bytes.Equal(proof.LeafKey, k):
+		if value == nil || !bytes.Equal(proof.LeafValue, common.Sha3(value)) {
+			return ErrProofNotMatch
+		}
+		h = common.Sha3(encodeLeaf(proof.LeafKey, proof.LeafValue))
+	default:
+		// a leaf of another key on the path of the key proves the key does not exist
+		if value != nil {
+			return ErrProofNotMatch
+		}
+		for depth := range proof.Siblings {
+			if smtBit(proof.LeafKey, depth) != smtBit(k, depth) {
+				return ErrInvalidProof
+			}
+		}
+		h = common.Sha3(encodeLeaf(proof.LeafKey, proof.LeafValue))
+	}
+	for depth := len(proof.Siblings) - 1; depth >= 0; depth-- {
+		sibling := proof.Siblings[depth]
+		if len(sibling) != smtHashLen {
+			return ErrInvalidProof
+		}
+		if smtBit(k, depth) == 0 {
+			h = common.Sha3(encodeInternal(h, sibling))
+		} else {
+			h = common.Sha3(encodeInternal(sibling, h))
+		}
+	}
+	if !bytes.Equal(h, root) && !(isEmptyHash(h) && isEmptyHash(root)) {
+		return ErrProofNotMatch
+	}
+	return nil
+}
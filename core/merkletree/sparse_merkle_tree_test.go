@@ -0,0 +1,131 @@
+package merkletree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type memNodeStore map[string][]byte
+
+func (s memNodeStore) Get(hash []byte) ([]byte, error) {
+	return s[string(hash)], nil
+}
+
+func (s memNodeStore) Put(hash []byte, node []byte) error {
+	s[string(hash)] = node
+	return nil
+}
+
+func TestSparseMerkleTree(t *testing.T) {
+	Convey("Test of SMT", t, func() {
+		keys := make([][]byte, 0)
+		values := make([][]byte, 0)
+		for i := 0; i < 100; i++ {
+			keys = append(keys, []byte(fmt.Sprintf("key%v", i)))
+			values = append(values, RandHash(10))
+		}
+		tree := NewSparseMerkleTree(memNodeStore{})
+
+		Convey("root does not depend on the order of updates", func() {
+			root, err := tree.Update(SMTEmptyRoot, keys, values)
+			So(err, ShouldBeNil)
+
+			other := NewSparseMerkleTree(memNodeStore{})
+			root2 := SMTEmptyRoot
+			for _, i := range rand.Perm(len(keys)) {
+				root2, err = other.Update(root2, keys[i:i+1], values[i:i+1])
+				So(err, ShouldBeNil)
+			}
+			So(root2, ShouldResemble, root)
+
+			root3, err := tree.Update(root, keys[:50], make([][]byte, 50))
+			So(err, ShouldBeNil)
+			root4, err := tree.Update(SMTEmptyRoot, keys[50:], values[50:])
+			So(err, ShouldBeNil)
+			So(root3, ShouldResemble, root4)
+
+			root5, err := tree.Update(root, keys, make([][]byte, len(keys)))
+			So(err, ShouldBeNil)
+			So(root5, ShouldResemble, SMTEmptyRoot)
+		})
+
+		Convey("prove keys", func() {
+			root, err := tree.Update(SMTEmptyRoot, keys[:50], values[:50])
+			So(err, ShouldBeNil)
+			newRoot, err := tree.Update(root, keys[:1], [][]byte{[]byte("new")})
+			So(err, ShouldBeNil)
+
+			for i := 0; i < 50; i++ {
+				proof, err := tree.Prove(root, keys[i])
+				So(err, ShouldBeNil)
+				So(VerifySparseMerkleProof(root, keys[i], values[i], proof), ShouldBeNil)
+				So(VerifySparseMerkleProof(root, keys[i], []byte("wrong"), proof), ShouldEqual, ErrProofNotMatch)
+				So(VerifySparseMerkleProof(root, keys[i], nil, proof), ShouldEqual, ErrProofNotMatch)
+			}
+			for i := 50; i < 100; i++ {
+				proof, err := tree.Prove(root, keys[i])
+				So(err, ShouldBeNil)
+				So(VerifySparseMerkleProof(root, keys[i], nil, proof), ShouldBeNil)
+				So(VerifySparseMerkleProof(root, keys[i], values[i], proof), ShouldEqual, ErrProofNotMatch)
+			}
+
+			proof, err := tree.Prove(newRoot, keys[0])
+			So(err, ShouldBeNil)
+			So(VerifySparseMerkleProof(newRoot, keys[0], []byte("new"), proof), ShouldBeNil)
+			So(VerifySparseMerkleProof(root, keys[0], []byte("new"), proof), ShouldEqual, ErrProofNotMatch)
+
+			proof.Siblings[0] = RandHash(32)
+			So(VerifySparseMerkleProof(newRoot, keys[0], []byte("new"), proof), ShouldEqual, ErrProofNotMatch)
+
+			value, err := tree.Get(root, keys[1])
+			So(err, ShouldBeNil)
+			So(value, ShouldNotBeNil)
+			value, err = tree.Get(root, keys[99])
+			So(err, ShouldBeNil)
+			So(value, ShouldBeNil)
+		})
+
+		Convey("stale nodes", func() {
+			store := memNodeStore{}
+			tree := NewSparseMerkleTree(store)
+			root, err := tree.Update(SMTEmptyRoot, keys, values)
+			So(err, ShouldBeNil)
+			// change 10 keys, keep 10 and delete 10
+			changed := make([][]byte, 30)
+			for i := 0; i < 10; i++ {
+				changed[i] = RandHash(10)
+				changed[10+i] = values[10+i]
+			}
+			newRoot, stale, err := tree.UpdateStale(root, keys[:30], changed)
+			So(err, ShouldBeNil)
+			for _, h := range stale {
+				delete(store, string(h))
+			}
+
+			fresh := memNodeStore{}
+			want, err := NewSparseMerkleTree(fresh).Update(SMTEmptyRoot, append(append([][]byte{}, keys[:20]...), keys[30:]...), append(append([][]byte{}, changed[:20]...), values[30:]...))
+			So(err, ShouldBeNil)
+			So(newRoot, ShouldResemble, want)
+			So(len(store), ShouldEqual, len(fresh))
+			for i := 30; i < 100; i++ {
+				value, err := tree.Get(newRoot, keys[i])
+				So(err, ShouldBeNil)
+				So(value, ShouldNotBeNil)
+			}
+		})
+
+		Convey("prove in the empty tree", func() {
+			proof, err := tree.Prove(SMTEmptyRoot, keys[0])
+			So(err, ShouldBeNil)
+			So(VerifySparseMerkleProof(SMTEmptyRoot, keys[0], nil, proof), ShouldBeNil)
+		})
+
+		Convey("missing nodes", func() {
+			_, err := tree.Prove(RandHash(32), keys[0])
+			So(err, ShouldEqual, ErrNodeNotFound)
+		})
+	})
+}
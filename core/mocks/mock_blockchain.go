@@ -118,6 +118,19 @@ func (mr *MockChainMockRecorder) GetBlockNumberByTxHash(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockNumberByTxHash", reflect.TypeOf((*MockChain)(nil).GetBlockNumberByTxHash), arg0)
 }
 
+// GetContractEventsByTxHash mocks base method
+func (m *MockChain) GetContractEventsByTxHash(arg0 []byte) ([]*tx.Receipt, error) {
+	ret := m.ctrl.Call(m, "GetContractEventsByTxHash", arg0)
+	ret0, _ := ret[0].([]*tx.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractEventsByTxHash indicates an expected call of GetContractEventsByTxHash
+func (mr *MockChainMockRecorder) GetContractEventsByTxHash(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractEventsByTxHash", reflect.TypeOf((*MockChain)(nil).GetContractEventsByTxHash), arg0)
+}
+
 // GetHashByNumber mocks base method
 func (m *MockChain) GetHashByNumber(arg0 int64) ([]byte, error) {
 	ret := m.ctrl.Call(m, "GetHashByNumber", arg0)
@@ -157,6 +170,19 @@ func (mr *MockChainMockRecorder) GetReceiptByTxHash(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptByTxHash", reflect.TypeOf((*MockChain)(nil).GetReceiptByTxHash), arg0)
 }
 
+// GetStateDiffByTxHash mocks base method
+func (m *MockChain) GetStateDiffByTxHash(arg0 []byte) ([]*tx.StateChange, error) {
+	ret := m.ctrl.Call(m, "GetStateDiffByTxHash", arg0)
+	ret0, _ := ret[0].([]*tx.StateChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateDiffByTxHash indicates an expected call of GetStateDiffByTxHash
+func (mr *MockChainMockRecorder) GetStateDiffByTxHash(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateDiffByTxHash", reflect.TypeOf((*MockChain)(nil).GetStateDiffByTxHash), arg0)
+}
+
 // GetTx mocks base method
 func (m *MockChain) GetTx(arg0 []byte) (*tx.Tx, error) {
 	ret := m.ctrl.Call(m, "GetTx", arg0)
@@ -220,6 +246,18 @@ func (mr *MockChainMockRecorder) Push(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockChain)(nil).Push), arg0)
 }
 
+// RestoreDelaytx mocks base method
+func (m *MockChain) RestoreDelaytx(arg0 *tx.Tx) error {
+	ret := m.ctrl.Call(m, "RestoreDelaytx", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDelaytx indicates an expected call of RestoreDelaytx
+func (mr *MockChainMockRecorder) RestoreDelaytx(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDelaytx", reflect.TypeOf((*MockChain)(nil).RestoreDelaytx), arg0)
+}
+
 // SetLength mocks base method
 func (m *MockChain) SetLength(arg0 int64) {
 	m.ctrl.Call(m, "SetLength", arg0)
@@ -0,0 +1,34 @@
+package tx
+
+import (
+	txpb "github.com/iost-official/go-iost/v3/core/tx/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// EncodeContractEvents encodes the contract events of a transaction as byte array.
+func EncodeContractEvents(events []*Receipt) []byte {
+	ce := &txpb.ContractEvents{}
+	for _, e := range events {
+		ce.Events = append(ce.Events, e.ToPb())
+	}
+	b, err := proto.Marshal(ce)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// DecodeContractEvents decodes the contract events of a transaction from byte array.
+func DecodeContractEvents(b []byte) ([]*Receipt, error) {
+	ce := &txpb.ContractEvents{}
+	err := proto.Unmarshal(b, ce)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*Receipt, 0, len(ce.Events))
+	for _, e := range ce.Events {
+		r := &Receipt{}
+		events = append(events, r.FromPb(e))
+	}
+	return events, nil
+}
@@ -0,0 +1,65 @@
+package tx
+
+import (
+	txpb "github.com/iost-official/go-iost/v3/core/tx/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// StateChange is a change of contract storage made by a transaction.
+// Field is empty for basic keys, and absent values are empty.
+type StateChange struct {
+	Contract string
+	Key      string
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+// ToPb convert StateChange to proto buf data structure.
+func (c *StateChange) ToPb() *txpb.StateChange {
+	return &txpb.StateChange{
+		Contract: c.Contract,
+		Key:      c.Key,
+		Field:    c.Field,
+		OldValue: c.OldValue,
+		NewValue: c.NewValue,
+	}
+}
+
+// FromPb convert StateChange from proto buf data structure.
+func (c *StateChange) FromPb(sc *txpb.StateChange) *StateChange {
+	c.Contract = sc.Contract
+	c.Key = sc.Key
+	c.Field = sc.Field
+	c.OldValue = sc.OldValue
+	c.NewValue = sc.NewValue
+	return c
+}
+
+// EncodeStateDiff encodes the state changes of a transaction as byte array.
+func EncodeStateDiff(diff []*StateChange) []byte {
+	sd := &txpb.StateDiff{}
+	for _, c := range diff {
+		sd.Changes = append(sd.Changes, c.ToPb())
+	}
+	b, err := proto.Marshal(sd)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// DecodeStateDiff decodes the state changes of a transaction from byte array.
+func DecodeStateDiff(b []byte) ([]*StateChange, error) {
+	sd := &txpb.StateDiff{}
+	err := proto.Unmarshal(b, sd)
+	if err != nil {
+		return nil, err
+	}
+	diff := make([]*StateChange, 0, len(sd.Changes))
+	for _, sc := range sd.Changes {
+		c := &StateChange{}
+		diff = append(diff, c.FromPb(sc))
+	}
+	return diff, nil
+}
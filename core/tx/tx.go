@@ -348,6 +348,17 @@ func (t *Tx) CheckGas() error {
 	return nil
 }
 
+// ClampGasLimit returns the nearest legal gas limit.
+func ClampGasLimit(limit int64) int64 {
+	if limit < minGasLimit {
+		return minGasLimit
+	}
+	if limit > maxGasLimit {
+		return maxGasLimit
+	}
+	return limit
+}
+
 // ToBytes converts tx to bytes.
 func (t *Tx) ToBytes(l ToBytesLevel) []byte {
 	se := common.NewSimpleEncoder()
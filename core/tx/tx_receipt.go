@@ -104,6 +104,12 @@ type TxReceipt struct { //nolint:golint
 	Status   *Status
 	Returns  []string
 	Receipts []*Receipt
+
+	// StateDiff is only set when the executor records it. It is not part of the encoding or the hash.
+	StateDiff []*StateChange
+	// Events are the contract events posted by the successful actions, with the function name of the
+	// posting action. Like StateDiff, they are only set when the executor records them.
+	Events []*Receipt
 }
 
 // NewTxReceipt generate tx receipt for a tx hash
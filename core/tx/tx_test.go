@@ -189,6 +189,14 @@ func TestTx(t *testing.T) {
 	})
 }
 
+func TestClampGasLimit(t *testing.T) {
+	Convey("Test of ClampGasLimit", t, func() {
+		So(ClampGasLimit(1), ShouldEqual, minGasLimit)
+		So(ClampGasLimit(1000000), ShouldEqual, 1000000)
+		So(ClampGasLimit(maxGasLimit+1), ShouldEqual, maxGasLimit)
+	})
+}
+
 func TestTx_Platform(t *testing.T) {
 	//t.Skip()
 	//var sep = `\` + "`" + "^" + "/" + "<"
@@ -0,0 +1,225 @@
+package txindex
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/db/kv"
+)
+
+// Role is the bit set of the roles of an account in a transaction.
+type Role uint8
+
+// The roles of an account in a transaction
+const (
+	RolePublisher Role = 1 << iota
+	RoleSigner
+	RoleRecipient
+)
+
+// The key layout of the index storage
+//
+//	a/<account>/<^blockNumber><^txIndex>  role + tx hash
+//	/height                               number of indexed blocks
+//
+// The block number and tx index are stored inverted, so the newest transactions of an account come first.
+var (
+	accountPrefix = []byte("a/")
+	heightKey     = []byte("/height")
+)
+
+// MaxLimit is the max number of postings returned by one query.
+const MaxLimit = 100
+
+// error of tx index
+var (
+	ErrInvalidCursor = fmt.Errorf("invalid cursor")
+	ErrBlockNotNext  = fmt.Errorf("block is not the next block to index")
+)
+
+// Posting is an entry of the transaction history of an account.
+type Posting struct {
+	TxHash      []byte
+	BlockNumber int64
+	Index       int32
+	Role        Role
+}
+
+// Indexer maintains the transactions published, signed or received by every account.
+type Indexer struct {
+	storage *kv.Storage
+	height  int64
+	mu      sync.RWMutex
+}
+
+// NewIndexer returns an Indexer stored at path.
+func NewIndexer(path string) (*Indexer, error) {
+	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to new storage: %v", err)
+	}
+	height, err := storage.Get(heightKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get height: %v", err)
+	}
+	i := &Indexer{
+		storage: storage,
+	}
+	if len(height) == 8 {
+		i.height = common.BytesToInt64(height)
+	}
+	return i, nil
+}
+
+// Height returns the number of indexed blocks, which is also the number of the next block to index.
+func (i *Indexer) Height() int64 {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.height
+}
+
+// Index adds the transactions of the block to the index. Blocks must be indexed in order.
+func (i *Indexer) Index(blk *block.Block) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if blk.Head.Number != i.height {
+		return ErrBlockNotNext
+	}
+	if err := i.storage.BeginBatch(); err != nil {
+		return err
+	}
+	for idx, t := range blk.Txs {
+		var receipt *tx.TxReceipt
+		if idx < len(blk.Receipts) {
+			receipt = blk.Receipts[idx]
+		}
+		hash := t.Hash()
+		for acc, role := range roles(t, receipt) {
+			value := append([]byte{byte(role)}, hash...)
+			if err := i.storage.Put(postingKey(acc, blk.Head.Number, int32(idx)), value); err != nil {
+				return err
+			}
+		}
+	}
+	if err := i.storage.Put(heightKey, common.Int64ToBytes(blk.Head.Number+1)); err != nil {
+		return err
+	}
+	if err := i.storage.CommitBatch(); err != nil {
+		return err
+	}
+	i.height = blk.Head.Number + 1
+	return nil
+}
+
+// Transactions returns at most limit postings of the account, newest first, starting after the cursor.
+// The returned cursor is empty if there are no more postings.
+func (i *Indexer) Transactions(account string, cursor string, limit int) ([]*Posting, string, error) {
+	if limit <= 0 || limit > MaxLimit {
+		limit = MaxLimit
+	}
+	prefix := accountKeyPrefix(account)
+	from := prefix
+	if cursor != "" {
+		c := common.Base58Decode(cursor)
+		if len(c) != 12 {
+			return nil, "", ErrInvalidCursor
+		}
+		from = nextKey(append(append([]byte{}, prefix...), c...))
+	}
+	keys, err := i.storage.KeysByRange(from, prefixEnd(prefix), limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(keys) > limit {
+		keys = keys[:limit]
+		next = common.Base58Encode(keys[limit-1][len(prefix):])
+	}
+	postings := make([]*Posting, 0, len(keys))
+	for _, k := range keys {
+		value, err := i.storage.Get(k)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(value) == 0 {
+			continue
+		}
+		suffix := k[len(prefix):]
+		postings = append(postings, &Posting{
+			TxHash:      value[1:],
+			BlockNumber: int64(^binary.BigEndian.Uint64(suffix[:8])),
+			Index:       int32(^binary.BigEndian.Uint32(suffix[8:])),
+			Role:        Role(value[0]),
+		})
+	}
+	return postings, next, nil
+}
+
+// Close closes the index storage.
+func (i *Indexer) Close() error {
+	return i.storage.Close()
+}
+
+// roles returns the accounts involved in the transaction and their roles. The recipients are read from the
+// receipts of the token.iost transfers, so the transfers made by contracts are included.
+func roles(t *tx.Tx, receipt *tx.TxReceipt) map[string]Role {
+	r := make(map[string]Role)
+	if t.Publisher != "" {
+		r[t.Publisher] |= RolePublisher
+	}
+	for _, s := range t.Signers {
+		r[strings.Split(s, "@")[0]] |= RoleSigner
+	}
+	if receipt == nil || receipt.Status == nil || receipt.Status.Code != tx.Success {
+		return r
+	}
+	for _, rec := range receipt.Receipts {
+		if rec.FuncName != "token.iost/transfer" && rec.FuncName != "token.iost/transferFreeze" {
+			continue
+		}
+		var args []interface{}
+		if err := json.Unmarshal([]byte(rec.Content), &args); err != nil || len(args) < 3 {
+			continue
+		}
+		if to, ok := args[2].(string); ok && to != "" {
+			r[to] |= RoleRecipient
+		}
+	}
+	return r
+}
+
+func accountKeyPrefix(account string) []byte {
+	return []byte(string(accountPrefix) + account + "/")
+}
+
+func postingKey(account string, number int64, index int32) []byte {
+	k := accountKeyPrefix(account)
+	b := make([]byte, 12)
+	binary.BigEndian.PutUint64(b, ^uint64(number))
+	binary.BigEndian.PutUint32(b[8:], ^uint32(index))
+	return append(k, b...)
+}
+
+// nextKey returns the smallest key greater than key.
+func nextKey(key []byte) []byte {
+	return append(append([]byte{}, key...), 0)
+}
+
+// prefixEnd returns the first key after all keys prefixed with prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
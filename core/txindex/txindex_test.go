@@ -0,0 +1,116 @@
+package txindex
+
+import (
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBlock(number int64, txs []*tx.Tx, codes []tx.StatusCode) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{Number: number},
+		Txs:  txs,
+	}
+	for i, t := range txs {
+		r := tx.NewTxReceipt(t.Hash())
+		r.Status = &tx.Status{Code: codes[i]}
+		if codes[i] == tx.Success {
+			// the receipts of the token.iost actions, as the vm writes them
+			for _, a := range t.Actions {
+				if a.Contract == "token.iost" {
+					r.Receipts = append(r.Receipts, &tx.Receipt{FuncName: a.Contract + "/" + a.ActionName, Content: a.Data})
+				}
+			}
+		}
+		blk.Receipts = append(blk.Receipts, r)
+	}
+	return blk
+}
+
+func newTx(publisher string, signers []string, actions ...*tx.Action) *tx.Tx {
+	t := tx.NewTx(actions, signers, 1000000, 100, 0, 0, 1024)
+	t.Publisher = publisher
+	return t
+}
+
+func TestIndexer(t *testing.T) {
+	defer os.RemoveAll("txindex_test")
+	indexer, err := NewIndexer("txindex_test")
+	require.Nil(t, err)
+
+	t0 := newTx("alice", []string{"bob@active"}, tx.NewAction("token.iost", "transfer", `["iost","alice","carol","1",""]`))
+	t1 := newTx("bob", nil, tx.NewAction("token.iost", "transfer", `["iost","bob","carol","1",""]`))
+	t2 := newTx("carol", nil, tx.NewAction("token.iost", "transfer", `["iost","carol","alice","1",""]`))
+
+	require.Nil(t, indexer.Index(newBlock(0, nil, nil)))
+	require.Nil(t, indexer.Index(newBlock(1, []*tx.Tx{t0, t1}, []tx.StatusCode{tx.Success, tx.ErrorRuntime})))
+	assert.Equal(t, ErrBlockNotNext, indexer.Index(newBlock(3, nil, nil)))
+	require.Nil(t, indexer.Index(newBlock(2, []*tx.Tx{t2}, []tx.StatusCode{tx.Success})))
+	assert.Equal(t, int64(3), indexer.Height())
+
+	postings, next, err := indexer.Transactions("alice", "", 0)
+	require.Nil(t, err)
+	assert.Equal(t, "", next)
+	require.Len(t, postings, 2)
+	assert.Equal(t, t2.Hash(), postings[0].TxHash)
+	assert.Equal(t, RoleRecipient, postings[0].Role)
+	assert.Equal(t, int64(2), postings[0].BlockNumber)
+	assert.Equal(t, t0.Hash(), postings[1].TxHash)
+	assert.Equal(t, RolePublisher, postings[1].Role)
+
+	postings, next, err = indexer.Transactions("bob", "", 1)
+	require.Nil(t, err)
+	require.Len(t, postings, 1)
+	assert.Equal(t, t1.Hash(), postings[0].TxHash)
+	assert.Equal(t, int32(1), postings[0].Index)
+	assert.NotEqual(t, "", next)
+	postings, next, err = indexer.Transactions("bob", next, 1)
+	require.Nil(t, err)
+	assert.Equal(t, "", next)
+	require.Len(t, postings, 1)
+	assert.Equal(t, t0.Hash(), postings[0].TxHash)
+	assert.Equal(t, RoleSigner, postings[0].Role)
+
+	postings, _, err = indexer.Transactions("carol", "", 0)
+	require.Nil(t, err)
+	require.Len(t, postings, 2)
+	assert.Equal(t, t2.Hash(), postings[0].TxHash)
+	assert.Equal(t, t0.Hash(), postings[1].TxHash)
+
+	_, _, err = indexer.Transactions("carol", "bad", 0)
+	assert.Equal(t, ErrInvalidCursor, err)
+	require.Nil(t, indexer.Close())
+
+	indexer, err = NewIndexer("txindex_test")
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), indexer.Height())
+	require.Nil(t, indexer.Close())
+}
+
+func TestIndexerContractTransfer(t *testing.T) {
+	defer os.RemoveAll("txindex_contract_test")
+	indexer, err := NewIndexer("txindex_contract_test")
+	require.Nil(t, err)
+	defer indexer.Close()
+
+	t0 := newTx("alice", nil, tx.NewAction("Contract1", "swap", `["carol"]`))
+	blk := newBlock(0, []*tx.Tx{t0}, []tx.StatusCode{tx.Success})
+	blk.Receipts[0].Receipts = []*tx.Receipt{
+		{FuncName: "token.iost/transfer", Content: `["iost","alice","Contract1","10",""]`},
+		{FuncName: "token.iost/transfer", Content: `["abc","Contract1","carol","5",""]`},
+		{FuncName: "Contract1/swap", Content: `["iost","abc","carol"]`},
+	}
+	require.Nil(t, indexer.Index(blk))
+
+	for acc, role := range map[string]Role{"alice": RolePublisher, "Contract1": RoleRecipient, "carol": RoleRecipient} {
+		postings, _, err := indexer.Transactions(acc, "", 0)
+		require.Nil(t, err)
+		require.Len(t, postings, 1, acc)
+		assert.Equal(t, t0.Hash(), postings[0].TxHash)
+		assert.Equal(t, role, postings[0].Role, acc)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/core/block"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/ilog"
@@ -34,23 +35,27 @@ type DeferServer struct {
 	idxMap           map[string]*tx.Tx
 	rw               *sync.RWMutex
 	nextScheduleTime atomic.Int64
+	height           atomic.Int64
 
 	bChain block.Chain
 	txpool TxPool
+	clock  *common.Clock
 
 	quitCh chan struct{}
 }
 
-// NewDeferServer returns a new DeferServer instance.
-func NewDeferServer(txpool TxPool, bChain block.Chain) (*DeferServer, error) {
+// NewDeferServer returns a new DeferServer instance, which sends the delay txs by the time of clock.
+func NewDeferServer(txpool TxPool, bChain block.Chain, clock *common.Clock) (*DeferServer, error) {
 	deferServer := &DeferServer{
 		pool:   redblacktree.NewWith(compareDeferTx),
 		idxMap: make(map[string]*tx.Tx),
 		rw:     new(sync.RWMutex),
 		bChain: bChain,
 		txpool: txpool,
+		clock:  clock,
 		quitCh: make(chan struct{}),
 	}
+	deferServer.height.Store(bChain.Length())
 	err := deferServer.buildIndex()
 	if err != nil {
 		return nil, fmt.Errorf("build defertx index error, %v", err)
@@ -123,6 +128,13 @@ func (d *DeferServer) StoreDeferTx(delayTx *tx.Tx) {
 	}
 }
 
+// Size returns the number of the delay txs waiting for their defer txs.
+func (d *DeferServer) Size() int {
+	d.rw.RLock()
+	defer d.rw.RUnlock()
+	return len(d.idxMap)
+}
+
 // DumpDeferTx dumps all defer transactions for debug.
 func (d *DeferServer) DumpDeferTx() []*tx.Tx {
 	ret := make([]*tx.Tx, 0)
@@ -161,7 +173,7 @@ func (d *DeferServer) restartDeferTicker() {
 
 func (d *DeferServer) deferTicker() {
 	for {
-		scheduled := time.Duration(d.nextScheduleTime.Load() - time.Now().UnixNano())
+		scheduled := time.Duration(d.nextScheduleTime.Load() - d.clock.Now().UnixNano())
 		if scheduled < minTickerTime {
 			scheduled = minTickerTime
 		}
@@ -177,7 +189,7 @@ func (d *DeferServer) deferTicker() {
 			d.rw.RUnlock()
 			for ok {
 				idx := iter.Key().(*tx.Tx)
-				if idx.Time > time.Now().UnixNano() {
+				if idx.Time > d.clock.Now().UnixNano() {
 					d.nextScheduleTime.Store(idx.Time)
 					break
 				}
@@ -207,6 +219,18 @@ func (d *DeferServer) deferTicker() {
 	}
 }
 
+// Height returns the number of the next irreversible block to process.
+func (d *DeferServer) Height() int64 {
+	return d.height.Load()
+}
+
+// Index processes the delay txs of the next irreversible block.
+func (d *DeferServer) Index(blk *block.Block) error {
+	d.ProcessDelaytx(blk)
+	d.height.Store(blk.Head.Number + 1)
+	return nil
+}
+
 // ProcessDelaytx will process the delay tx.
 func (d *DeferServer) ProcessDelaytx(blk *block.Block) {
 	for i, t := range blk.Txs {
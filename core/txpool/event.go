@@ -0,0 +1,38 @@
+package txpool
+
+import (
+	"encoding/json"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/ilog"
+)
+
+// The reasons of a tx dropped from the pending pool.
+const (
+	DropReasonExpired  = "expired"
+	DropReasonRejected = "rejected"
+	DropReasonReplaced = "replaced"
+	DropReasonEvicted  = "evicted"
+)
+
+// TxDroppedEventData is the data of the TxDropped event.
+type TxDroppedEventData struct {
+	Hash      string `json:"hash"`
+	Publisher string `json:"publisher"`
+	Reason    string `json:"reason"`
+}
+
+func postTxDropped(t *tx.Tx, reason string) {
+	b, err := json.Marshal(&TxDroppedEventData{
+		Hash:      common.Base58Encode(t.Hash()),
+		Publisher: t.Publisher,
+		Reason:    reason,
+	})
+	if err != nil {
+		ilog.Errorf("Marshal %v event failed: %v", event.TxDropped, err)
+		return
+	}
+	event.GetCollector().Post(event.NewEvent(event.TxDropped, string(b)), &event.Meta{Publisher: t.Publisher})
+}
@@ -15,6 +15,7 @@ type TxPool interface {
 	DelTx(hash []byte) error
 	GetFromPending(hash []byte) (*tx.Tx, error)
 	PendingTx() (*SortedTxMap, *blockcache.BlockCacheNode)
+	Stats() *Stats
 
 	// TODO: The following interfaces need to be moved from txpool to chainbase.
 	AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error
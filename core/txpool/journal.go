@@ -0,0 +1,78 @@
+package txpool
+
+import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/db/wal"
+	"github.com/iost-official/go-iost/v3/ilog"
+)
+
+// TxPoolWALDir is the directory of the pending tx journal.
+const TxPoolWALDir = "TxPoolWAL"
+
+// the journal is rotated when it has this many more entries than twice the pending txs
+var minJournalRotate = 1000
+
+// journal keeps the accepted pending txs on disk. Txs which are packed, expired or replaced are not
+// removed from the journal, they are filtered out when the journal is loaded.
+type journal struct {
+	wal   *wal.WAL
+	count int // number of entries since the last rotation
+}
+
+// openJournal opens the journal in dir and returns the journaled txs.
+func openJournal(dir string) (*journal, []*tx.Tx, error) {
+	w, err := wal.Create(dir, []byte("txpool_wal"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create wal: %v", err)
+	}
+	txs := make([]*tx.Tx, 0)
+	if w.HasDecoder() {
+		_, entries, err := w.ReadAll()
+		if err != nil {
+			w.Close()
+			return nil, nil, fmt.Errorf("failed to read wal: %v", err)
+		}
+		for _, entry := range entries {
+			t := &tx.Tx{}
+			if err := t.Decode(entry.Data); err != nil {
+				ilog.Warnf("Decode journaled tx failed: %v", err)
+				continue
+			}
+			txs = append(txs, t)
+		}
+	}
+	return &journal{wal: w, count: len(txs)}, txs, nil
+}
+
+func (j *journal) insert(t *tx.Tx) error {
+	if _, err := j.wal.SaveSingle(&wal.Entry{Data: t.Encode()}); err != nil {
+		return err
+	}
+	j.count++
+	return nil
+}
+
+// rotate writes the txs to a new wal file, and removes the older wal files, even if there are no txs.
+func (j *journal) rotate(txs []*tx.Tx) error {
+	next, err := j.wal.Cut()
+	if err != nil {
+		return err
+	}
+	for _, t := range txs {
+		if _, err := j.wal.SaveSingle(&wal.Entry{Data: t.Encode()}); err != nil {
+			return err
+		}
+	}
+	// the old files are named by the index of the next entry when they were cut
+	if err := j.wal.RemoveFilesBefore(next + 1); err != nil {
+		return err
+	}
+	j.count = len(txs)
+	return nil
+}
+
+func (j *journal) close() error {
+	return j.wal.Close()
+}
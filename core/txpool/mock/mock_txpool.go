@@ -148,6 +148,18 @@ func (mr *MockTxPoolMockRecorder) Start() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockTxPool)(nil).Start))
 }
 
+// Stats mocks base method
+func (m *MockTxPool) Stats() *txpool.Stats {
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(*txpool.Stats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats
+func (mr *MockTxPoolMockRecorder) Stats() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockTxPool)(nil).Stats))
+}
+
 // Stop mocks base method
 func (m *MockTxPool) Stop() {
 	m.ctrl.Call(m, "Stop")
@@ -20,20 +20,45 @@ type TxPImpl struct {
 	forkChain  *forkChain
 	blockList  *sync.Map // map[string]*blockTx
 	pendingTx  *SortedTxMap
+	defers     *DeferServer
+	replaced   map[string]int64 // hash of replaced tx -> expiration
+	maxSize    int
+	maxPubTxs  int
+	journal    *journal
+	clock      *common.Clock
 	mu         sync.RWMutex
 	quitCh     chan struct{}
 }
 
-// NewTxPoolImpl returns a default TxPImpl instance.
-func NewTxPoolImpl(bChain block.Chain, blockCache blockcache.BlockCache) (*TxPImpl, error) {
+// NewTxPoolImpl returns a default TxPImpl instance. The limits of the pool are read from conf if it is not nil.
+// Txs are checked and delay txs are scheduled by the time of clock, which is the local time if it is nil.
+func NewTxPoolImpl(bChain block.Chain, blockCache blockcache.BlockCache, conf *common.TxPoolConfig, clock *common.Clock) (*TxPImpl, error) {
 	p := &TxPImpl{
 		bChain:     bChain,
 		blockCache: blockCache,
 		forkChain:  new(forkChain),
 		blockList:  new(sync.Map),
 		pendingTx:  NewSortedTxMap(),
+		replaced:   make(map[string]int64),
+		maxSize:    maxCacheTxs,
+		maxPubTxs:  maxPublisherTxs,
+		clock:      clock,
 		quitCh:     make(chan struct{}),
 	}
+	if conf != nil && conf.MaxSize > 0 {
+		p.maxSize = conf.MaxSize
+	}
+	if conf != nil && conf.MaxPerPublisher > 0 {
+		p.maxPubTxs = conf.MaxPerPublisher
+	}
+	defers, err := NewDeferServer(p, bChain, clock)
+	if err != nil {
+		return nil, err
+	}
+	p.defers = defers
+	if err := defers.Start(); err != nil {
+		return nil, err
+	}
 	p.forkChain.SetNewHead(blockCache.Head())
 	p.initBlockTx()
 	go p.loop()
@@ -43,6 +68,42 @@ func NewTxPoolImpl(bChain block.Chain, blockCache blockcache.BlockCache) (*TxPIm
 // Close will close the tx pool.
 func (pool *TxPImpl) Close() {
 	close(pool.quitCh)
+	pool.defers.Stop()
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.journal != nil {
+		if err := pool.journal.close(); err != nil {
+			ilog.Errorf("Close txpool journal failed: %v", err)
+		}
+		pool.journal = nil
+	}
+}
+
+// LoadJournal adds the txs journaled in dir back to the pool after verifying them again,
+// and journals the txs accepted from now on.
+func (pool *TxPImpl) LoadJournal(dir string) error {
+	j, txs, err := openJournal(dir)
+	if err != nil {
+		return err
+	}
+	loaded := 0
+	for _, t := range txs {
+		if err := pool.AddTx(t, "journal"); err == nil {
+			loaded++
+		}
+	}
+	ilog.Infof("Loaded %v of %v journaled txs.", loaded, len(txs))
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.journal = j
+	return pool.rotateJournal()
+}
+
+func (pool *TxPImpl) rotateJournal() error {
+	size := pool.pendingTx.Size()
+	txs, _ := pool.pendingTx.List(nil, 0, size)
+	return pool.journal.rotate(txs)
 }
 
 func (pool *TxPImpl) loop() {
@@ -54,6 +115,11 @@ func (pool *TxPImpl) loop() {
 			pool.mu.Lock()
 			pool.clearBlock()
 			pool.clearTimeoutTx()
+			if pool.journal != nil && pool.journal.count > 2*pool.pendingTx.Size()+minJournalRotate {
+				if err := pool.rotateJournal(); err != nil {
+					ilog.Errorf("Rotate txpool journal failed: %v", err)
+				}
+			}
 			pool.mu.Unlock()
 			metricsTxPoolSize.Set(float64(pool.pendingTx.Size()), nil)
 		case <-pool.quitCh:
@@ -62,11 +128,25 @@ func (pool *TxPImpl) loop() {
 	}
 }
 
+// DeferServer returns the index of the delay txs, which should be fed with the irreversible blocks.
+func (pool *TxPImpl) DeferServer() *DeferServer {
+	return pool.defers
+}
+
 // PendingTx is return pendingTx
 func (pool *TxPImpl) PendingTx() (*SortedTxMap, *blockcache.BlockCacheNode) {
 	return pool.pendingTx, pool.forkChain.NewHead
 }
 
+// Stats returns the statistics and limits of the pending txs.
+func (pool *TxPImpl) Stats() *Stats {
+	s := pool.pendingTx.Stats()
+	s.MaxSize = pool.maxSize
+	s.MaxPerPublisher = pool.maxPubTxs
+	s.DeferSize = pool.defers.Size()
+	return s
+}
+
 // AddLinkedNode add the findBlock
 func (pool *TxPImpl) AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error {
 	pool.mu.Lock()
@@ -95,7 +175,11 @@ func (pool *TxPImpl) AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error
 	return nil
 }
 
-// AddTx add the transaction
+// AddTx add the transaction. A pending tx with the same publisher and time is replaced
+// if the new one pays a higher gas ratio, and a replacement without actions cancels it.
+// The replacement is best-effort and not exclusive on-chain: a producer which has not seen it
+// may still pack the replaced tx, and then the replacement is dropped from the pool.
+// When the pool is full, the tx with the lowest priority is evicted if the new one has a higher priority.
 func (pool *TxPImpl) AddTx(t *tx.Tx, from string) error {
 	pool.mu.Lock()
 	err := pool.verifyDuplicate(t)
@@ -108,7 +192,35 @@ func (pool *TxPImpl) AddTx(t *tx.Tx, from string) error {
 		pool.mu.Unlock()
 		return err
 	}
+	var old *tx.Tx
+	if !t.IsDefer() {
+		old = pool.pendingTx.GetReplaceable(t)
+	}
+	var evicted *tx.Tx
+	if old != nil {
+		err = verifyReplacement(old, t)
+	} else {
+		evicted, err = pool.verifyCapacity(t)
+	}
+	if err != nil {
+		pool.mu.Unlock()
+		return err
+	}
 	pool.pendingTx.Add(t)
+	if old != nil {
+		pool.pendingTx.Del(old.Hash())
+		pool.replaced[string(old.Hash())] = old.Expiration
+		postTxDropped(old, DropReasonReplaced)
+	}
+	if evicted != nil {
+		pool.pendingTx.Del(evicted.Hash())
+		postTxDropped(evicted, DropReasonEvicted)
+	}
+	if pool.journal != nil {
+		if err := pool.journal.insert(t); err != nil {
+			ilog.Errorf("Journal tx %v failed: %v", common.Base58Encode(t.Hash()), err)
+		}
+	}
 	pool.mu.Unlock()
 
 	ilog.Debugf(
@@ -121,9 +233,12 @@ func (pool *TxPImpl) AddTx(t *tx.Tx, from string) error {
 	return nil
 }
 
-// DelTx del the transaction
+// DelTx del the transaction which is rejected by the block producer.
 func (pool *TxPImpl) DelTx(hash []byte) error {
-	pool.pendingTx.Del(hash)
+	if t := pool.pendingTx.Get(hash); t != nil {
+		pool.pendingTx.Del(hash)
+		postTxDropped(t, DropReasonRejected)
+	}
 	return nil
 }
 
@@ -134,7 +249,7 @@ func (pool *TxPImpl) ExistTxs(hash []byte, chainBlock *block.Block) bool {
 }
 
 func (pool *TxPImpl) initBlockTx() {
-	filterLimit := time.Now().UnixNano() - filterTime
+	filterLimit := pool.clock.Now().UnixNano() - filterTime
 	for i := pool.bChain.Length() - 1; i > 0; i-- {
 		blk, err := pool.bChain.GetBlockByNumber(i)
 		if err != nil {
@@ -148,19 +263,16 @@ func (pool *TxPImpl) initBlockTx() {
 }
 
 func (pool *TxPImpl) verifyTx(t *tx.Tx) error {
-	if pool.pendingTx.Size() > maxCacheTxs {
-		return ErrCacheFull
-	}
 	if t.IsDefer() {
 		return errors.New("reject defertx")
 	}
 	// Add one second delay for tx created time check
-	currentTime := time.Now().UnixNano()
+	currentTime := pool.clock.Now().UnixNano()
 	if !t.IsCreatedBefore(currentTime + maxTxTimeGap) {
 		return fmt.Errorf("TimeError: tx.time is too large(tx.time: %v, now: %v). Please sync time",
 			t.Time, currentTime)
 	}
-	if t.IsExpired(time.Now().UnixNano()) {
+	if t.IsExpired(pool.clock.Now().UnixNano()) {
 		return fmt.Errorf("TimeError: tx.time is expired(tx.time: %v, now: %v). Please sync time",
 			t.Time, currentTime)
 	}
@@ -241,18 +353,108 @@ func (pool *TxPImpl) verifyDuplicate(t *tx.Tx) error {
 	if pool.pendingTx.Get(t.Hash()) != nil {
 		return ErrDupPendingTx
 	}
+	if _, ok := pool.replaced[string(t.Hash())]; ok {
+		return ErrTxReplaced
+	}
 	if t, _ := pool.getTxAndReceiptInChain(t.Hash(), pool.forkChain.GetNewHead().Block); t != nil {
 		return ErrDupChainTx
 	}
+	if !t.IsDefer() && pool.existReplaceKeyInChain(replaceKey(t), pool.forkChain.GetNewHead().Block) {
+		return ErrChainReplaced
+	}
+	return nil
+}
+
+// existReplaceKeyInChain returns whether a tx with the replace key is packed in the chain of the block.
+func (pool *TxPImpl) existReplaceKeyInChain(key string, block *block.Block) bool {
+	if block == nil {
+		return false
+	}
+	blkHash := block.HeadHash()
+	filterLimit := block.Head.Time - filterTime
+	for {
+		b, ok := pool.findBlock(blkHash)
+		if !ok || b.time < filterLimit {
+			return false
+		}
+		if b.hasReplaceKey(key) {
+			return true
+		}
+		blkHash = b.ParentHash
+	}
+}
+
+// delPackedTx deletes the tx packed in a block from pending, together with the pending tx with
+// the same replace key, which would be executed again if it were packed too.
+func (pool *TxPImpl) delPackedTx(t *tx.Tx) {
+	pool.pendingTx.Del(t.Hash())
+	if t.IsDefer() {
+		return
+	}
+	if r := pool.pendingTx.GetReplaceable(t); r != nil {
+		pool.pendingTx.Del(r.Hash())
+		pool.replaced[string(r.Hash())] = r.Expiration
+		postTxDropped(r, DropReasonReplaced)
+	}
+}
+
+// restoreTx adds the tx of a block rolled back to pending, unless it has been replaced.
+func (pool *TxPImpl) restoreTx(t *tx.Tx) {
+	if _, ok := pool.replaced[string(t.Hash())]; ok {
+		return
+	}
+	if !t.IsDefer() && pool.pendingTx.GetReplaceable(t) != nil {
+		return
+	}
+	pool.pendingTx.Add(t)
+}
+
+// verifyReplacement checks whether t can replace the pending tx old.
+func verifyReplacement(old, t *tx.Tx) error {
+	if t.GasRatio*100 < old.GasRatio*(100+minReplaceGasRatioBump) {
+		return ErrReplaceUnderpriced
+	}
+	keys := make(map[string]bool)
+	for _, sign := range t.PublishSigns {
+		keys[string(sign.Pubkey)] = true
+	}
+	for _, sign := range old.PublishSigns {
+		if !keys[string(sign.Pubkey)] {
+			return ErrReplaceSigner
+		}
+	}
 	return nil
 }
 
+// verifyCapacity checks the limits of the pool, and returns the tx to evict if the pool is full.
+func (pool *TxPImpl) verifyCapacity(t *tx.Tx) (*tx.Tx, error) {
+	if pool.pendingTx.PublisherSize(t.Publisher) >= pool.maxPubTxs {
+		return nil, ErrPublisherFull
+	}
+	if pool.pendingTx.Size() < pool.maxSize {
+		return nil, nil
+	}
+	lowest := pool.pendingTx.Lowest()
+	if lowest == nil || compareTx(t, lowest) <= 0 {
+		return nil, ErrCacheFull
+	}
+	return lowest, nil
+}
+
 func (pool *TxPImpl) clearTimeoutTx() {
+	now := pool.clock.Now().UnixNano()
+	for hash, expiration := range pool.replaced {
+		if expiration <= now {
+			delete(pool.replaced, hash)
+		}
+	}
+
 	iter := pool.pendingTx.Iter()
 	t, ok := iter.Next()
 	for ok {
-		if t.IsExpired(time.Now().UnixNano()) && !t.IsDefer() {
+		if t.IsExpired(pool.clock.Now().UnixNano()) && !t.IsDefer() {
 			pool.pendingTx.Del(t.Hash())
+			postTxDropped(t, DropReasonExpired)
 		}
 		t, ok = iter.Next()
 	}
@@ -296,13 +498,13 @@ func (pool *TxPImpl) doChainChangeByForkBCN() {
 	oldHead := pool.forkChain.GetOldHead()
 	forkBCN := pool.forkChain.GetForkBCN()
 	//add txs
-	filterLimit := time.Now().UnixNano() - filterTime
+	filterLimit := pool.clock.Now().UnixNano() - filterTime
 	for {
 		if oldHead == nil || oldHead == forkBCN || oldHead.Block.Head.Time < filterLimit {
 			break
 		}
 		for _, t := range oldHead.Block.Txs {
-			pool.pendingTx.Add(t)
+			pool.restoreTx(t)
 		}
 		oldHead = oldHead.GetParent()
 	}
@@ -313,7 +515,7 @@ func (pool *TxPImpl) doChainChangeByForkBCN() {
 			break
 		}
 		for _, t := range newHead.Block.Txs {
-			pool.DelTx(t.Hash())
+			pool.delPackedTx(t)
 		}
 		newHead = newHead.GetParent()
 	}
@@ -322,7 +524,7 @@ func (pool *TxPImpl) doChainChangeByForkBCN() {
 func (pool *TxPImpl) doChainChangeByTimeout() {
 	newHead := pool.forkChain.GetNewHead()
 	oldHead := pool.forkChain.GetOldHead()
-	filterLimit := time.Now().UnixNano() - filterTime
+	filterLimit := pool.clock.Now().UnixNano() - filterTime
 	ob, ok := pool.findBlock(oldHead.Block.HeadHash())
 	if ok {
 		for {
@@ -330,7 +532,7 @@ func (pool *TxPImpl) doChainChangeByTimeout() {
 				break
 			}
 			ob.txMap.Range(func(k, v interface{}) bool {
-				pool.pendingTx.Add(v.(*tx.Tx))
+				pool.restoreTx(v.(*tx.Tx))
 				return true
 			})
 			ob, ok = pool.findBlock(ob.ParentHash)
@@ -346,7 +548,7 @@ func (pool *TxPImpl) doChainChangeByTimeout() {
 				break
 			}
 			nb.txMap.Range(func(k, v interface{}) bool {
-				pool.DelTx(v.(*tx.Tx).Hash())
+				pool.delPackedTx(v.(*tx.Tx))
 				return true
 			})
 			nb, ok = pool.findBlock(nb.ParentHash)
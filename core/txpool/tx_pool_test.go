@@ -123,7 +123,7 @@ func TestNewTxPImpl(t *testing.T) {
 		BlockCache, err := blockcache.NewBlockCache(config, base, statedb)
 		So(err, ShouldBeNil)
 
-		txPool, err := NewTxPoolImpl(base, BlockCache)
+		txPool, err := NewTxPoolImpl(base, BlockCache, nil, nil)
 		So(err, ShouldBeNil)
 
 		Convey("AddTx", func() {
@@ -136,6 +136,112 @@ func TestNewTxPImpl(t *testing.T) {
 			err = txPool.AddTx(t, "rpc")
 			So(err, ShouldEqual, ErrDupPendingTx)
 		})
+		Convey("ReplaceTx", func() {
+			t := genTx(accountList[0], tx.MaxExpiration)
+			So(txPool.AddTx(t, "rpc"), ShouldBeNil)
+
+			So(txPool.AddTx(genReplaceTx(accountList[0], accountList[0], t, t.Actions, 105), "rpc"), ShouldEqual, ErrReplaceUnderpriced)
+			So(txPool.AddTx(genReplaceTx(accountList[0], accountList[1], t, t.Actions, 200), "rpc"), ShouldEqual, ErrReplaceSigner)
+
+			r := genReplaceTx(accountList[0], accountList[0], t, t.Actions, 110)
+			So(txPool.AddTx(r, "rpc"), ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+			So(txPool.pendingTx.Get(t.Hash()), ShouldBeNil)
+			So(txPool.pendingTx.Get(r.Hash()), ShouldNotBeNil)
+			So(txPool.AddTx(t, "rpc"), ShouldEqual, ErrTxReplaced)
+
+			cancel := genReplaceTx(accountList[0], accountList[0], r, nil, 200)
+			So(txPool.AddTx(cancel, "rpc"), ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+			So(txPool.pendingTx.Get(cancel.Hash()), ShouldNotBeNil)
+		})
+		Convey("PoolLimits", func() {
+			txPool.maxSize = 3
+			txPool.maxPubTxs = 2
+			So(txPool.AddTx(genTx(accountList[0], tx.MaxExpiration), "rpc"), ShouldBeNil)
+			So(txPool.AddTx(genTx(accountList[0], tx.MaxExpiration), "rpc"), ShouldBeNil)
+			So(txPool.AddTx(genTx(accountList[0], tx.MaxExpiration), "rpc"), ShouldEqual, ErrPublisherFull)
+
+			low := genTx(accountList[1], tx.MaxExpiration)
+			So(txPool.AddTx(low, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(genTx(accountList[2], tx.MaxExpiration), "rpc"), ShouldEqual, ErrCacheFull)
+
+			high := genTx(accountList[2], tx.MaxExpiration)
+			high = genReplaceTx(accountList[2], accountList[2], high, high.Actions, 200)
+			So(txPool.AddTx(high, "rpc"), ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 3)
+			So(txPool.pendingTx.Get(low.Hash()), ShouldBeNil)
+			So(txPool.pendingTx.PublisherSize(accountList[1].ReadablePubkey()), ShouldEqual, 0)
+		})
+		Convey("FairList", func() {
+			x1 := genTx(accountList[0], tx.MaxExpiration)
+			x1 = genReplaceTx(accountList[0], accountList[0], x1, x1.Actions, 300)
+			x2 := genTx(accountList[0], tx.MaxExpiration)
+			x2 = genReplaceTx(accountList[0], accountList[0], x2, x2.Actions, 200)
+			y1 := genTx(accountList[1], tx.MaxExpiration)
+			So(txPool.AddTx(x1, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(x2, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(y1, "rpc"), ShouldBeNil)
+
+			list := txPool.pendingTx.FairList()
+			So(len(list), ShouldEqual, 3)
+			for i, expectTx := range []*tx.Tx{x1, y1, x2} {
+				So(common.Base58Encode(list[i].Hash()), ShouldEqual, common.Base58Encode(expectTx.Hash()))
+			}
+		})
+		Convey("ListAndStats", func() {
+			x1 := genTx(accountList[0], tx.MaxExpiration)
+			x2 := genTx(accountList[0], tx.MaxExpiration)
+			x2 = genReplaceTx(accountList[0], accountList[0], x2, x2.Actions, 200)
+			y1 := genTx(accountList[1], tx.MaxExpiration)
+			So(txPool.AddTx(x1, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(x2, "rpc"), ShouldBeNil)
+			So(txPool.AddTx(y1, "rpc"), ShouldBeNil)
+
+			publisher := accountList[0].ReadablePubkey()
+			list, total := txPool.pendingTx.List(func(t *tx.Tx) bool { return t.Publisher == publisher }, 1, 10)
+			So(total, ShouldEqual, 2)
+			So(len(list), ShouldEqual, 1)
+			So(common.Base58Encode(list[0].Hash()), ShouldEqual, common.Base58Encode(x1.Hash()))
+
+			stats := txPool.Stats()
+			So(stats.Size, ShouldEqual, 3)
+			So(stats.Publishers, ShouldEqual, 2)
+			So(stats.GasRatios[100], ShouldEqual, 2)
+			So(stats.GasRatios[200], ShouldEqual, 1)
+			So(stats.Bytes, ShouldEqual, len(x1.Encode())+len(x2.Encode())+len(y1.Encode()))
+			So(stats.MaxSize, ShouldEqual, maxCacheTxs)
+		})
+		Convey("Journal", func() {
+			defer os.RemoveAll(TxPoolWALDir)
+			So(txPool.LoadJournal(TxPoolWALDir), ShouldBeNil)
+			t := genTx(accountList[0], tx.MaxExpiration)
+			So(txPool.AddTx(t, "rpc"), ShouldBeNil)
+			So(txPool.journal.close(), ShouldBeNil)
+			txPool.journal = nil
+
+			reloaded, err := NewTxPoolImpl(base, BlockCache, nil, nil)
+			So(err, ShouldBeNil)
+			defer reloaded.Close()
+			So(reloaded.LoadJournal(TxPoolWALDir), ShouldBeNil)
+			So(reloaded.testPendingTxsNum(), ShouldEqual, 1)
+			So(reloaded.pendingTx.Get(t.Hash()), ShouldNotBeNil)
+		})
+		Convey("Journal rotation", func() {
+			defer os.RemoveAll(TxPoolWALDir)
+			So(txPool.LoadJournal(TxPoolWALDir), ShouldBeNil)
+			t := genTx(accountList[0], tx.MaxExpiration)
+			So(txPool.AddTx(t, "rpc"), ShouldBeNil)
+			txPool.DelTx(t.Hash())
+			So(txPool.rotateJournal(), ShouldBeNil)
+			So(txPool.journal.close(), ShouldBeNil)
+			txPool.journal = nil
+
+			j, txs, err := openJournal(TxPoolWALDir)
+			So(err, ShouldBeNil)
+			defer j.close()
+			So(len(txs), ShouldEqual, 0)
+		})
 		Convey("txTimeOut", func() {
 
 			t := genTx(accountList[0], tx.MaxExpiration)
@@ -275,7 +381,9 @@ func TestNewTxPImplB(t *testing.T) {
 		base.EXPECT().Push(Any()).AnyTimes().Return(nil)
 		base.EXPECT().Length().AnyTimes().Return(int64(1))
 		base.EXPECT().Close().AnyTimes()
-		base.EXPECT().AllDelaytx().AnyTimes().Return(nil, nil)
+		delayTx := genTx(accountList[1], tx.MaxExpiration)
+		delayTx.Delay = int64(time.Hour)
+		base.EXPECT().AllDelaytx().AnyTimes().Return([]*tx.Tx{delayTx}, nil)
 
 		config := &common.Config{
 			DB: &common.DBConfig{
@@ -292,7 +400,7 @@ func TestNewTxPImplB(t *testing.T) {
 		BlockCache, err := blockcache.NewBlockCache(config, base, statedb)
 		So(err, ShouldBeNil)
 
-		txPool, err := NewTxPoolImpl(base, BlockCache)
+		txPool, err := NewTxPoolImpl(base, BlockCache, nil, nil)
 		So(err, ShouldBeNil)
 
 		Convey("delPending", func() {
@@ -348,6 +456,73 @@ func TestNewTxPImplB(t *testing.T) {
 			So(txPool.testPendingTxsNum(), ShouldEqual, 10)
 		})
 
+		Convey("packed replaced tx", func() {
+			t := genTx(accountList[0], tx.MaxExpiration)
+			r := genReplaceTx(accountList[0], accountList[0], t, t.Actions, 110)
+			So(txPool.AddTx(r, "rpc"), ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+
+			// the producer packs the original tx without seeing the replacement
+			blk := genSingleBlock(accountList, witnessList, b[0].HeadHash(), 0)
+			blk.Txs = append(blk.Txs, t)
+			blk.Receipts = append(blk.Receipts, genTxReceipt())
+			blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+			blk.CalculateHeadHash()
+			txPool.blockCache.Head().Head.Number = 0
+			bcn := BlockCache.Add(blk)
+			So(bcn, ShouldNotBeNil)
+			So(txPool.AddLinkedNode(bcn), ShouldBeNil)
+
+			So(txPool.testPendingTxsNum(), ShouldEqual, 0)
+			So(txPool.AddTx(r, "rpc"), ShouldEqual, ErrTxReplaced)
+			So(txPool.AddTx(genReplaceTx(accountList[0], accountList[0], t, t.Actions, 200), "rpc"), ShouldEqual, ErrChainReplaced)
+		})
+
+		Convey("defer size", func() {
+			defers := txPool.DeferServer()
+			So(txPool.Stats().DeferSize, ShouldEqual, 1)
+			So(defers.Height(), ShouldEqual, 1)
+			withTxs := func(parent []byte, txs ...*tx.Tx) *block.Block {
+				blk := genSingleBlock(accountList, witnessList, parent, 0)
+				for _, t := range txs {
+					blk.Txs = append(blk.Txs, t)
+					blk.Receipts = append(blk.Receipts, genTxReceipt())
+				}
+				blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+				blk.CalculateHeadHash()
+				return blk
+			}
+
+			// a delay tx packed after startup counts once its block is irreversible
+			newDelayTx := genTx(accountList[2], tx.MaxExpiration)
+			newDelayTx.Delay = int64(time.Hour)
+			packed := withTxs(b[0].HeadHash(), newDelayTx)
+			txPool.blockCache.Head().Head.Number = 0
+			bcn := BlockCache.Add(packed)
+			So(bcn, ShouldNotBeNil)
+			So(txPool.AddLinkedNode(bcn), ShouldBeNil)
+			So(txPool.Stats().DeferSize, ShouldEqual, 1)
+
+			// the defer tx packed on a fork does not count until its block is irreversible
+			forked := withTxs(b[0].HeadHash(), delayTx.DeferTx())
+			bcn = BlockCache.Add(forked)
+			So(bcn, ShouldNotBeNil)
+			So(txPool.AddLinkedNode(bcn), ShouldBeNil)
+			So(txPool.Stats().DeferSize, ShouldEqual, 1)
+
+			// the fork with the defer tx is abandoned, the block with the new delay tx is irreversible
+			So(defers.Index(packed), ShouldBeNil)
+			So(defers.Height(), ShouldEqual, 2)
+			So(txPool.Stats().DeferSize, ShouldEqual, 2)
+
+			next := withTxs(packed.HeadHash(), delayTx.DeferTx())
+			next.Head.Number = 2
+			So(defers.Index(next), ShouldBeNil)
+			So(txPool.Stats().DeferSize, ShouldEqual, 1)
+			So(len(defers.DumpDeferTx()), ShouldEqual, 1)
+			So(defers.DumpDeferTx()[0].ReferredTx, ShouldResemble, newDelayTx.Hash())
+		})
+
 		Convey("rbtree", func() {
 			t1 := genTx(newAccount, tx.MaxExpiration)
 			t2 := genTx(newAccount, tx.MaxExpiration)
@@ -601,7 +776,7 @@ func envInit(b *testing.B) (blockcache.BlockCache, []*account.KeyPair, []string,
 	os.RemoveAll(blockcache.BlockCacheWALDir)
 	BlockCache, _ := blockcache.NewBlockCache(conf, bChain, stateDB)
 
-	txPool, _ := NewTxPoolImpl(bChain, BlockCache)
+	txPool, _ := NewTxPoolImpl(bChain, BlockCache, nil, nil)
 
 	b.ResetTimer()
 
@@ -659,6 +834,21 @@ func genTx(a *account.KeyPair, expirationIter int64) *tx.Tx {
 	return t1
 }
 
+func genReplaceTx(signer, publisher *account.KeyPair, old *tx.Tx, actions []*tx.Action, gasRatio int64) *tx.Tx {
+	t := tx.NewTx(actions, old.Signers, old.GasLimit, gasRatio, old.Expiration, 0, 0)
+	t.Time = old.Time
+	sig, err := tx.SignTxContent(t, signer.ReadablePubkey(), signer)
+	if err != nil {
+		ilog.Debug("failed to SignTxContent")
+	}
+	t.Signs = append(t.Signs, sig)
+	t, err = tx.SignTx(t, old.Publisher, []*account.KeyPair{publisher})
+	if err != nil {
+		ilog.Debug("failed to SignTx")
+	}
+	return t
+}
+
 func genTxMsg(a *account.KeyPair, expirationIter int64) *p2p.IncomingMessage {
 	t := genTx(a, expirationIter)
 
@@ -3,6 +3,7 @@ package txpool
 import (
 	"bytes"
 	"errors"
+	"strconv"
 	"sync"
 	"time"
 
@@ -18,15 +19,25 @@ var (
 	clearInterval = 10 * time.Second
 	filterTime    = int64(90 * time.Second)
 	maxCacheTxs   = 10000
-	maxTxTimeGap  = 30 * time.Second.Nanoseconds()
+	// the default max number of pending txs of a publisher
+	maxPublisherTxs = 1000
+	maxTxTimeGap    = 30 * time.Second.Nanoseconds()
+	// the gas ratio of a replacement tx must be at least this percent higher than the replaced one
+	minReplaceGasRatioBump int64 = 10
 
 	metricsReceivedTxCount = metrics.NewCounter("iost_tx_received_count", []string{"from"})
 	metricsTxPoolSize      = metrics.NewGauge("iost_txpool_size", nil)
 
-	ErrDupPendingTx = errors.New("tx exists in pending")
-	ErrDupChainTx   = errors.New("tx exists in chain")
-	ErrCacheFull    = errors.New("txpool is full")
-	ErrTxNotFound   = errors.New("tx not found")
+	ErrDupPendingTx  = errors.New("tx exists in pending")
+	ErrDupChainTx    = errors.New("tx exists in chain")
+	ErrCacheFull     = errors.New("txpool is full")
+	ErrTxNotFound    = errors.New("tx not found")
+	ErrTxReplaced    = errors.New("tx has been replaced")
+	ErrPublisherFull = errors.New("too many pending txs of the publisher")
+	ErrChainReplaced = errors.New("tx with the same publisher and time exists in chain")
+
+	ErrReplaceUnderpriced = errors.New("replacement tx gas ratio too low")
+	ErrReplaceSigner      = errors.New("replacement tx is not signed by the publisher keys of the replaced tx")
 )
 
 // FRet find the return value of the tx
@@ -103,6 +114,7 @@ func (f *forkChain) SetForkBCN(n *blockcache.BlockCacheNode) {
 type blockTx struct {
 	txMap        *sync.Map // map[string]*tx.Tx
 	txReceiptMap *sync.Map // map[string]*tx.TxReceipt
+	keyMap       *sync.Map // map[string]bool, replace keys of the txs
 	ParentHash   []byte
 	time         int64
 }
@@ -111,11 +123,15 @@ func newBlockTx(blk *block.Block) *blockTx {
 	b := &blockTx{
 		txMap:        new(sync.Map),
 		txReceiptMap: new(sync.Map),
+		keyMap:       new(sync.Map),
 		ParentHash:   blk.Head.ParentHash,
 		time:         blk.Head.Time,
 	}
 	for _, v := range blk.Txs {
 		b.txMap.Store(string(v.Hash()), v)
+		if !v.IsDefer() {
+			b.keyMap.Store(replaceKey(v), true)
+		}
 	}
 	for _, v := range blk.Receipts {
 		b.txReceiptMap.Store(string(v.TxHash), v)
@@ -136,11 +152,24 @@ func (b *blockTx) getTxAndReceipt(hash []byte) (*tx.Tx, *tx.TxReceipt) {
 	return retTx, nil
 }
 
+func (b *blockTx) hasReplaceKey(key string) bool {
+	_, exist := b.keyMap.Load(key)
+	return exist
+}
+
 // SortedTxMap is a red black tree of tx.
 type SortedTxMap struct {
-	tree  *redblacktree.Tree
-	txMap map[string]*tx.Tx
-	rw    *sync.RWMutex
+	tree   *redblacktree.Tree
+	txMap  map[string]*tx.Tx
+	keyMap map[string]string // replace key -> tx hash
+	pubMap map[string]int    // publisher -> number of txs
+	rw     *sync.RWMutex
+}
+
+// replaceKey returns the key of the tx which can be replaced. Txs of the same publisher with the same time
+// have the same key, so the time acts as the nonce chosen by the client.
+func replaceKey(t *tx.Tx) string {
+	return t.Publisher + "@" + strconv.FormatInt(t.Time, 10)
 }
 
 func compareTx(a, b interface{}) int {
@@ -158,9 +187,11 @@ func compareTx(a, b interface{}) int {
 // NewSortedTxMap returns a new SortedTxMap instance.
 func NewSortedTxMap() *SortedTxMap {
 	return &SortedTxMap{
-		tree:  redblacktree.NewWith(compareTx),
-		txMap: make(map[string]*tx.Tx),
-		rw:    new(sync.RWMutex),
+		tree:   redblacktree.NewWith(compareTx),
+		txMap:  make(map[string]*tx.Tx),
+		keyMap: make(map[string]string),
+		pubMap: make(map[string]int),
+		rw:     new(sync.RWMutex),
 	}
 }
 
@@ -171,11 +202,28 @@ func (st *SortedTxMap) Get(hash []byte) *tx.Tx {
 	return st.txMap[string(hash)]
 }
 
+// GetReplaceable returns the tx which has the same replace key as t.
+func (st *SortedTxMap) GetReplaceable(t *tx.Tx) *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	hash, ok := st.keyMap[replaceKey(t)]
+	if !ok {
+		return nil
+	}
+	return st.txMap[hash]
+}
+
 // Add adds a tx in SortedTxMap.
 func (st *SortedTxMap) Add(tx *tx.Tx) {
 	st.rw.Lock()
 	st.tree.Put(tx, true)
+	if _, ok := st.txMap[string(tx.Hash())]; !ok {
+		st.pubMap[tx.Publisher]++
+	}
 	st.txMap[string(tx.Hash())] = tx
+	if !tx.IsDefer() {
+		st.keyMap[replaceKey(tx)] = string(tx.Hash())
+	}
 	st.rw.Unlock()
 }
 
@@ -190,6 +238,55 @@ func (st *SortedTxMap) Del(hash []byte) {
 	}
 	st.tree.Remove(tx)
 	delete(st.txMap, string(hash))
+	if key := replaceKey(tx); st.keyMap[key] == string(hash) {
+		delete(st.keyMap, key)
+	}
+	if st.pubMap[tx.Publisher]--; st.pubMap[tx.Publisher] <= 0 {
+		delete(st.pubMap, tx.Publisher)
+	}
+}
+
+// PublisherSize returns the number of txs of the publisher.
+func (st *SortedTxMap) PublisherSize(publisher string) int {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return st.pubMap[publisher]
+}
+
+// Lowest returns the tx with the lowest priority.
+func (st *SortedTxMap) Lowest() *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	node := st.tree.Left()
+	if node == nil {
+		return nil
+	}
+	return node.Key.(*tx.Tx)
+}
+
+// FairList returns all the txs in rounds. Each round takes the next tx of every publisher,
+// so one publisher cannot take the whole block. Txs of a round are ordered by priority.
+func (st *SortedTxMap) FairList() []*tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	rounds := make([][]*tx.Tx, 0)
+	taken := make(map[string]int)
+	iter := st.tree.Iterator()
+	for iter.End(); iter.Prev(); {
+		t := iter.Key().(*tx.Tx)
+		r := taken[t.Publisher]
+		taken[t.Publisher]++
+		if r == len(rounds) {
+			rounds = append(rounds, make([]*tx.Tx, 0))
+		}
+		rounds[r] = append(rounds[r], t)
+	}
+	ret := make([]*tx.Tx, 0, len(st.txMap))
+	for _, round := range rounds {
+		ret = append(ret, round...)
+	}
+	return ret
 }
 
 // Size returns the size of SortedTxMap.
@@ -243,3 +340,53 @@ func (iter *Iterator) Next() (*tx.Tx, bool) {
 	go iter.getNext()
 	return ret.tx, ret.ok
 }
+
+// List returns at most limit txs matched by the filter after skipping offset ones,
+// ordered by priority, and the number of all the matched txs.
+func (st *SortedTxMap) List(filter func(*tx.Tx) bool, offset, limit int) ([]*tx.Tx, int) {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	ret := make([]*tx.Tx, 0)
+	total := 0
+	iter := st.tree.Iterator()
+	for iter.End(); iter.Prev(); {
+		t := iter.Key().(*tx.Tx)
+		if filter != nil && !filter(t) {
+			continue
+		}
+		if total >= offset && len(ret) < limit {
+			ret = append(ret, t)
+		}
+		total++
+	}
+	return ret, total
+}
+
+// Stats is the statistics of the pending txs.
+type Stats struct {
+	Size            int           // number of txs
+	Bytes           int           // total encoded size of txs
+	Publishers      int           // number of publishers
+	DeferSize       int           // number of delay txs waiting for their defer txs
+	GasRatios       map[int64]int // gas ratio -> number of txs
+	MaxSize         int
+	MaxPerPublisher int
+}
+
+// Stats returns the statistics of the txs.
+func (st *SortedTxMap) Stats() *Stats {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	s := &Stats{
+		Size:       len(st.txMap),
+		Publishers: len(st.pubMap),
+		GasRatios:  make(map[int64]int),
+	}
+	for _, t := range st.txMap {
+		s.Bytes += len(t.Encode())
+		s.GasRatios[t.GasRatio]++
+	}
+	return s
+}
@@ -1,6 +1,10 @@
 package version
 
-import "github.com/iost-official/go-iost/v3/common"
+import (
+	"math"
+
+	"github.com/iost-official/go-iost/v3/common"
+)
 
 // ChainIDs
 const (
@@ -14,6 +18,7 @@ type ChainConfig struct {
 	Block3_1_0  int64
 	Block3_3_0  int64
 	Block3_3_1  int64
+	Block3_4_0  int64
 }
 
 var (
@@ -22,6 +27,7 @@ var (
 		Block3_1_0:  15800000,
 		Block3_3_0:  38500000,
 		Block3_3_1:  53230000,
+		Block3_4_0:  math.MaxInt64,
 	}
 
 	testNetChainConf = &ChainConfig{
@@ -29,6 +35,7 @@ var (
 		Block3_1_0:  12800000,
 		Block3_3_0:  30440000,
 		Block3_3_1:  45150000,
+		Block3_4_0:  math.MaxInt64,
 	}
 
 	defaultChainConf = &ChainConfig{
@@ -36,6 +43,7 @@ var (
 		Block3_1_0:  0,
 		Block3_3_0:  0,
 		Block3_3_1:  0,
+		Block3_4_0:  0,
 	}
 )
 
@@ -73,6 +81,16 @@ func IsFork3_3_1(num int64) bool {
 	return isForked(chainConf.Block3_3_1, num)
 }
 
+// IsFork3_4_0 enables wasm contracts and the state root in block heads. It is not scheduled on mainnet and testnet yet.
+func IsFork3_4_0(num int64) bool {
+	return isForked(chainConf.Block3_4_0, num)
+}
+
+// IsScheduled3_4_0 returns whether the 3.4.0 fork has a block number on the chain.
+func IsScheduled3_4_0() bool {
+	return chainConf.Block3_4_0 != math.MaxInt64
+}
+
 func isForked(v, num int64) bool {
 	return v <= num
 }
@@ -83,6 +101,7 @@ type Rules struct {
 	IsFork3_1_0  bool `json:"is_fork3_1_0"`
 	IsFork3_3_0  bool `json:"is_fork3_3_0"`
 	IsFork3_3_1  bool `json:"is_fork3_3_1"`
+	IsFork3_4_0  bool `json:"is_fork3_4_0"`
 }
 
 // NewRules create Rules for each block
@@ -92,5 +111,6 @@ func NewRules(num int64) *Rules {
 		IsFork3_1_0:  IsFork3_1_0(num),
 		IsFork3_3_0:  IsFork3_3_0(num),
 		IsFork3_3_1:  IsFork3_3_1(num),
+		IsFork3_4_0:  IsFork3_4_0(num),
 	}
 }
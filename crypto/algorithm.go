@@ -1,10 +1,16 @@
 package crypto
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/iost-official/go-iost/v3/crypto/backend"
 	"github.com/iost-official/go-iost/v3/ilog"
 )
 
+// ErrUnknownAlgorithm is returned when parsing an unsupported algorithm name.
+var ErrUnknownAlgorithm = errors.New("unknown algorithm")
+
 // AlgorithmBackend is the interface of algorithm backend
 type AlgorithmBackend interface {
 	Sign(message []byte, seckey []byte) []byte
@@ -22,6 +28,8 @@ const (
 	_ Algorithm = iota
 	Secp256k1
 	Ed25519
+	Secp256r1
+	BLS
 )
 
 func (a Algorithm) getBackend() AlgorithmBackend {
@@ -30,20 +38,38 @@ func (a Algorithm) getBackend() AlgorithmBackend {
 		return &backend.Secp256k1{}
 	case Ed25519:
 		return &backend.Ed25519{}
+	case Secp256r1:
+		return &backend.Secp256r1{}
+	case BLS:
+		return &backend.BLS{}
 	default:
 		return &backend.Secp256k1{}
 	}
 }
 
-// NewAlgorithm returns a new algorithm by name
+// NewAlgorithm returns a new algorithm by name, unknown names fall back to ed25519.
+// Use ParseAlgorithm to reject them.
 func NewAlgorithm(name string) Algorithm {
+	a, err := ParseAlgorithm(name)
+	if err != nil {
+		return Ed25519
+	}
+	return a
+}
+
+// ParseAlgorithm returns the algorithm of name.
+func ParseAlgorithm(name string) (Algorithm, error) {
 	switch name {
 	case "secp256k1":
-		return Secp256k1
+		return Secp256k1, nil
 	case "ed25519":
-		return Ed25519
+		return Ed25519, nil
+	case "secp256r1":
+		return Secp256r1, nil
+	case "bls":
+		return BLS, nil
 	default:
-		return Ed25519
+		return 0, fmt.Errorf("%w: %v", ErrUnknownAlgorithm, name)
 	}
 }
 
@@ -54,6 +80,10 @@ func (a Algorithm) String() string {
 		return "secp256k1"
 	case Ed25519:
 		return "ed25519"
+	case Secp256r1:
+		return "secp256r1"
+	case BLS:
+		return "bls"
 	default:
 		return "secp256k1"
 	}
@@ -90,3 +120,13 @@ func (a Algorithm) GenSeckey() []byte {
 func (a Algorithm) CheckSeckey(seckey []byte) error {
 	return a.getBackend().CheckSeckey(seckey)
 }
+
+// AggregateSignatures aggregates BLS signatures into one signature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	return backend.BLSAggregateSignatures(sigs)
+}
+
+// AggregateVerify verifies an aggregate BLS signature of distinct messages, each signed by the public key of the same index.
+func AggregateVerify(messages [][]byte, pubkeys [][]byte, sig []byte) bool {
+	return backend.BLSAggregateVerify(messages, pubkeys, sig)
+}
@@ -1,7 +1,10 @@
 package crypto
 
 import (
+	"crypto/elliptic"
 	"crypto/rand"
+	"errors"
+	"math/big"
 	"reflect"
 	"testing"
 
@@ -13,6 +16,8 @@ import (
 var algos = []Algorithm{
 	Secp256k1,
 	Ed25519,
+	Secp256r1,
+	BLS,
 }
 
 func TestCheckSeckey(t *testing.T) {
@@ -30,6 +35,44 @@ func TestSecp256k1(t *testing.T) {
 	assert.True(t, Secp256k1.Verify(msg, pubkey, sig))
 }
 
+func TestParseAlgorithm(t *testing.T) {
+	for _, algo := range algos {
+		a, err := ParseAlgorithm(algo.String())
+		assert.Nil(t, err)
+		assert.Equal(t, algo, a)
+	}
+	_, err := ParseAlgorithm("rsa")
+	assert.True(t, errors.Is(err, ErrUnknownAlgorithm))
+	assert.Equal(t, Ed25519, NewAlgorithm("rsa"))
+}
+
+func TestSecp256r1Malleability(t *testing.T) {
+	seckey := Secp256r1.GenSeckey()
+	pubkey := Secp256r1.GetPubkey(seckey)
+	msg := common.Sha3([]byte("hello world"))
+	sig := Secp256r1.Sign(msg, seckey)
+	assert.True(t, Secp256r1.Verify(msg, pubkey, sig))
+	s := new(big.Int).SetBytes(sig[32:])
+	s.Sub(elliptic.P256().Params().N, s)
+	highS := append(append([]byte{}, sig[:32]...), s.FillBytes(make([]byte, 32))...)
+	assert.False(t, Secp256r1.Verify(msg, pubkey, highS))
+}
+
+func TestAggregateVerify(t *testing.T) {
+	var msgs, pubkeys, sigs [][]byte
+	for i := 0; i < 3; i++ {
+		seckey := BLS.GenSeckey()
+		msg := common.Sha3([]byte{byte(i)})
+		msgs = append(msgs, msg)
+		pubkeys = append(pubkeys, BLS.GetPubkey(seckey))
+		sigs = append(sigs, BLS.Sign(msg, seckey))
+	}
+	agg, err := AggregateSignatures(sigs)
+	assert.Nil(t, err)
+	assert.True(t, AggregateVerify(msgs, pubkeys, agg))
+	assert.False(t, AggregateVerify(msgs, pubkeys, sigs[0]))
+}
+
 func TestVerify(t *testing.T) {
 	for _, algo := range algos {
 		seckey := algo.GenSeckey()
@@ -0,0 +1,197 @@
+package backend
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/iost-official/go-iost/v3/ilog"
+)
+
+// BLS is the BLS12-381 signature algorithm of the basic scheme, with public keys in G1
+// and signatures in G2. Points are serialized uncompressed, so public keys are 96 bytes
+// and signatures are 192 bytes. Messages are hashed to G2 as specified by RFC 9380.
+type BLS struct{}
+
+// blsDST is the domain separation tag of the basic scheme ciphersuite.
+var blsDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
+var (
+	blsP, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	blsR, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+)
+
+// errors
+var (
+	ErrBLSInvalidPubkey    = errors.New("invalid bls public key")
+	ErrBLSInvalidSignature = errors.New("invalid bls signature")
+)
+
+// Sign will signature the message with seckey by bls
+func (b *BLS) Sign(message []byte, seckey []byte) []byte {
+	h, err := hashToG2(message, blsDST)
+	if err != nil {
+		ilog.Errorf("Failed to sign, %v", err)
+		return nil
+	}
+	g2 := bls12381.NewG2()
+	return g2.ToBytes(g2.MulScalar(g2.New(), h, new(big.Int).SetBytes(seckey)))
+}
+
+// Verify will verify the message with pubkey and sig by bls
+func (b *BLS) Verify(message []byte, pubkey []byte, sig []byte) bool {
+	return BLSAggregateVerify([][]byte{message}, [][]byte{pubkey}, sig)
+}
+
+// GetPubkey will get the public key of the secret key by bls
+func (b *BLS) GetPubkey(seckey []byte) []byte {
+	g1 := bls12381.NewG1()
+	return g1.ToBytes(g1.MulScalar(g1.New(), g1.One(), new(big.Int).SetBytes(seckey)))
+}
+
+// GenSeckey will generate the secret key by bls
+func (b *BLS) GenSeckey() []byte {
+	max := new(big.Int).Sub(blsR, big.NewInt(1))
+	d, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		ilog.Errorf("Failed to random seckey, %v", err)
+		return nil
+	}
+	seckey := make([]byte, 32)
+	d.Add(d, big.NewInt(1)).FillBytes(seckey)
+	return seckey
+}
+
+// CheckSeckey ...
+func (b *BLS) CheckSeckey(seckey []byte) error {
+	if len(seckey) != 32 {
+		return fmt.Errorf("seckey length error bls seckey length should not be %v", len(seckey))
+	}
+	d := new(big.Int).SetBytes(seckey)
+	if d.Sign() == 0 || d.Cmp(blsR) >= 0 {
+		return fmt.Errorf("invalid seckey")
+	}
+	return nil
+}
+
+// BLSAggregateSignatures aggregates bls signatures into one signature.
+func BLSAggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, ErrBLSInvalidSignature
+	}
+	g2 := bls12381.NewG2()
+	agg := g2.Zero()
+	for _, s := range sigs {
+		p, err := decodeG2(g2, s)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(agg, agg, p)
+	}
+	return g2.ToBytes(agg), nil
+}
+
+// BLSAggregateVerify verifies an aggregate signature of distinct messages, each signed by the public key of the same index.
+func BLSAggregateVerify(messages [][]byte, pubkeys [][]byte, sig []byte) bool {
+	if len(messages) == 0 || len(messages) != len(pubkeys) {
+		return false
+	}
+	seen := make(map[string]bool, len(messages))
+	for _, m := range messages {
+		if seen[string(m)] {
+			return false
+		}
+		seen[string(m)] = true
+	}
+	g1 := bls12381.NewG1()
+	s, err := decodeG2(bls12381.NewG2(), sig)
+	if err != nil {
+		return false
+	}
+	e := bls12381.NewPairingEngine()
+	for i, m := range messages {
+		pk, err := decodeG1(g1, pubkeys[i])
+		if err != nil {
+			return false
+		}
+		h, err := hashToG2(m, blsDST)
+		if err != nil {
+			return false
+		}
+		e.AddPair(pk, h)
+	}
+	e.AddPairInv(g1.One(), s)
+	return e.Check()
+}
+
+func decodeG1(g1 *bls12381.G1, b []byte) (*bls12381.PointG1, error) {
+	p, err := g1.FromBytes(b)
+	if err != nil || g1.IsZero(p) || !g1.InCorrectSubgroup(p) {
+		return nil, ErrBLSInvalidPubkey
+	}
+	return p, nil
+}
+
+func decodeG2(g2 *bls12381.G2, b []byte) (*bls12381.PointG2, error) {
+	p, err := g2.FromBytes(b)
+	if err != nil || !g2.InCorrectSubgroup(p) {
+		return nil, ErrBLSInvalidSignature
+	}
+	return p, nil
+}
+
+// hashToG2 implements hash_to_curve of the BLS12381G2_XMD:SHA-256_SSWU_RO_ suite.
+func hashToG2(msg, dst []byte) (*bls12381.PointG2, error) {
+	u, err := expandMessageXMD(msg, dst, 256)
+	if err != nil {
+		return nil, err
+	}
+	g2 := bls12381.NewG2()
+	q := g2.Zero()
+	for i := 0; i < 2; i++ {
+		// a field element of G2 is serialized as c1 || c0
+		fe := make([]byte, 96)
+		new(big.Int).Mod(new(big.Int).SetBytes(u[i*128+64:i*128+128]), blsP).FillBytes(fe[:48])
+		new(big.Int).Mod(new(big.Int).SetBytes(u[i*128:i*128+64]), blsP).FillBytes(fe[48:])
+		p, err := g2.MapToCurve(fe)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(q, q, p)
+	}
+	return q, nil
+}
+
+// expandMessageXMD implements expand_message_xmd of RFC 9380 with sha256.
+func expandMessageXMD(msg, dst []byte, n int) ([]byte, error) {
+	ell := (n + sha256.Size - 1) / sha256.Size
+	if ell > 255 || len(dst) > 255 {
+		return nil, errors.New("invalid expand message length")
+	}
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sha256.BlockSize))
+	h.Write(msg)
+	h.Write([]byte{byte(n >> 8), byte(n), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	out := make([]byte, 0, ell*sha256.Size)
+	bi := make([]byte, sha256.Size)
+	for i := 1; i <= ell; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		h.Reset()
+		h.Write(bi)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+	return out[:n], nil
+}
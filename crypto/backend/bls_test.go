@@ -0,0 +1,42 @@
+package backend
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test vectors of RFC 9380 appendix K.1 and J.10.1.
+func TestHashToG2(t *testing.T) {
+	u, err := expandMessageXMD([]byte(""), []byte("QUUX-V01-CS02-with-expander-SHA256-128"), 0x20)
+	assert.Nil(t, err)
+	assert.Equal(t, "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235", hex.EncodeToString(u))
+
+	q, err := hashToG2([]byte(""), []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_"))
+	assert.Nil(t, err)
+	b := bls12381.NewG2().ToBytes(q)
+	assert.Equal(t, "05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d", hex.EncodeToString(b[:48]))
+	assert.Equal(t, "0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a", hex.EncodeToString(b[48:96]))
+	assert.Equal(t, "12424ac32561493f3fe3c260708a12b7c620e7be00099a974e259ddc7d1f6395c3c811cdd19f1e8dbf3e9ecfdcbab8d6", hex.EncodeToString(b[96:144]))
+	assert.Equal(t, "0503921d7f6a12805e72940b963c0cf3471c7b2a524950ca195d11062ee75ec076daf2d4bc358c4b190c0c98064fdd92", hex.EncodeToString(b[144:]))
+}
+
+func TestBLSAggregateVerify(t *testing.T) {
+	b := &BLS{}
+	msgs := [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+	var pubkeys, sigs [][]byte
+	for _, m := range msgs {
+		seckey := b.GenSeckey()
+		assert.Nil(t, b.CheckSeckey(seckey))
+		pubkeys = append(pubkeys, b.GetPubkey(seckey))
+		sigs = append(sigs, b.Sign(m, seckey))
+	}
+	agg, err := BLSAggregateSignatures(sigs)
+	assert.Nil(t, err)
+	assert.True(t, BLSAggregateVerify(msgs, pubkeys, agg))
+	assert.False(t, BLSAggregateVerify(msgs[:2], pubkeys[:2], agg))
+	assert.False(t, BLSAggregateVerify([][]byte{msgs[0], msgs[0], msgs[2]}, pubkeys, agg))
+	assert.False(t, BLSAggregateVerify(msgs, [][]byte{pubkeys[1], pubkeys[0], pubkeys[2]}, agg))
+}
@@ -0,0 +1,92 @@
+package backend
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/iost-official/go-iost/v3/ilog"
+)
+
+// Secp256r1 is the secp256r1 (NIST P-256) crypto algorithm.
+// Public keys are compressed to 33 bytes and signatures are r || s in 64 bytes,
+// with s in the lower half of the curve order so that signatures are not malleable.
+type Secp256r1 struct{}
+
+var (
+	p256      = elliptic.P256()
+	p256N     = p256.Params().N
+	p256HalfN = new(big.Int).Rsh(p256N, 1)
+)
+
+func (b *Secp256r1) privateKey(seckey []byte) *ecdsa.PrivateKey {
+	priv := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(seckey)}
+	priv.PublicKey.Curve = p256
+	priv.PublicKey.X, priv.PublicKey.Y = p256.ScalarBaseMult(seckey)
+	return priv
+}
+
+// Sign will signature the message with seckey by secp256r1
+func (b *Secp256r1) Sign(message []byte, seckey []byte) []byte {
+	r, s, err := ecdsa.Sign(rand.Reader, b.privateKey(seckey), message)
+	if err != nil {
+		ilog.Errorf("Failed to sign, %v", err)
+		return nil
+	}
+	if s.Cmp(p256HalfN) > 0 {
+		s.Sub(p256N, s)
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig
+}
+
+// Verify will verify the message with pubkey and sig by secp256r1
+func (b *Secp256r1) Verify(message []byte, pubkey []byte, sig []byte) bool {
+	if len(sig) != 64 {
+		return false
+	}
+	x, y := elliptic.UnmarshalCompressed(p256, pubkey)
+	if x == nil {
+		return false
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(p256HalfN) > 0 {
+		return false
+	}
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: p256, X: x, Y: y}, message, r, s)
+}
+
+// GetPubkey will get the public key of the secret key by secp256r1
+func (b *Secp256r1) GetPubkey(seckey []byte) []byte {
+	x, y := p256.ScalarBaseMult(seckey)
+	return elliptic.MarshalCompressed(p256, x, y)
+}
+
+// GenSeckey will generate the secret key by secp256r1
+func (b *Secp256r1) GenSeckey() []byte {
+	priv, err := ecdsa.GenerateKey(p256, rand.Reader)
+	if err != nil {
+		ilog.Errorf("Failed to random seckey, %v", err)
+		return nil
+	}
+	seckey := make([]byte, 32)
+	priv.D.FillBytes(seckey)
+	return seckey
+}
+
+// CheckSeckey ...
+func (b *Secp256r1) CheckSeckey(seckey []byte) error {
+	if len(seckey) != 32 {
+		return fmt.Errorf("seckey length error secp256r1 seckey length should not be %v", len(seckey))
+	}
+	d := new(big.Int).SetBytes(seckey)
+	if d.Sign() == 0 || d.Cmp(p256N) >= 0 {
+		return fmt.Errorf("invalid seckey")
+	}
+	return nil
+}
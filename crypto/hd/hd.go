@@ -0,0 +1,174 @@
+// Package hd derives keys of accounts from a single seed.
+//
+// Seeds are generated from BIP-39 mnemonics. Ed25519 keys are derived by SLIP-0010 and
+// secp256k1 keys by BIP-32, both along the path
+//
+//	m/44'/291'/account'/0'/index'
+//
+// where 291 is the coin type of IOST registered in SLIP-0044. Every level is hardened,
+// since SLIP-0010 only supports hardened derivation for ed25519, so the same path works
+// for both algorithms.
+package hd
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/tyler-smith/go-bip39"
+
+	"github.com/iost-official/go-iost/v3/crypto"
+)
+
+// constants of the derivation path
+const (
+	Purpose  uint32 = 44
+	CoinType uint32 = 291
+
+	// HardenedOffset is added to the index of hardened levels.
+	HardenedOffset uint32 = 0x80000000
+)
+
+// errors
+var (
+	ErrInvalidMnemonic      = errors.New("invalid mnemonic")
+	ErrInvalidPath          = errors.New("invalid derivation path")
+	ErrUnsupportedAlgorithm = errors.New("algorithm does not support hd derivation")
+	ErrInvalidKey           = errors.New("derived key is invalid")
+)
+
+var (
+	ed25519Curve   = []byte("ed25519 seed")
+	secp256k1Curve = []byte("Bitcoin seed")
+)
+
+// NewMnemonic generates a mnemonic with entropy of bits, which is a multiple of 32 in [128, 256].
+func NewMnemonic(bits int) (string, error) {
+	entropy, err := bip39.NewEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// NewSeed returns the seed of a mnemonic protected by passphrase, which can be empty.
+func NewSeed(mnemonic, passphrase string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
+
+// Path returns the derivation path of the key index of an account.
+func Path(account, index uint32) string {
+	return fmt.Sprintf("m/%d'/%d'/%d'/0'/%d'", Purpose, CoinType, account, index)
+}
+
+// ParsePath parses a derivation path like m/44'/291'/0'/0'/0'.
+func ParsePath(path string) ([]uint32, error) {
+	ss := strings.Split(strings.TrimSpace(path), "/")
+	if ss[0] != "m" {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPath, path)
+	}
+	ret := make([]uint32, 0, len(ss)-1)
+	for _, s := range ss[1:] {
+		hardened := strings.HasSuffix(s, "'") || strings.HasSuffix(s, "H")
+		if hardened {
+			s = s[:len(s)-1]
+		}
+		i, err := strconv.ParseUint(s, 10, 32)
+		if err != nil || uint32(i) >= HardenedOffset {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPath, path)
+		}
+		if hardened {
+			i += uint64(HardenedOffset)
+		}
+		ret = append(ret, uint32(i))
+	}
+	return ret, nil
+}
+
+// DeriveSeckey derives the secret key of algo at path from seed. The key is in the format of the algorithm.
+func DeriveSeckey(algo crypto.Algorithm, seed []byte, path string) ([]byte, error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	switch algo {
+	case crypto.Ed25519:
+		k, err := deriveEd25519(seed, indexes)
+		if err != nil {
+			return nil, err
+		}
+		return ed25519.NewKeyFromSeed(k), nil
+	case crypto.Secp256k1:
+		return deriveSecp256k1(seed, indexes)
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedAlgorithm, algo)
+	}
+}
+
+func hmacSHA512(key []byte, data ...[]byte) ([]byte, []byte) {
+	h := hmac.New(sha512.New, key)
+	for _, d := range data {
+		h.Write(d)
+	}
+	i := h.Sum(nil)
+	return i[:32], i[32:]
+}
+
+func indexBytes(i uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, i)
+	return b
+}
+
+// deriveEd25519 implements the private key derivation of SLIP-0010 for ed25519.
+func deriveEd25519(seed []byte, indexes []uint32) ([]byte, error) {
+	k, c := hmacSHA512(ed25519Curve, seed)
+	for _, i := range indexes {
+		if i < HardenedOffset {
+			return nil, fmt.Errorf("%w: ed25519 only supports hardened derivation", ErrInvalidPath)
+		}
+		k, c = hmacSHA512(c, []byte{0}, k, indexBytes(i))
+	}
+	return k, nil
+}
+
+// deriveSecp256k1 implements the private key derivation of BIP-32.
+func deriveSecp256k1(seed []byte, indexes []uint32) ([]byte, error) {
+	n := btcec.S256().N
+	k, c := hmacSHA512(secp256k1Curve, seed)
+	d := new(big.Int).SetBytes(k)
+	if d.Sign() == 0 || d.Cmp(n) >= 0 {
+		return nil, ErrInvalidKey
+	}
+	for _, i := range indexes {
+		var il []byte
+		if i >= HardenedOffset {
+			il, c = hmacSHA512(c, []byte{0}, k, indexBytes(i))
+		} else {
+			_, pub := btcec.PrivKeyFromBytes(btcec.S256(), k)
+			il, c = hmacSHA512(c, pub.SerializeCompressed(), indexBytes(i))
+		}
+		t := new(big.Int).SetBytes(il)
+		if t.Cmp(n) >= 0 {
+			return nil, ErrInvalidKey
+		}
+		d.Add(d, t).Mod(d, n)
+		if d.Sign() == 0 {
+			return nil, ErrInvalidKey
+		}
+		k = make([]byte, 32)
+		d.FillBytes(k)
+	}
+	return k, nil
+}
@@ -0,0 +1,57 @@
+package hd
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iost-official/go-iost/v3/crypto"
+)
+
+// Test vector 1 of SLIP-0010 and BIP-32.
+func TestDeriveSeckey(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+	k, err := DeriveSeckey(crypto.Ed25519, seed, "m")
+	assert.Nil(t, err)
+	assert.Equal(t, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", hex.EncodeToString(k[:32]))
+	k, err = DeriveSeckey(crypto.Ed25519, seed, "m/0'/1'")
+	assert.Nil(t, err)
+	assert.Equal(t, "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2", hex.EncodeToString(k[:32]))
+	assert.Nil(t, crypto.Ed25519.CheckSeckey(k))
+	_, err = DeriveSeckey(crypto.Ed25519, seed, "m/0'/1")
+	assert.True(t, errors.Is(err, ErrInvalidPath))
+
+	k, err = DeriveSeckey(crypto.Secp256k1, seed, "m/0'/1/2'")
+	assert.Nil(t, err)
+	assert.Equal(t, "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca", hex.EncodeToString(k))
+
+	_, err = DeriveSeckey(crypto.BLS, seed, "m/0'")
+	assert.True(t, errors.Is(err, ErrUnsupportedAlgorithm))
+}
+
+func TestParsePath(t *testing.T) {
+	p, err := ParsePath(Path(2, 5))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{44 + HardenedOffset, 291 + HardenedOffset, 2 + HardenedOffset, HardenedOffset, 5 + HardenedOffset}, p)
+
+	for _, s := range []string{"", "44'/0'", "m/x", "m/2147483648", "m//1"} {
+		_, err = ParsePath(s)
+		assert.True(t, errors.Is(err, ErrInvalidPath), s)
+	}
+}
+
+func TestNewSeed(t *testing.T) {
+	m, err := NewMnemonic(256)
+	assert.Nil(t, err)
+	s1, err := NewSeed(m, "")
+	assert.Nil(t, err)
+	s2, err := NewSeed(m, "passphrase")
+	assert.Nil(t, err)
+	assert.NotEqual(t, s1, s2)
+
+	_, err = NewSeed("abandon abandon abandon", "")
+	assert.Equal(t, ErrInvalidMnemonic, err)
+}
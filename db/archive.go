@@ -0,0 +1,350 @@
+package db
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/iost-official/go-iost/v3/db/mvcc"
+)
+
+// The key layout of the archive storage
+//
+//	v/<table>/<key>\x00<^version>  value written at version, 'd' marks a deleted key
+//	t/<tag>                        version of the tag
+//	/version                       latest version
+//
+// The version is stored inverted, so the newest value of a key is the first one in key order.
+const (
+	archiveValueFlag   = 'v'
+	archiveDeletedFlag = 'd'
+)
+
+var (
+	archiveVersionPrefix = []byte("v" + string(SEPARATOR))
+	archiveTagPrefix     = []byte("t" + string(SEPARATOR))
+	archiveVersionKey    = []byte(string(SEPARATOR) + "version")
+)
+
+// error of archive
+var (
+	ErrArchiveReadOnly    = fmt.Errorf("archive state is read only")
+	ErrArchiveTagNotFound = fmt.Errorf("tag not found in archive")
+)
+
+// Archive keeps every flushed version of a mvccdb, so the state of any tag flushed
+// after the archive is enabled can be read back.
+type Archive struct {
+	storage *kv.Storage
+	version uint64
+	rwmu    sync.RWMutex
+}
+
+// NewArchive opens the archive at path and attaches it to the mvccdb.
+// An empty archive is initialized with the current persisted state of the mvccdb.
+func NewArchive(path string, mvccdb MVCCDB) (*Archive, error) {
+	m, ok := mvccdb.(*CacheMVCCDB)
+	if !ok {
+		return nil, fmt.Errorf("archive is not supported by %T", mvccdb)
+	}
+	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to new storage: %v", err)
+	}
+	a := &Archive{
+		storage: storage,
+	}
+
+	tag, err := m.storage.Get([]byte(string(SEPARATOR) + "tag"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag from storage: %v", err)
+	}
+	version, err := storage.Get(archiveVersionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get version from archive: %v", err)
+	}
+	if len(version) == 0 {
+		if err := a.init(m.storage, string(tag)); err != nil {
+			return nil, fmt.Errorf("failed to init archive: %v", err)
+		}
+	} else {
+		a.version = binary.BigEndian.Uint64(version)
+		if _, err := a.tagVersion(string(tag)); err != nil {
+			return nil, fmt.Errorf("archive is behind the state db, remove it to rebuild from the current state: %v", err)
+		}
+	}
+
+	m.rwmu.Lock()
+	m.archive = a
+	m.rwmu.Unlock()
+	return a, nil
+}
+
+func (a *Archive) init(storage *kv.Storage, tag string) error {
+	iter := storage.NewIteratorByPrefix([]byte{})
+	defer iter.Release()
+
+	if err := a.storage.BeginBatch(); err != nil {
+		return err
+	}
+	for iter.Next() {
+		if iter.Key()[0] == SEPARATOR {
+			continue
+		}
+		value := append([]byte{archiveValueFlag}, iter.Value()...)
+		if err := a.storage.Put(archiveKey(iter.Key(), 0), value); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	if err := a.putVersion(tag, 0); err != nil {
+		return err
+	}
+	return a.storage.CommitBatch()
+}
+
+func (a *Archive) putVersion(tag string, version uint64) error {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, version)
+	if err := a.storage.Put(append(append([]byte{}, archiveTagPrefix...), tag...), v); err != nil {
+		return err
+	}
+	return a.storage.Put(archiveVersionKey, v)
+}
+
+func (a *Archive) tagVersion(tag string) (uint64, error) {
+	v, err := a.storage.Get(append(append([]byte{}, archiveTagPrefix...), tag...))
+	if err != nil {
+		return 0, err
+	}
+	if len(v) != 8 {
+		return 0, ErrArchiveTagNotFound
+	}
+	return binary.BigEndian.Uint64(v), nil
+}
+
+// record saves the items of the commit as a new version of the state.
+func (a *Archive) record(tag string, items []interface{}) error {
+	a.rwmu.Lock()
+	defer a.rwmu.Unlock()
+
+	version := a.version + 1
+	if err := a.storage.BeginBatch(); err != nil {
+		return err
+	}
+	for _, v := range items {
+		item, ok := v.(*Item)
+		if !ok {
+			return fmt.Errorf("can't assert Item type")
+		}
+		value := []byte{archiveDeletedFlag}
+		if !item.deleted {
+			value = append([]byte{archiveValueFlag}, item.value...)
+		}
+		err := a.storage.Put(archiveKey([]byte(item.table+string(SEPARATOR)+item.key), version), value)
+		if err != nil {
+			return err
+		}
+	}
+	if err := a.putVersion(tag, version); err != nil {
+		return err
+	}
+	if err := a.storage.CommitBatch(); err != nil {
+		return err
+	}
+	a.version = version
+	return nil
+}
+
+// Checkout returns a read only mvccdb of the state with the tag.
+func (a *Archive) Checkout(t string) (MVCCDB, error) {
+	version, err := a.tagVersion(t)
+	if err != nil {
+		return nil, err
+	}
+	m := &CacheMVCCDB{
+		stage: mvcc.NewCache(mvcc.MapCache),
+		storage: &kv.Storage{
+			StorageBackend: &archiveBackend{
+				archive: a,
+				version: version,
+			},
+		},
+		cm: NewCommitManager(),
+	}
+	m.Commit(t)
+	return m, nil
+}
+
+// Close will close the archive
+func (a *Archive) Close() error {
+	return a.storage.Close()
+}
+
+// get returns the value of the raw key at the version.
+func (a *Archive) get(key []byte, version uint64) ([]byte, bool, error) {
+	prefix := archiveKeyPrefix(key)
+	keys, err := a.storage.KeysByRange(archiveKey(key, version), prefixEnd(prefix), 1)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(keys) == 0 {
+		return nil, false, nil
+	}
+	value, err := a.storage.Get(keys[0])
+	if err != nil {
+		return nil, false, err
+	}
+	if len(value) == 0 || value[0] == archiveDeletedFlag {
+		return nil, false, nil
+	}
+	return value[1:], true, nil
+}
+
+func archiveKeyPrefix(key []byte) []byte {
+	prefix := make([]byte, 0, len(archiveVersionPrefix)+len(key)+1)
+	prefix = append(prefix, archiveVersionPrefix...)
+	prefix = append(prefix, key...)
+	return append(prefix, 0)
+}
+
+func archiveKey(key []byte, version uint64) []byte {
+	k := archiveKeyPrefix(key)
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, ^version)
+	return append(k, v...)
+}
+
+// prefixEnd returns the first key after all keys prefixed with prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// archiveBackend is the read only storage backend of the state at a version.
+type archiveBackend struct {
+	archive *Archive
+	version uint64
+}
+
+func (b *archiveBackend) Get(key []byte) ([]byte, error) {
+	value, ok, err := b.archive.get(key, b.version)
+	if err != nil || !ok {
+		return []byte{}, err
+	}
+	return value, nil
+}
+
+func (b *archiveBackend) Has(key []byte) (bool, error) {
+	_, ok, err := b.archive.get(key, b.version)
+	return ok, err
+}
+
+func (b *archiveBackend) Put(key []byte, value []byte) error {
+	return ErrArchiveReadOnly
+}
+
+func (b *archiveBackend) Delete(key []byte) error {
+	return ErrArchiveReadOnly
+}
+
+func (b *archiveBackend) Keys(prefix []byte) ([][]byte, error) {
+	return b.KeysByRange(prefix, prefixEnd(prefix), 0)
+}
+
+func (b *archiveBackend) KeysByRange(from []byte, to []byte, limit int) ([][]byte, error) {
+	keys := make([][]byte, 0)
+	start := append(append([]byte{}, archiveVersionPrefix...), from...)
+	end := prefixEnd(archiveVersionPrefix)
+	if len(to) > 0 {
+		end = append(append([]byte{}, archiveVersionPrefix...), to...)
+	}
+	for limit <= 0 || len(keys) < limit {
+		next, err := b.archive.storage.KeysByRange(start, end, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(next) == 0 {
+			break
+		}
+		key := next[0][len(archiveVersionPrefix) : len(next[0])-9]
+		_, ok, err := b.archive.get(key, b.version)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			keys = append(keys, key)
+		}
+		start = prefixEnd(archiveKeyPrefix(key))
+	}
+	return keys, nil
+}
+
+func (b *archiveBackend) BeginBatch() error {
+	return ErrArchiveReadOnly
+}
+
+func (b *archiveBackend) CommitBatch() error {
+	return ErrArchiveReadOnly
+}
+
+func (b *archiveBackend) Size() (int64, error) {
+	return b.archive.storage.Size()
+}
+
+func (b *archiveBackend) Close() error {
+	return nil
+}
+
+func (b *archiveBackend) NewIteratorByPrefix(prefix []byte) interface{} {
+	keys, err := b.Keys(prefix)
+	return &archiveIter{
+		backend: b,
+		keys:    keys,
+		index:   -1,
+		err:     err,
+	}
+}
+
+// archiveIter is the iterator of the state at a version.
+type archiveIter struct {
+	backend *archiveBackend
+	keys    [][]byte
+	index   int
+	value   []byte
+	err     error
+}
+
+func (i *archiveIter) Next() bool {
+	if i.err != nil || i.index+1 >= len(i.keys) {
+		return false
+	}
+	i.index++
+	i.value, i.err = i.backend.Get(i.keys[i.index])
+	return i.err == nil
+}
+
+func (i *archiveIter) Key() []byte {
+	return i.keys[i.index]
+}
+
+func (i *archiveIter) Value() []byte {
+	return i.value
+}
+
+func (i *archiveIter) Error() error {
+	return i.err
+}
+
+func (i *archiveIter) Release() {
+	i.keys = nil
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failedCommitBackend fails to commit batches.
+type failedCommitBackend struct {
+	kv.StorageBackend
+}
+
+func (b *failedCommitBackend) CommitBatch() error {
+	return errors.New("commit failed")
+}
+
+func TestArchive(t *testing.T) {
+	defer func() {
+		os.RemoveAll("archive_state")
+		os.RemoveAll("archive_versions")
+	}()
+	mvccdb, err := NewMVCCDB("archive_state")
+	require.Nil(t, err)
+	mvccdb.Put("table01", "key01", "value01")
+	mvccdb.Put("table01", "key02", "value02")
+	mvccdb.Commit("tag0")
+	require.Nil(t, mvccdb.Flush("tag0"))
+
+	archive, err := NewArchive("archive_versions", mvccdb)
+	require.Nil(t, err)
+
+	mvccdb.Put("table01", "key01", "value011")
+	mvccdb.Del("table01", "key02")
+	mvccdb.Put("table01", "key03", "value03")
+	mvccdb.Commit("tag1")
+	require.Nil(t, mvccdb.Flush("tag1"))
+
+	mvccdb.Put("table01", "key01", "value012")
+	mvccdb.Put("table01", "key02", "value022")
+	mvccdb.Commit("tag2")
+	require.Nil(t, mvccdb.Flush("tag2"))
+
+	expected := map[string]map[string]string{
+		"tag0": {"key01": "value01", "key02": "value02", "key03": ""},
+		"tag1": {"key01": "value011", "key02": "", "key03": "value03"},
+		"tag2": {"key01": "value012", "key02": "value022", "key03": "value03"},
+	}
+	for tag, values := range expected {
+		state, err := archive.Checkout(tag)
+		require.Nil(t, err)
+		assert.Equal(t, tag, state.CurrentTag())
+		for k, v := range values {
+			value, err := state.Get("table01", k)
+			assert.Nil(t, err)
+			assert.Equal(t, v, value, "%v %v", tag, k)
+			has, err := state.Has("table01", k)
+			assert.Nil(t, err)
+			assert.Equal(t, v != "", has, "%v %v", tag, k)
+		}
+	}
+
+	state, err := archive.Checkout("tag1")
+	require.Nil(t, err)
+	keys, err := state.KeysByRange("table01", "key", "kez", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key01", "key03"}, keys)
+	keys, err = state.KeysByRange("table01", "key", "kez", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key01"}, keys)
+
+	assert.Nil(t, state.Put("table01", "key01", "value"))
+	state.Commit("tag3")
+	assert.NotNil(t, state.Flush("tag3"))
+
+	_, err = archive.Checkout("tag3")
+	assert.Equal(t, ErrArchiveTagNotFound, err)
+
+	// the version is not archived if the state is not persisted
+	m := mvccdb.(*CacheMVCCDB)
+	backend := m.storage.StorageBackend
+	m.storage.StorageBackend = &failedCommitBackend{backend}
+	mvccdb.Put("table01", "key01", "value013")
+	mvccdb.Commit("tag4")
+	assert.NotNil(t, mvccdb.Flush("tag4"))
+	_, err = archive.Checkout("tag4")
+	assert.Equal(t, ErrArchiveTagNotFound, err)
+	m.storage.StorageBackend = backend
+
+	assert.Nil(t, archive.Close())
+	assert.Nil(t, mvccdb.Close())
+}
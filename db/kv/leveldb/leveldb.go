@@ -1,21 +1,35 @@
 package leveldb
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
+// ErrReadOnly is returned when writing a snapshot.
+var ErrReadOnly = errors.New("snapshot is read only")
+
+type iteratorSource interface {
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
 // DB is the leveldb databse
 type DB struct {
 	db    *leveldb.DB
 	batch *leveldb.Batch
+	// closed is called after a database kept in memory is closed
+	closed func()
 }
 
-// NewDB return new leveldb
+// NewDB return new leveldb, which is kept in memory if the path is under a directory of a memory.
 func NewDB(path string) (*DB, error) {
+	if m := memoryOf(path); m != nil {
+		return m.open(path)
+	}
 	db, err := leveldb.OpenFile(path, nil)
 	if err != nil {
 		return nil, err
@@ -62,18 +76,18 @@ func (d *DB) Delete(key []byte) error {
 // Keys returns the list of key prefixed with prefix
 func (d *DB) Keys(prefix []byte) ([][]byte, error) {
 	queryRange := util.BytesPrefix(prefix)
-	return d.keysByRange(queryRange, 0)
+	return keysByRange(d.db, queryRange, 0)
 }
 
 // Keys returns the list of key prefixed with prefix
 func (d *DB) KeysByRange(from []byte, to []byte, limit int) ([][]byte, error) {
 	// [from, to)
 	queryRange := &util.Range{Start: from, Limit: to}
-	return d.keysByRange(queryRange, limit)
+	return keysByRange(d.db, queryRange, limit)
 }
 
-func (d *DB) keysByRange(queryRange *util.Range, limit int) ([][]byte, error) {
-	iter := d.db.NewIterator(queryRange, nil)
+func keysByRange(src iteratorSource, queryRange *util.Range, limit int) ([][]byte, error) {
+	iter := src.NewIterator(queryRange, nil)
 	keys := make([][]byte, 0)
 	for iter.Next() {
 		key := make([]byte, len(iter.Key()))
@@ -128,7 +142,11 @@ func (d *DB) Size() (int64, error) {
 
 // Close will close the database
 func (d *DB) Close() error {
-	return d.db.Close()
+	err := d.db.Close()
+	if d.closed != nil {
+		d.closed()
+	}
+	return err
 }
 
 // NewIteratorByPrefix returns a new iterator by prefix
@@ -139,6 +157,86 @@ func (d *DB) NewIteratorByPrefix(prefix []byte) interface{} {
 	}
 }
 
+// NewSnapshot returns a read only snapshot of the current state of the database.
+func (d *DB) NewSnapshot() (*Snapshot, error) {
+	snap, err := d.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		db:   d.db,
+		snap: snap,
+	}, nil
+}
+
+// Snapshot is a read only view of the database at a point, which is not changed by the later writes.
+type Snapshot struct {
+	db   *leveldb.DB
+	snap *leveldb.Snapshot
+}
+
+// Get return the value of the specify key
+func (s *Snapshot) Get(key []byte) ([]byte, error) {
+	value, err := s.snap.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return []byte{}, nil
+	}
+	return value, err
+}
+
+// Has returns whether the specified key exists
+func (s *Snapshot) Has(key []byte) (bool, error) {
+	return s.snap.Has(key, nil)
+}
+
+// Put returns ErrReadOnly
+func (s *Snapshot) Put(key []byte, value []byte) error {
+	return ErrReadOnly
+}
+
+// Delete returns ErrReadOnly
+func (s *Snapshot) Delete(key []byte) error {
+	return ErrReadOnly
+}
+
+// Keys returns the list of key prefixed with prefix
+func (s *Snapshot) Keys(prefix []byte) ([][]byte, error) {
+	return keysByRange(s.snap, util.BytesPrefix(prefix), 0)
+}
+
+// KeysByRange returns the list of key in [from, to)
+func (s *Snapshot) KeysByRange(from []byte, to []byte, limit int) ([][]byte, error) {
+	return keysByRange(s.snap, &util.Range{Start: from, Limit: to}, limit)
+}
+
+// BeginBatch returns ErrReadOnly
+func (s *Snapshot) BeginBatch() error {
+	return ErrReadOnly
+}
+
+// CommitBatch returns ErrReadOnly
+func (s *Snapshot) CommitBatch() error {
+	return ErrReadOnly
+}
+
+// Size returns the size of the database
+func (s *Snapshot) Size() (int64, error) {
+	return (&DB{db: s.db}).Size()
+}
+
+// Close releases the snapshot, the database is not closed
+func (s *Snapshot) Close() error {
+	s.snap.Release()
+	return nil
+}
+
+// NewIteratorByPrefix returns a new iterator by prefix
+func (s *Snapshot) NewIteratorByPrefix(prefix []byte) interface{} {
+	return &Iter{
+		iter: s.snap.NewIterator(util.BytesPrefix(prefix), nil),
+	}
+}
+
 // Iter is the iterator for leveldb
 type Iter struct {
 	iter iterator.Iterator
@@ -0,0 +1,173 @@
+package leveldb
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+// memories are the directories of which the databases are kept in memory.
+var (
+	memoriesMu sync.Mutex
+	memories   = make(map[string]*Memory)
+)
+
+// Memory keeps the databases opened under a directory in memory instead of on disk. A closed database is kept
+// until the memory is released, so it can be opened again like a database on disk.
+type Memory struct {
+	dir string
+
+	mu  sync.Mutex
+	dbs map[string]*memDB
+}
+
+type memDB struct {
+	stor storage.Storage
+	// db is nil if the database is closed
+	db *leveldb.DB
+}
+
+// NewMemory keeps the databases opened under dir in memory, until the memory is released.
+func NewMemory(dir string) (*Memory, error) {
+	dir = filepath.Clean(dir)
+	memoriesMu.Lock()
+	defer memoriesMu.Unlock()
+	for d := range memories {
+		if within(d, dir) || within(dir, d) {
+			return nil, fmt.Errorf("%v is already kept in memory", d)
+		}
+	}
+	m := &Memory{
+		dir: dir,
+		dbs: make(map[string]*memDB),
+	}
+	memories[dir] = m
+	return m, nil
+}
+
+func memoryOf(path string) *Memory {
+	path = filepath.Clean(path)
+	memoriesMu.Lock()
+	defer memoriesMu.Unlock()
+	for dir, m := range memories {
+		if within(dir, path) {
+			return m
+		}
+	}
+	return nil
+}
+
+// within returns whether path is dir or under dir.
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// Dir returns the directory of the memory.
+func (m *Memory) Dir() string {
+	return m.dir
+}
+
+func (m *Memory) open(path string) (*DB, error) {
+	name, err := filepath.Rel(m.dir, filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	mdb, ok := m.dbs[name]
+	if !ok {
+		mdb = &memDB{stor: storage.NewMemStorage()}
+		m.dbs[name] = mdb
+	}
+	if mdb.db != nil {
+		return nil, fmt.Errorf("%v is already opened", path)
+	}
+	db, err := leveldb.Open(mdb.stor, nil)
+	if err != nil {
+		return nil, err
+	}
+	mdb.db = db
+	return &DB{
+		db:     db,
+		closed: func() { m.closed(name) },
+	}, nil
+}
+
+func (m *Memory) closed(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if mdb, ok := m.dbs[name]; ok {
+		mdb.db = nil
+	}
+}
+
+// Copy copies the databases to a new memory kept at dir. The databases may be open while they are copied, each of
+// them is copied from a snapshot of its own.
+func (m *Memory) Copy(dir string) (*Memory, error) {
+	c, err := NewMemory(dir)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for name, mdb := range m.dbs {
+		stor := storage.NewMemStorage()
+		if err := copyDB(mdb, stor); err != nil {
+			c.Release()
+			return nil, fmt.Errorf("copy %v failed: %v", name, err)
+		}
+		c.dbs[name] = &memDB{stor: stor}
+	}
+	return c, nil
+}
+
+func copyDB(src *memDB, stor storage.Storage) error {
+	from := src.db
+	if from == nil {
+		db, err := leveldb.Open(src.stor, nil)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		from = db
+	}
+	snap, err := from.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+
+	to, err := leveldb.Open(stor, nil)
+	if err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	iter := snap.NewIterator(nil, nil)
+	for iter.Next() {
+		batch.Put(iter.Key(), iter.Value())
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		to.Close()
+		return err
+	}
+	if err := to.Write(batch, nil); err != nil {
+		to.Close()
+		return err
+	}
+	return to.Close()
+}
+
+// Release drops the databases of the memory, they should be closed before.
+func (m *Memory) Release() {
+	memoriesMu.Lock()
+	defer memoriesMu.Unlock()
+	if memories[m.dir] == m {
+		delete(memories, m.dir)
+	}
+}
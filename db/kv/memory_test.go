@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "memory")
+	m, err := NewMemory(dir)
+	require.Nil(t, err)
+	defer m.Release()
+
+	_, err = NewMemory(filepath.Join(dir, "sub"))
+	assert.NotNil(t, err)
+
+	path := filepath.Join(dir, "db")
+	storage, err := NewStorage(path, LevelDBStorage)
+	require.Nil(t, err)
+	require.Nil(t, storage.Put([]byte("key01"), []byte("value01")))
+	_, err = NewStorage(path, LevelDBStorage)
+	assert.NotNil(t, err, "opened twice")
+	require.Nil(t, storage.Close())
+
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err), "written to disk")
+
+	storage, err = NewStorage(path, LevelDBStorage)
+	require.Nil(t, err)
+	value, err := storage.Get([]byte("key01"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value01"), value)
+
+	c, err := m.Copy(filepath.Join(t.TempDir(), "copy"))
+	require.Nil(t, err)
+	defer c.Release()
+	require.Nil(t, storage.Put([]byte("key02"), []byte("value02")))
+	require.Nil(t, storage.Close())
+
+	copied, err := NewStorage(filepath.Join(c.Dir(), "db"), LevelDBStorage)
+	require.Nil(t, err)
+	defer copied.Close()
+	value, err = copied.Get([]byte("key01"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value01"), value)
+	value, err = copied.Get([]byte("key02"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{}, value, "written after the copy")
+}
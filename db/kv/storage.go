@@ -33,6 +33,15 @@ type Storage struct {
 	StorageBackend
 }
 
+// Memory keeps the databases under a directory in memory, see NewMemory.
+type Memory = leveldb.Memory
+
+// NewMemory keeps the databases opened under dir in memory instead of on disk, until the memory is released.
+// It is meant for local development chains, which are thrown away when they stop.
+func NewMemory(dir string) (*Memory, error) {
+	return leveldb.NewMemory(dir)
+}
+
 // NewStorage return the storage of the specify type
 func NewStorage(path string, t StorageType) (*Storage, error) {
 	switch t {
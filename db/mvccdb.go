@@ -145,7 +145,10 @@ type CacheMVCCDB struct {
 	stage   mvcc.Cache
 	storage *kv.Storage
 	cm      *CommitManager
+	archive *Archive
 	rwmu    sync.RWMutex
+
+	flushHook func(t string)
 }
 
 // NewCacheMVCCDB returns new CacheMVCCDB
@@ -263,52 +266,54 @@ func (m *CacheMVCCDB) Has(table string, key string) (bool, error) {
 	return true, nil
 }
 
-// Keys returns the list of key prefixed with prefix in the table
+// KeysByRange returns the keys in [from, to) of the table, at most limit keys if limit is positive
 func (m *CacheMVCCDB) KeysByRange(table string, from string, to string, limit int) ([]string, error) {
 	if !m.isValidTable(table) {
 		return nil, ErrTableNotValid
 	}
 
-	var deletedKeys []string
-	var cachedKeys []string
+	// the stage lists the items of the older commits first, so the later items override
+	cached := make(map[string]bool)
+	deletedNum := 0
 	for _, v := range m.stage.All([]byte("")) {
 		item, ok := v.(*Item)
 		if !ok {
 			continue
 		}
-		if !(from <= item.key && item.key < to) {
+		if item.table != table || !(from <= item.key && item.key < to) {
 			continue
 		}
+		cached[item.key] = !item.deleted
 		if item.deleted {
-			deletedKeys = append(deletedKeys, item.key)
-		} else {
-			cachedKeys = append(cachedKeys, item.key)
+			deletedNum++
 		}
 	}
 
 	fromBytes := []byte(table + string(SEPARATOR) + from)
 	toBytes := []byte(table + string(SEPARATOR) + to)
-	keys, err := m.storage.KeysByRange(fromBytes, toBytes, limit)
+	storageLimit := limit
+	if limit > 0 {
+		storageLimit += deletedNum
+	}
+	keys, err := m.storage.KeysByRange(fromBytes, toBytes, storageLimit)
 	if err != nil {
 		return nil, err
 	}
-	results := make([]string, 0, len(keys)+len(cachedKeys))
+	results := make([]string, 0, len(keys)+len(cached))
 	for _, item := range keys {
 		keyWithoutPrefix := strings.TrimPrefix(string(item), table+string(SEPARATOR))
-		deleted := false
-		for _, s := range deletedKeys {
-			if s == keyWithoutPrefix {
-				deleted = true
-				break
-			}
+		if _, ok := cached[keyWithoutPrefix]; ok {
+			continue
 		}
-		if !deleted {
-			results = append(results, keyWithoutPrefix)
+		results = append(results, keyWithoutPrefix)
+	}
+	for k, exist := range cached {
+		if exist {
+			results = append(results, k)
 		}
 	}
-	results = append(results, cachedKeys...)
 	sort.Strings(results)
-	if len(results) > limit {
+	if limit > 0 && len(results) > limit {
 		results = results[:limit]
 	}
 	return results, nil
@@ -357,6 +362,9 @@ func (m *CacheMVCCDB) Fork() MVCCDB {
 		stage:   m.head.ForkCache(),
 		storage: m.storage,
 		cm:      m.cm,
+		archive: m.archive,
+
+		flushHook: m.flushHook,
 	}
 	return mvccdb
 }
@@ -394,7 +402,16 @@ func (m *CacheMVCCDB) Flush(t string) error {
 	if err := m.storage.CommitBatch(); err != nil {
 		return err
 	}
+	// archive the version after the state is persisted, an archive left behind by a crash is found when it is opened
+	if m.archive != nil {
+		if err := m.archive.record(t, commit.All([]byte(""))); err != nil {
+			return fmt.Errorf("failed to archive %v: %v", t, err)
+		}
+	}
 	m.cm.FreeBefore(commit)
+	if m.flushHook != nil {
+		m.flushHook(t)
+	}
 	return nil
 }
 
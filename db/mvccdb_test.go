@@ -255,6 +255,26 @@ func (suite *MVCCDBTestSuite) TestFlushAndCheckout() {
 	suite.Equal("", value)
 }
 
+func (suite *MVCCDBTestSuite) TestKeysByRange() {
+	err := suite.mvccdb.Flush("tag0")
+	suite.Nil(err)
+
+	suite.Nil(suite.mvccdb.Put("table01", "key02", "value022"))
+	suite.Nil(suite.mvccdb.Put("table01", "key06", "value06"))
+	suite.Nil(suite.mvccdb.Del("table01", "key03"))
+	suite.Nil(suite.mvccdb.Put("table02", "key07", "value07"))
+
+	keys, err := suite.mvccdb.KeysByRange("table01", "key", "kez", 0)
+	suite.Nil(err)
+	suite.Equal([]string{"key01", "key02", "key04", "key05", "key06"}, keys)
+	keys, err = suite.mvccdb.KeysByRange("table01", "key", "kez", 3)
+	suite.Nil(err)
+	suite.Equal([]string{"key01", "key02", "key04"}, keys)
+	keys, err = suite.mvccdb.KeysByRange("table02", "key", "kez", 0)
+	suite.Nil(err)
+	suite.Equal([]string{"key07"}, keys)
+}
+
 func (suite *MVCCDBTestSuite) TearDownTest() {
 	err := suite.mvccdb.Close()
 	suite.Nil(err, "Close MVCCDB should not fail")
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/iost-official/go-iost/v3/db/kv/leveldb"
+	"github.com/iost-official/go-iost/v3/db/mvcc"
+)
+
+// NewSnapshot returns a read only mvccdb of the state flushed to the storage of the mvccdb, whose tag is the tag of
+// the flush. It is not changed by the later flushes, and should be closed to release the storage.
+func NewSnapshot(mvccdb MVCCDB) (MVCCDB, error) {
+	m, ok := mvccdb.(*CacheMVCCDB)
+	if !ok {
+		return nil, fmt.Errorf("snapshot is not supported by %T", mvccdb)
+	}
+	ldb, ok := m.storage.StorageBackend.(*leveldb.DB)
+	if !ok {
+		return nil, fmt.Errorf("snapshot is not supported by %T", m.storage.StorageBackend)
+	}
+	snap, err := ldb.NewSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot: %v", err)
+	}
+	tag, err := snap.Get([]byte(string(SEPARATOR) + "tag"))
+	if err != nil {
+		snap.Close()
+		return nil, fmt.Errorf("failed to get tag from snapshot: %v", err)
+	}
+	s := &CacheMVCCDB{
+		stage:   mvcc.NewCache(mvcc.MapCache),
+		storage: &kv.Storage{StorageBackend: snap},
+		cm:      NewCommitManager(),
+	}
+	s.Commit(string(tag))
+	return s, nil
+}
+
+// SetFlushHook sets the function called with the tag after the state of the tag is flushed to the storage of the
+// mvccdb. It is called in the flush, so it should return quickly, and it should be set before the mvccdb is flushed.
+func SetFlushHook(mvccdb MVCCDB, fn func(t string)) error {
+	m, ok := mvccdb.(*CacheMVCCDB)
+	if !ok {
+		return fmt.Errorf("flush hook is not supported by %T", mvccdb)
+	}
+	m.flushHook = fn
+	return nil
+}
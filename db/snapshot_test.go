@@ -0,0 +1,49 @@
+package db
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	defer os.RemoveAll("snapshot_state")
+	mvccdb, err := NewMVCCDB("snapshot_state")
+	require.Nil(t, err)
+	defer mvccdb.Close()
+
+	flushed := make([]string, 0)
+	require.Nil(t, SetFlushHook(mvccdb, func(t string) {
+		flushed = append(flushed, t)
+	}))
+	mvccdb.Put("table01", "key01", "value01")
+	mvccdb.Put("table01", "key02", "value02")
+	mvccdb.Commit("tag0")
+	require.Nil(t, mvccdb.Flush("tag0"))
+
+	snap, err := NewSnapshot(mvccdb)
+	require.Nil(t, err)
+	defer snap.Close()
+
+	mvccdb.Put("table01", "key01", "value011")
+	mvccdb.Del("table01", "key02")
+	mvccdb.Put("table01", "key03", "value03")
+	mvccdb.Commit("tag1")
+	require.Nil(t, mvccdb.Flush("tag1"))
+	assert.Equal(t, []string{"tag0", "tag1"}, flushed)
+
+	assert.Equal(t, "tag0", snap.CurrentTag())
+	value, err := snap.Get("table01", "key01")
+	assert.Nil(t, err)
+	assert.Equal(t, "value01", value)
+	keys, err := snap.KeysByRange("table01", "", "z", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key01", "key02"}, keys)
+	assert.NotNil(t, snap.Flush("tag0"))
+
+	value, err = mvccdb.Get("table01", "key01")
+	assert.Nil(t, err)
+	assert.Equal(t, "value011", value)
+}
@@ -236,6 +236,18 @@ func (w *WAL) SaveSingle(ent *Entry) (uint64, error) {
 	return w.lastEntryIndex, w.cut()
 }
 
+// Cut starts a new tail file, so the entries saved before can be removed by RemoveFilesBefore.
+// It returns the index of the next entry.
+func (w *WAL) Cut() (uint64, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.encoder.flush(); err != nil {
+		return 0, err
+	}
+	return w.lastEntryIndex, w.cut()
+}
+
 // RemoveFilesBefore remove files less than index
 func (w *WAL) RemoveFilesBefore(index uint64) error {
 	w.mu.Lock()
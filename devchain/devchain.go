@@ -0,0 +1,411 @@
+// Package devchain runs a single node chain for developing and testing contracts locally. Blocks are mined on
+// demand instead of every half second, the clock of the chain can jump forward, and the state can be snapshotted
+// and reverted, so that contract tests are deterministic and do not wait for real time to pass.
+package devchain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/chainbase"
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/global"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/crypto"
+	"github.com/iost-official/go-iost/v3/db"
+	"github.com/iost-official/go-iost/v3/db/kv"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/rpc"
+	"github.com/iost-official/go-iost/v3/verifier"
+)
+
+// DefaultWitnessKey is the key of producer000, the witness of config/genesis.
+const DefaultWitnessKey = "1rANSfcRzr4HkhbUFZ7L1Zp69JZZHiDDq5v7dNSbbEqeU4jxy3fszV4HGiaLQEyqVpS1dKT9g7zCVRxBVzuiUzB"
+
+// errors
+var (
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+	ErrNegativeTime     = errors.New("time can not go backward")
+)
+
+// Config is the config of a development chain.
+type Config struct {
+	// Genesis is the directory of genesis.yml and the genesis contracts.
+	Genesis string
+	// Witnesses are the keys to produce blocks with. Slots of witnesses without keys are skipped.
+	Witnesses []*account.KeyPair
+	ChainID   uint32
+	RPC       *common.RPCConfig
+	// DevAddr is the address of the http api to mine blocks, travel in time and snapshot the chain.
+	DevAddr string
+	// AutoMine mines a block as soon as txs are pending.
+	AutoMine bool
+	// BlockTime mines blocks periodically if it is not zero.
+	BlockTime time.Duration
+	// ContractLog prints the console logs of contracts.
+	ContractLog bool
+}
+
+// DefaultConfig returns the config of a chain booted from config/genesis, which serves the rpc
+// on the default ports and mines blocks automatically.
+func DefaultConfig() *Config {
+	kp, err := account.NewKeyPair(common.Base58Decode(DefaultWitnessKey), crypto.Ed25519)
+	if err != nil {
+		panic(err)
+	}
+	return &Config{
+		Genesis:   "config/genesis",
+		Witnesses: []*account.KeyPair{kp},
+		ChainID:   1020,
+		RPC: &common.RPCConfig{
+			Enable:       true,
+			GatewayAddr:  "0.0.0.0:30001",
+			GRPCAddr:     "0.0.0.0:30002",
+			AllowOrigins: []string{"*"},
+			TryTx:        true,
+			ExecTx:       true,
+		},
+		DevAddr:  "0.0.0.0:30004",
+		AutoMine: true,
+	}
+}
+
+type snapshot struct {
+	// memory is the copy of the databases
+	memory *kv.Memory
+	// root is the last irreversible block, of which the state is in the databases
+	root   *block.Block
+	active []string
+	// blocks are the reversible blocks after the root
+	blocks []*block.Block
+	offset time.Duration
+}
+
+// Chain is a development chain. The databases of the chain are kept in memory, only the write ahead logs of the
+// block cache are written to a temporary directory which is removed on Close.
+//
+// The chain sets the global config of the node, so only one chain should run in a process.
+type Chain struct {
+	conf     *Config
+	nodeConf *common.Config
+	keys     map[string]*account.KeyPair
+	clock    *common.Clock
+
+	dir       string
+	instances int
+	memory    *kv.Memory
+	cBase     *chainbase.ChainBase
+	produceDB db.MVCCDB
+	snapshots []*snapshot
+
+	serving   bool
+	rpcServer *rpc.Server
+	devServer *devServer
+
+	mu     sync.Mutex
+	quitCh chan struct{}
+	wg     *sync.WaitGroup
+}
+
+// New boots a chain from the genesis of the config.
+func New(conf *Config) (*Chain, error) {
+	if _, err := os.Stat(filepath.Join(conf.Genesis, "genesis.yml")); err != nil {
+		return nil, fmt.Errorf("invalid genesis: %v", err)
+	}
+	if len(conf.Witnesses) == 0 {
+		return nil, fmt.Errorf("no witness key to produce blocks")
+	}
+	dir, err := os.MkdirTemp("", "devchain")
+	if err != nil {
+		return nil, err
+	}
+	rpcConf := conf.RPC
+	if rpcConf == nil {
+		rpcConf = &common.RPCConfig{}
+	}
+	nodeConf := &common.Config{
+		Genesis: conf.Genesis,
+		DB: &common.DBConfig{
+			TxIndex:    true,
+			EventStore: true,
+			StateDiff:  true,
+		},
+		TxPool: &common.TxPoolConfig{},
+		P2P:    &common.P2PConfig{ChainID: conf.ChainID},
+		RPC:    rpcConf,
+		Log:    &common.LogConfig{EnableContractLog: conf.ContractLog},
+	}
+	global.SetGlobalConf(nodeConf)
+	tx.ChainID = conf.ChainID
+
+	c := &Chain{
+		conf:     conf,
+		nodeConf: nodeConf,
+		keys:     make(map[string]*account.KeyPair),
+		clock:    new(common.Clock),
+		dir:      dir,
+		quitCh:   make(chan struct{}),
+		wg:       new(sync.WaitGroup),
+	}
+	for _, kp := range conf.Witnesses {
+		c.keys[kp.ReadablePubkey()] = kp
+	}
+	memory, err := kv.NewMemory(c.nextDir())
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	cBase, err := chainbase.New(c.dbConf(memory), c.clock)
+	if err != nil {
+		memory.Release()
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	c.memory = memory
+	c.cBase = cBase
+	c.produceDB = cBase.StateDB().Fork()
+	return c, nil
+}
+
+// nextDir returns a new directory for the databases of the chain.
+func (c *Chain) nextDir() string {
+	c.instances++
+	return filepath.Join(c.dir, strconv.Itoa(c.instances))
+}
+
+// dbConf returns the config of the node with the databases in memory.
+func (c *Chain) dbConf(memory *kv.Memory) *common.Config {
+	conf := *c.nodeConf
+	dbConf := *conf.DB
+	dbConf.LdbPath = memory.Dir() + "/"
+	conf.DB = &dbConf
+	return &conf
+}
+
+// Close stops the chain and removes its state.
+func (c *Chain) Close() {
+	c.Stop()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cBase.Close()
+	c.memory.Release()
+	for _, s := range c.snapshots {
+		s.memory.Release()
+	}
+	c.snapshots = nil
+	os.RemoveAll(c.dir)
+}
+
+// ChainBase returns the chainbase of the chain, which is replaced when the chain is reverted.
+func (c *Chain) ChainBase() *chainbase.ChainBase {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cBase
+}
+
+// Head returns the head block.
+func (c *Chain) Head() *block.Block {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cBase.HeadBlock().Block
+}
+
+// Time returns the time of the chain.
+func (c *Chain) Time() time.Time {
+	return c.clock.Now()
+}
+
+// IncreaseTime moves the clock of the chain forward, the next block is mined at the new time.
+func (c *Chain) IncreaseTime(d time.Duration) error {
+	if d < 0 {
+		return ErrNegativeTime
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clock.SetOffset(c.clock.Offset() + d)
+	return nil
+}
+
+// Mine mines n blocks with the pending txs. Blocks are mined at the time of the chain, or right after
+// their parents if the chain mines faster than the block interval, in which case the clock of the chain
+// moves forward to the last block.
+func (c *Chain) Mine(n int) ([]*block.Block, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	blks := make([]*block.Block, 0, n)
+	for i := 0; i < n; i++ {
+		blk, err := c.mineBlock()
+		if err != nil {
+			return blks, err
+		}
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+func (c *Chain) mineBlock() (*block.Block, error) {
+	pTx, head := c.cBase.TxPool().PendingTx()
+	t, kp, err := nextBlockTime(head, c.keys, c.clock.Now().UnixNano())
+	if err != nil {
+		return nil, err
+	}
+	if d := time.Duration(t - c.clock.Now().UnixNano()); d > 0 {
+		c.clock.SetOffset(c.clock.Offset() + d)
+	}
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Version:    block.V1,
+			ParentHash: head.HeadHash(),
+			Info:       make([]byte, 0),
+			Number:     head.Head.Number + 1,
+			Witness:    kp.ReadablePubkey(),
+			Time:       t,
+		},
+		Txs:      []*tx.Tx{},
+		Receipts: []*tx.TxReceipt{},
+	}
+
+	c.produceDB.Checkout(string(head.HeadHash()))
+	v := &verifier.Executor{}
+	dropList, _, err := v.Gen(
+		blk, head.Block, head.WitnessList, c.produceDB, pTx,
+		&verifier.Config{
+			Mode:        0,
+			Timeout:     common.MaxBlockTimeLimit,
+			TxTimeLimit: common.MaxTxTimeLimit,
+		},
+	)
+	for _, t := range dropList {
+		c.cBase.TxPool().DelTx(t.Hash())
+	}
+	if err != nil {
+		return nil, err
+	}
+	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+	blk.CalculateHeadHash()
+	blk.Sign = kp.Sign(blk.HeadHash())
+	c.produceDB.Commit(string(blk.HeadHash()))
+
+	if err := c.cBase.Add(blk, false, true); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
+
+// nextBlockTime returns the time of the block next to the head and the key of the witness to produce it.
+// The block is mined no earlier than now, and no earlier than one block interval after the head.
+func nextBlockTime(head *blockcache.BlockCacheNode, keys map[string]*account.KeyPair, now int64) (int64, *account.KeyPair, error) {
+	t := now
+	if min := head.Head.Time + int64(common.BlockInterval); t < min {
+		t = min
+	}
+	active := head.Active()
+	for i := 0; i <= len(active); i++ {
+		witness := common.WitnessOfNanoSec(t, active)
+		full := witness == head.Head.Witness &&
+			common.SlotOfUnixNano(t) == common.SlotOfUnixNano(head.Head.Time) &&
+			head.SerialNum+1 >= int64(common.BlockNumPerWitness)
+		if kp, ok := keys[witness]; ok && !full {
+			return t, kp, nil
+		}
+		t = (common.SlotOfUnixNano(t) + 1) * int64(common.SlotInterval)
+	}
+	return 0, nil, fmt.Errorf("no key of the active witnesses %v", active)
+}
+
+// Snapshot copies the databases of the chain and returns the id to revert to it.
+func (c *Chain) Snapshot() (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	memory, err := c.memory.Copy(c.nextDir())
+	if err != nil {
+		return 0, err
+	}
+	root := c.cBase.BlockCache().LinkedRoot()
+	s := &snapshot{
+		memory: memory,
+		root:   root.Block,
+		active: root.Active(),
+		offset: c.clock.Offset(),
+	}
+	for n := c.cBase.BlockCache().Head(); n != root; n = n.GetParent() {
+		s.blocks = append([]*block.Block{n.Block}, s.blocks...)
+	}
+	c.snapshots = append(c.snapshots, s)
+	return len(c.snapshots), nil
+}
+
+// Revert reverts the chain to the snapshot, dropping the pending txs, the snapshot and the ones taken after it.
+// The clock of the chain goes back to the time of the snapshot, plus the real time passed since then.
+//
+// The chain is opened again from the copy of the databases, the reversible blocks of the snapshot are executed
+// again on top of it, and the rpc server is restarted to serve the reverted chain.
+func (c *Chain) Revert(id int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if id < 1 || id > len(c.snapshots) {
+		return ErrSnapshotNotFound
+	}
+	s := c.snapshots[id-1]
+
+	conf := c.dbConf(s.memory)
+	if err := blockcache.WriteRootActiveWAL(conf, s.root, s.active); err != nil {
+		return err
+	}
+	cBase, err := chainbase.New(conf, c.clock)
+	if err != nil {
+		return err
+	}
+	produceDB := cBase.StateDB().Fork()
+	for _, blk := range s.blocks {
+		if err := addBlock(cBase, produceDB, blk); err != nil {
+			cBase.Close()
+			return fmt.Errorf("execute block %v failed: %v", blk.Head.Number, err)
+		}
+	}
+	ilog.Infof("Reverted to block %v.", cBase.HeadBlock().Head.Number)
+
+	c.stopRPC()
+	c.cBase.Close()
+	c.memory.Release()
+	for _, later := range c.snapshots[id:] {
+		later.memory.Release()
+	}
+	c.snapshots = c.snapshots[:id-1]
+	c.memory = s.memory
+	c.cBase = cBase
+	c.produceDB = produceDB
+	c.clock.SetOffset(s.offset)
+	return c.startRPC()
+}
+
+// addBlock executes the block on top of the head in produceDB and adds it to the chain.
+func addBlock(cBase *chainbase.ChainBase, produceDB db.MVCCDB, blk *block.Block) error {
+	parent := cBase.BlockCache().Head()
+	if !bytes.Equal(parent.HeadHash(), blk.Head.ParentHash) {
+		return fmt.Errorf("parent is not the head %v", parent.Head.Number)
+	}
+	produceDB.Checkout(string(blk.Head.ParentHash))
+	v := verifier.Verifier{}
+	err := v.Verify(blk, parent.Block, parent.WitnessList, produceDB, &verifier.Config{
+		Mode:        0,
+		Timeout:     common.MaxBlockTimeLimit,
+		TxTimeLimit: common.MaxTxTimeLimit,
+	})
+	if err != nil {
+		return err
+	}
+	produceDB.Commit(string(blk.HeadHash()))
+	return cBase.Add(blk, false, true)
+}
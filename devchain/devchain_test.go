@@ -0,0 +1,58 @@
+package devchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextBlockTime(t *testing.T) {
+	kp0, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	kp1, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	w0, w1 := kp0.ReadablePubkey(), kp1.ReadablePubkey()
+	keys := map[string]*account.KeyPair{w0: kp0}
+
+	slot := int64(1000)
+	slotTime := slot * int64(common.SlotInterval)
+	head := &blockcache.BlockCacheNode{
+		Block:       &block.Block{Head: &block.BlockHead{Witness: w0, Time: slotTime}},
+		WitnessList: &blockcache.WitnessList{},
+	}
+	head.SetActive([]string{w0, w1})
+
+	// right after the head when the chain mines faster than real time
+	tm, kp, err := nextBlockTime(head, keys, slotTime)
+	assert.Nil(t, err)
+	assert.Equal(t, slotTime+int64(common.BlockInterval), tm)
+	assert.Equal(t, w0, kp.ReadablePubkey())
+
+	// at the time of the chain
+	now := slotTime + int64(2*time.Second)
+	tm, _, err = nextBlockTime(head, keys, now)
+	assert.Nil(t, err)
+	assert.Equal(t, now, tm)
+
+	// skip the slots of witnesses without keys
+	now = slotTime + int64(common.SlotInterval)
+	tm, kp, err = nextBlockTime(head, keys, now)
+	assert.Nil(t, err)
+	assert.Equal(t, slotTime+2*int64(common.SlotInterval), tm)
+	assert.Equal(t, w0, kp.ReadablePubkey())
+
+	// skip the rest of a slot full of blocks
+	head.SerialNum = int64(common.BlockNumPerWitness - 1)
+	tm, _, err = nextBlockTime(head, keys, slotTime)
+	assert.Nil(t, err)
+	assert.Equal(t, slotTime+2*int64(common.SlotInterval), tm)
+
+	_, _, err = nextBlockTime(head, map[string]*account.KeyPair{}, slotTime)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,26 @@
+package devchain
+
+import (
+	"github.com/iost-official/go-iost/v3/p2p"
+)
+
+// network is a p2p service without any peer, txs sent to the chain stay in its txpool.
+type network struct{}
+
+var _ p2p.Service = network{}
+
+func (network) Start() error                                                        { return nil }
+func (network) Stop()                                                               {}
+func (network) ID() string                                                          { return "devchain" }
+func (network) ConnectBPs([]string)                                                 {}
+func (network) PutPeerToBlack(string)                                               {}
+func (network) AdjustPeerScore(p2p.PeerID, int)                                     {}
+func (network) PeerScore(p2p.PeerID) int                                            { return 0 }
+func (network) Broadcast([]byte, p2p.MessageType, p2p.MessagePriority)              {}
+func (network) SendToPeer(p2p.PeerID, []byte, p2p.MessageType, p2p.MessagePriority) {}
+func (network) Deregister(string, ...p2p.MessageType)                               {}
+func (network) GetAllNeighbors() []*p2p.Peer                                        { return nil }
+
+func (network) Register(string, ...p2p.MessageType) chan p2p.IncomingMessage {
+	return make(chan p2p.IncomingMessage)
+}
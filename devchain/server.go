@@ -0,0 +1,250 @@
+package devchain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/rpc"
+)
+
+var autoMineInterval = 100 * time.Millisecond
+
+// Start serves the rpc and the dev api of the chain, and starts mining blocks if it is configured to.
+func (c *Chain) Start() error {
+	c.mu.Lock()
+	c.serving = true
+	err := c.startRPC()
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if c.conf.DevAddr != "" {
+		c.devServer = newDevServer(c, c.conf.DevAddr)
+		c.devServer.Start()
+	}
+	if c.conf.AutoMine || c.conf.BlockTime > 0 {
+		c.wg.Add(1)
+		go c.mineLoop()
+	}
+	return nil
+}
+
+// Stop stops serving and mining.
+func (c *Chain) Stop() {
+	select {
+	case <-c.quitCh:
+		return
+	default:
+	}
+	close(c.quitCh)
+	c.wg.Wait()
+	if c.devServer != nil {
+		c.devServer.Stop()
+	}
+	c.mu.Lock()
+	c.serving = false
+	c.stopRPC()
+	c.mu.Unlock()
+}
+
+func (c *Chain) startRPC() error {
+	if !c.serving || !c.nodeConf.RPC.Enable {
+		return nil
+	}
+	c.rpcServer = rpc.New(c.cBase.TxPool(), c.cBase, c.nodeConf, network{})
+	return c.rpcServer.Start()
+}
+
+func (c *Chain) stopRPC() {
+	if c.rpcServer != nil {
+		c.rpcServer.Stop()
+		c.rpcServer = nil
+	}
+}
+
+func (c *Chain) pendingSize() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pTx, _ := c.cBase.TxPool().PendingTx()
+	return pTx.Size()
+}
+
+func (c *Chain) mineLoop() {
+	defer c.wg.Done()
+
+	var blockTicker, autoMineTicker <-chan time.Time
+	if c.conf.BlockTime > 0 {
+		t := time.NewTicker(c.conf.BlockTime)
+		defer t.Stop()
+		blockTicker = t.C
+	}
+	if c.conf.AutoMine {
+		t := time.NewTicker(autoMineInterval)
+		defer t.Stop()
+		autoMineTicker = t.C
+	}
+	// the count of pending txs which could not be mined last time, such as txs from the future
+	idle := 0
+	for {
+		select {
+		case <-blockTicker:
+			if _, err := c.Mine(1); err != nil {
+				ilog.Errorf("Mine block failed: %v", err)
+			}
+		case <-autoMineTicker:
+			size := c.pendingSize()
+			if size == 0 || size == idle {
+				continue
+			}
+			blks, err := c.Mine(1)
+			if err != nil {
+				ilog.Errorf("Mine block failed: %v", err)
+				continue
+			}
+			if len(blks[0].Txs) > 1 {
+				idle = 0
+			} else {
+				idle = size
+			}
+		case <-c.quitCh:
+			return
+		}
+	}
+}
+
+// devServer serves the http api to control the chain.
+type devServer struct {
+	chain *Chain
+	srv   *http.Server
+}
+
+// BlockInfo is the brief of a block returned by the dev api.
+type BlockInfo struct {
+	Number int64  `json:"number"`
+	Hash   string `json:"hash"`
+	Time   int64  `json:"time"`
+	TxNum  int    `json:"tx_num"`
+}
+
+// TimeInfo is the time of the chain returned by the dev api.
+type TimeInfo struct {
+	Time   int64 `json:"time"`
+	Offset int64 `json:"offset"`
+}
+
+// SnapshotInfo is the snapshot returned by the dev api.
+type SnapshotInfo struct {
+	ID int `json:"id"`
+}
+
+func newBlockInfo(blk *block.Block) *BlockInfo {
+	return &BlockInfo{
+		Number: blk.Head.Number,
+		Hash:   common.Base58Encode(blk.HeadHash()),
+		Time:   blk.Head.Time,
+		TxNum:  len(blk.Txs),
+	}
+}
+
+func newDevServer(chain *Chain, addr string) *devServer {
+	d := &devServer{chain: chain}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/dev/mine", d.mine)
+	mux.HandleFunc("/dev/time", d.time)
+	mux.HandleFunc("/dev/increaseTime", d.increaseTime)
+	mux.HandleFunc("/dev/snapshot", d.snapshot)
+	mux.HandleFunc("/dev/revert", d.revert)
+	d.srv = &http.Server{Addr: addr, Handler: mux}
+	return d
+}
+
+// Start starts the dev server.
+func (d *devServer) Start() {
+	go func() {
+		if err := d.srv.ListenAndServe(); err != http.ErrServerClosed {
+			ilog.Errorf("Dev server listen failed. err=%v", err)
+		}
+	}()
+}
+
+// Stop stops the dev server.
+func (d *devServer) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := d.srv.Shutdown(ctx); err != nil {
+		ilog.Errorf("Stop dev server failed: %v", err)
+	}
+}
+
+func writeJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(rw).Encode(v)
+}
+
+func (d *devServer) mine(rw http.ResponseWriter, r *http.Request) {
+	n := 1
+	if s := r.URL.Query().Get("blocks"); s != "" {
+		var err error
+		n, err = strconv.Atoi(s)
+		if err != nil || n < 1 {
+			http.Error(rw, "invalid blocks "+s, http.StatusBadRequest)
+			return
+		}
+	}
+	blks, err := d.chain.Mine(n)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(rw, newBlockInfo(blks[len(blks)-1]))
+}
+
+func (d *devServer) time(rw http.ResponseWriter, r *http.Request) {
+	writeJSON(rw, &TimeInfo{
+		Time:   d.chain.Time().UnixNano(),
+		Offset: d.chain.clock.Offset().Nanoseconds(),
+	})
+}
+
+func (d *devServer) increaseTime(rw http.ResponseWriter, r *http.Request) {
+	s := r.URL.Query().Get("seconds")
+	seconds, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		http.Error(rw, "invalid seconds "+s, http.StatusBadRequest)
+		return
+	}
+	if err := d.chain.IncreaseTime(time.Duration(seconds) * time.Second); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	d.time(rw, r)
+}
+
+func (d *devServer) snapshot(rw http.ResponseWriter, r *http.Request) {
+	id, err := d.chain.Snapshot()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(rw, &SnapshotInfo{ID: id})
+}
+
+func (d *devServer) revert(rw http.ResponseWriter, r *http.Request) {
+	s := r.URL.Query().Get("id")
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		http.Error(rw, "invalid id "+s, http.StatusBadRequest)
+		return
+	}
+	if err := d.chain.Revert(id); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeJSON(rw, newBlockInfo(d.chain.Head()))
+}
@@ -1,4 +1,4 @@
-package verifier
+package devchain
 
 import (
 	"encoding/json"
@@ -15,6 +15,7 @@ import (
 	"github.com/iost-official/go-iost/v3/core/version"
 	"github.com/iost-official/go-iost/v3/db"
 	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/verifier"
 	"github.com/iost-official/go-iost/v3/vm"
 	"github.com/iost-official/go-iost/v3/vm/database"
 )
@@ -24,7 +25,7 @@ var txTime = 2 * common.MaxTxTimeLimit
 // Simulator of txs and contract
 type Simulator struct {
 	Visitor  *database.Visitor
-	Verifier *Verifier
+	Verifier *verifier.Verifier
 	Head     *block.BlockHead
 	Logger   *ilog.Logger
 	Mvcc     db.MVCCDB
@@ -37,7 +38,7 @@ func NewSimulator() *Simulator {
 	if err != nil {
 		panic(err)
 	}
-	v := Verifier{}
+	v := verifier.Verifier{}
 
 	s := &Simulator{
 		Visitor:  database.NewVisitor(0, mvccdb, version.NewRules(0)),
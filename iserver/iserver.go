@@ -1,9 +1,12 @@
 package iserver
 
 import (
+	"os"
+
 	"github.com/iost-official/go-iost/v3/chainbase"
 	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/consensus"
+	"github.com/iost-official/go-iost/v3/consensus/snapshot"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/metrics/exporter"
@@ -24,51 +27,93 @@ type IServer struct {
 	p2p       *p2p.NetService
 	rpcServer *rpc.Server
 	consensus consensus.Consensus
+	snapshot  *snapshot.Server
 	debug     *DebugServer
 	exporter  *exporter.Exporter
+
+	p2pStarted bool
 }
 
 // New returns a iserver application
 func New(conf *common.Config) *IServer {
 	tx.ChainID = conf.P2P.ChainID
 
-	cBase, err := chainbase.New(conf)
+	p2pService, err := p2p.NewNetService(conf.P2P)
 	if err != nil {
-		ilog.Fatalf("New chainbase failed: %v.", err)
+		ilog.Fatalf("network initialization failed, stop the program! err:%v", err)
 	}
 
-	exporter := exporter.New()
+	p2pStarted, err := fastSync(conf, p2pService)
+	if err != nil {
+		ilog.Fatalf("Fast sync failed: %v.", err)
+	}
 
-	p2pService, err := p2p.NewNetService(conf.P2P)
+	cBase, err := chainbase.New(conf, nil)
 	if err != nil {
-		ilog.Fatalf("network initialization failed, stop the program! err:%v", err)
+		ilog.Fatalf("New chainbase failed: %v.", err)
 	}
 
+	exporter := exporter.New()
+
 	consensus := consensus.New(consensus.Pob, conf, cBase, p2pService)
 	ilog.Info("consensus init done")
 
 	rpcServer := rpc.New(cBase.TxPool(), cBase, conf, p2pService)
 
+	var snapshotServer *snapshot.Server
+	if conf.Snapshot != nil && conf.Snapshot.Serve {
+		snapshotServer = snapshot.NewServer(cBase.StateDB(), cBase.BlockChain(), p2pService, conf.Snapshot.ServeInterval)
+	}
+
 	debug := NewDebugServer(conf.Debug, p2pService, cBase.BlockCache(), cBase.BlockChain())
 
 	return &IServer{
-		config:    conf,
-		cBase:     cBase,
-		p2p:       p2pService,
-		rpcServer: rpcServer,
-		consensus: consensus,
-		debug:     debug,
-		exporter:  exporter,
+		config:     conf,
+		cBase:      cBase,
+		p2p:        p2pService,
+		rpcServer:  rpcServer,
+		consensus:  consensus,
+		snapshot:   snapshotServer,
+		debug:      debug,
+		exporter:   exporter,
+		p2pStarted: p2pStarted,
+	}
+}
+
+// fastSync imports the state from the snapshot served by the peers if the node has no state yet, and returns whether
+// the p2p service is started for it.
+func fastSync(conf *common.Config, p *p2p.NetService) (bool, error) {
+	if conf.Snapshot == nil || !conf.Snapshot.FastSync {
+		return false, nil
+	}
+	if _, err := os.Stat(conf.DB.LdbPath + "StateDB"); err == nil {
+		return false, nil
+	}
+	if err := p.Start(); err != nil {
+		return false, err
+	}
+	if conf.Snapshot.FastSyncHash == "" {
+		ilog.Warnf("No fast sync snapshot hash given, the snapshot is only trusted by the quorum of peers and its state root")
 	}
+	m, err := snapshot.FastSync(conf, p, conf.Snapshot.FastSyncHash)
+	if err != nil {
+		return true, err
+	}
+	ilog.Infof("Fast synced the state of block %v, syncing the blocks after it.", m.Block.Head.Number)
+	return true, nil
 }
 
 // Start starts iserver application.
 func (s *IServer) Start() error {
-	Services := []Service{
-		s.p2p,
-		s.consensus,
-		s.rpcServer,
+	Services := []Service{}
+	if !s.p2pStarted {
+		Services = append(Services, s.p2p)
+	}
+	// the snapshot server starts before the blocks are flushed by consensus
+	if s.snapshot != nil {
+		Services = append(Services, s.snapshot)
 	}
+	Services = append(Services, s.consensus, s.rpcServer)
 	for _, s := range Services {
 		if err := s.Start(); err != nil {
 			return err
@@ -92,8 +137,11 @@ func (s *IServer) Stop() {
 	Services := []Service{
 		s.rpcServer,
 		s.consensus,
-		s.p2p,
 	}
+	if s.snapshot != nil {
+		Services = append(Services, s.snapshot)
+	}
+	Services = append(Services, s.p2p)
 	for _, s := range Services {
 		s.Stop()
 	}
@@ -0,0 +1,252 @@
+package isigner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/common"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/sdk"
+)
+
+// errors
+var (
+	ErrPolicyDenied = errors.New("denied by policy")
+)
+
+// ActionRule allows an action of a contract. "*" matches any contract or action.
+type ActionRule struct {
+	Contract string `yaml:"contract"`
+	Action   string `yaml:"action"`
+}
+
+// Policy is the rules a transaction must meet to be signed.
+//
+// Actions are denied unless allowed by a rule. When daily caps are set, every amount limit of a
+// transaction must be a finite amount of a capped token, and the amount limits of the transactions
+// signed in a UTC day are summed up against the caps, since the chain never lets a transaction
+// spend more than its amount limits.
+type Policy struct {
+	// ChainID of the transactions, 0 for any chain.
+	ChainID uint32 `yaml:"chain_id"`
+	// Keys allowed to sign with, all keys of the signer if empty.
+	Keys []string `yaml:"keys"`
+	// AllowPublisherSign allows signing as the publisher.
+	AllowPublisherSign bool `yaml:"allow_publisher_sign"`
+	// Actions allowed.
+	Actions []*ActionRule `yaml:"actions"`
+	// MaxGasLimit of the transactions, 0 for no limit.
+	MaxGasLimit float64 `yaml:"max_gas_limit"`
+	// DailyCaps is token->amount signed at most per day.
+	DailyCaps map[string]string `yaml:"daily_caps"`
+	// UsageFile keeps the amounts signed today across restarts.
+	UsageFile string `yaml:"usage_file"`
+
+	caps  map[string]*big.Rat
+	mu    sync.Mutex
+	usage *usage
+}
+
+type usage struct {
+	Day    string            `json:"day"`
+	Spent  map[string]string `json:"spent"`
+	Hashes []string          `json:"hashes"`
+
+	spent  map[string]*big.Rat
+	hashes map[string]bool
+}
+
+func newUsage(day string) *usage {
+	return &usage{
+		Day:    day,
+		spent:  make(map[string]*big.Rat),
+		hashes: make(map[string]bool),
+	}
+}
+
+// LoadPolicy loads the policy from a yaml file.
+func LoadPolicy(fileName string) (*Policy, error) {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	p := &Policy{}
+	err = yaml.UnmarshalStrict(b, p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy file %v: %v", fileName, err)
+	}
+	err = p.init()
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Policy) init() error {
+	p.caps = make(map[string]*big.Rat)
+	for token, v := range p.DailyCaps {
+		r, ok := new(big.Rat).SetString(v)
+		if !ok || r.Sign() < 0 {
+			return fmt.Errorf("invalid daily cap %v of token %v", v, token)
+		}
+		p.caps[token] = r
+	}
+	p.usage = newUsage(today())
+	if p.UsageFile == "" {
+		return nil
+	}
+	b, err := os.ReadFile(p.UsageFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	u := &usage{}
+	err = json.Unmarshal(b, u)
+	if err != nil {
+		return fmt.Errorf("invalid usage file %v: %v", p.UsageFile, err)
+	}
+	if u.Day != p.usage.Day {
+		return nil
+	}
+	for token, v := range u.Spent {
+		r, ok := new(big.Rat).SetString(v)
+		if !ok {
+			return fmt.Errorf("invalid usage file %v: amount %v", p.UsageFile, v)
+		}
+		p.usage.spent[token] = r
+	}
+	for _, h := range u.Hashes {
+		p.usage.hashes[h] = true
+	}
+	return nil
+}
+
+func today() string {
+	return time.Now().UTC().Format("2006-01-02")
+}
+
+// AllowKey returns whether the key can sign.
+func (p *Policy) AllowKey(pubkey string) bool {
+	if len(p.Keys) == 0 {
+		return true
+	}
+	for _, k := range p.Keys {
+		if k == pubkey {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) allowAction(a *rpcpb.Action) bool {
+	for _, r := range p.Actions {
+		if (r.Contract == "*" || r.Contract == a.Contract) && (r.Action == "*" || r.Action == a.ActionName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) amounts(t *rpcpb.TransactionRequest) (map[string]*big.Rat, error) {
+	amounts := make(map[string]*big.Rat)
+	if len(p.caps) == 0 {
+		return amounts, nil
+	}
+	for _, l := range t.AmountLimit {
+		if _, ok := p.caps[l.Token]; !ok {
+			return nil, fmt.Errorf("%w: token %v is not capped", ErrPolicyDenied, l.Token)
+		}
+		r, ok := new(big.Rat).SetString(l.Value)
+		if !ok || r.Sign() < 0 {
+			return nil, fmt.Errorf("%w: amount limit %v of token %v is not a finite amount", ErrPolicyDenied, l.Value, l.Token)
+		}
+		if a, ok := amounts[l.Token]; ok {
+			r.Add(r, a)
+		}
+		amounts[l.Token] = r
+	}
+	return amounts, nil
+}
+
+// Check checks the transaction against the policy, and records its amount limits against
+// the daily caps if it can be signed. A transaction is only recorded once a day, however many
+// times it is signed.
+func (p *Policy) Check(t *rpcpb.TransactionRequest, pubkey []byte, withSign bool) error {
+	if !p.AllowKey(account.EncodePubkey(pubkey)) {
+		return fmt.Errorf("%w: key %v", ErrPolicyDenied, account.EncodePubkey(pubkey))
+	}
+	if withSign && !p.AllowPublisherSign {
+		return fmt.Errorf("%w: publisher sign", ErrPolicyDenied)
+	}
+	if p.ChainID != 0 && t.ChainId != p.ChainID {
+		return fmt.Errorf("%w: chain id %v", ErrPolicyDenied, t.ChainId)
+	}
+	if p.MaxGasLimit > 0 && t.GasLimit > p.MaxGasLimit {
+		return fmt.Errorf("%w: gas limit %v exceeds %v", ErrPolicyDenied, t.GasLimit, p.MaxGasLimit)
+	}
+	for _, a := range t.Actions {
+		if !p.allowAction(a) {
+			return fmt.Errorf("%w: action %v/%v", ErrPolicyDenied, a.Contract, a.ActionName)
+		}
+	}
+	amounts, err := p.amounts(t)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if day := today(); day != p.usage.Day {
+		p.usage = newUsage(day)
+	}
+	hash := common.Base58Encode(sdk.SignHashOfTx(t, false))
+	if p.usage.hashes[hash] {
+		return nil
+	}
+	spent := make(map[string]*big.Rat)
+	for token, a := range amounts {
+		s := new(big.Rat).Set(a)
+		if u, ok := p.usage.spent[token]; ok {
+			s.Add(s, u)
+		}
+		if s.Cmp(p.caps[token]) > 0 {
+			return fmt.Errorf("%w: daily cap %v of token %v exceeded", ErrPolicyDenied, p.caps[token].FloatString(8), token)
+		}
+		spent[token] = s
+	}
+	for token, s := range spent {
+		p.usage.spent[token] = s
+	}
+	p.usage.hashes[hash] = true
+	return p.saveUsage()
+}
+
+func (p *Policy) saveUsage() error {
+	if p.UsageFile == "" {
+		return nil
+	}
+	u := p.usage
+	u.Spent = make(map[string]string)
+	for token, s := range u.spent {
+		u.Spent[token] = s.RatString()
+	}
+	u.Hashes = make([]string, 0, len(u.hashes))
+	for h := range u.hashes {
+		u.Hashes = append(u.Hashes, h)
+	}
+	b, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p.UsageFile, b, 0600)
+}
@@ -0,0 +1,130 @@
+// Package isigner is a standalone signer holding the keys of hot wallets out of the processes
+// talking to the network. It signs transactions meeting its policy over the http protocol of
+// sdk.RemoteSigner.
+package isigner
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/sdk"
+	jsonpb "google.golang.org/protobuf/encoding/protojson"
+)
+
+const maxRequestSize = 1 << 20
+
+// Server signs transactions with its keys.
+type Server struct {
+	keys   map[string]*account.KeyPair
+	policy *Policy
+	token  string
+}
+
+// NewServer returns a server signing with the keys allowed by the policy. Requests must carry
+// the token as a bearer token if it is not empty.
+func NewServer(keys []*account.KeyPair, policy *Policy, token string) *Server {
+	s := &Server{
+		keys:   make(map[string]*account.KeyPair),
+		policy: policy,
+		token:  token,
+	}
+	for _, kp := range keys {
+		if policy.AllowKey(kp.ReadablePubkey()) {
+			s.keys[kp.ReadablePubkey()] = kp
+		}
+	}
+	return s
+}
+
+// Handler returns the http handler of the server.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(sdk.SignerKeysPath, s.auth(s.handleKeys))
+	mux.HandleFunc(sdk.SignerSignPath, s.auth(s.handleSign))
+	return mux
+}
+
+func (s *Server) auth(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+s.token)) != 1 {
+			writeError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+			return
+		}
+		h(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		ilog.Warnf("Failed to write response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, &sdk.SignerErrorResponse{Error: err.Error()})
+}
+
+func (s *Server) handleKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %v not allowed", r.Method))
+		return
+	}
+	res := &sdk.SignerKeysResponse{Keys: make([]*sdk.SignerKey, 0, len(s.keys))}
+	for pubkey, kp := range s.keys {
+		res.Keys = append(res.Keys, &sdk.SignerKey{PublicKey: pubkey, Algorithm: kp.Algorithm.String()})
+	}
+	sort.Slice(res.Keys, func(i, j int) bool {
+		return res.Keys[i].PublicKey < res.Keys[j].PublicKey
+	})
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) handleSign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %v not allowed", r.Method))
+		return
+	}
+	b, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	req := &sdk.SignerSignRequest{}
+	err = json.Unmarshal(b, req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	t, err := req.ParseTx()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	kp, ok := s.keys[req.PublicKey]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown key %v", req.PublicKey))
+		return
+	}
+	err = s.policy.Check(t, kp.Pubkey, req.WithSign)
+	if err != nil {
+		ilog.Warnf("Refused to sign tx of publisher %v with key %v: %v", t.Publisher, req.PublicKey, err)
+		writeError(w, http.StatusForbidden, err)
+		return
+	}
+	sig, err := jsonpb.Marshal(sdk.GetSignatureOfTx(t, kp, req.WithSign))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	ilog.Infof("Signed tx of publisher %v with key %v, publisher sign: %v", t.Publisher, req.PublicKey, req.WithSign)
+	writeJSON(w, http.StatusOK, &sdk.SignerSignResponse{Signature: sig})
+}
@@ -0,0 +1,125 @@
+package isigner
+
+import (
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/crypto"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/sdk"
+)
+
+func newTx(time int64, value string, actions ...*rpcpb.Action) *rpcpb.TransactionRequest {
+	return &rpcpb.TransactionRequest{
+		Time:        time,
+		GasLimit:    100000,
+		ChainId:     1024,
+		Actions:     actions,
+		Publisher:   "hot",
+		AmountLimit: []*rpcpb.AmountLimit{{Token: "iost", Value: value}},
+	}
+}
+
+func transfer() *rpcpb.Action {
+	return sdk.NewAction("token.iost", "transfer", `["iost","hot","user","10",""]`)
+}
+
+func TestServer(t *testing.T) {
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	p := &Policy{
+		ChainID:            1024,
+		AllowPublisherSign: true,
+		Actions:            []*ActionRule{{Contract: "token.iost", Action: "transfer"}},
+		DailyCaps:          map[string]string{"iost": "100"},
+		UsageFile:          filepath.Join(t.TempDir(), "usage.json"),
+	}
+	assert.Nil(t, p.init())
+	ts := httptest.NewServer(NewServer([]*account.KeyPair{kp}, p, "secret").Handler())
+	defer ts.Close()
+
+	signer := sdk.NewRemoteSigner(ts.URL, kp.ReadablePubkey(), "secret")
+	keys, err := signer.Keys()
+	assert.Nil(t, err)
+	assert.Equal(t, []*sdk.SignerKey{{PublicKey: kp.ReadablePubkey(), Algorithm: "ed25519"}}, keys)
+
+	tx := newTx(1, "60", transfer())
+	sig, err := signer.SignTx(tx, false)
+	assert.Nil(t, err)
+	assert.True(t, sdk.VerifySigForTx(tx, sig, false))
+	tx.Signatures = append(tx.Signatures, sig)
+	// signing the same tx again is not counted against the cap
+	sig, err = signer.SignTx(tx, true)
+	assert.Nil(t, err)
+	assert.True(t, sdk.VerifySigForTx(tx, sig, true))
+
+	_, err = signer.SignTx(newTx(2, "60", transfer()), true)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "daily cap")
+	_, err = signer.SignTx(newTx(3, "40", transfer()), true)
+	assert.Nil(t, err)
+
+	_, err = signer.SignTx(newTx(4, "0", sdk.NewAction("auth.iost", "assignPermission", `[]`)), true)
+	assert.Contains(t, err.Error(), "action auth.iost/assignPermission")
+
+	_, err = sdk.NewRemoteSigner(ts.URL, kp.ReadablePubkey(), "wrong").SignTx(newTx(5, "0", transfer()), true)
+	assert.Contains(t, err.Error(), "unauthorized")
+
+	// usage is kept across restarts
+	p2 := &Policy{DailyCaps: map[string]string{"iost": "100"}, UsageFile: p.UsageFile, Actions: p.Actions, AllowPublisherSign: true}
+	assert.Nil(t, p2.init())
+	err = p2.Check(newTx(6, "1", transfer()), kp.Pubkey, true)
+	assert.True(t, errors.Is(err, ErrPolicyDenied))
+}
+
+func TestPolicy_Check(t *testing.T) {
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	p := &Policy{
+		Keys:        []string{kp.ReadablePubkey()},
+		Actions:     []*ActionRule{{Contract: "*", Action: "transfer"}},
+		MaxGasLimit: 100000,
+		DailyCaps:   map[string]string{"iost": "10.5"},
+	}
+	assert.Nil(t, p.init())
+
+	cases := []struct {
+		tx       *rpcpb.TransactionRequest
+		withSign bool
+		allowed  bool
+	}{
+		{newTx(1, "10.5", transfer()), false, true},
+		{newTx(2, "10.5", transfer()), true, false},
+		{newTx(3, "unlimited", transfer()), false, false},
+		{&rpcpb.TransactionRequest{Time: 4, Actions: []*rpcpb.Action{transfer()}, AmountLimit: []*rpcpb.AmountLimit{{Token: "*", Value: "1"}}}, false, false},
+		{&rpcpb.TransactionRequest{Time: 5, GasLimit: 200000, Actions: []*rpcpb.Action{transfer()}}, false, false},
+		{&rpcpb.TransactionRequest{Time: 6, Actions: []*rpcpb.Action{sdk.NewAction("Contractxxx", "transfer", "[]")}}, false, true},
+		{&rpcpb.TransactionRequest{Time: 7, Actions: []*rpcpb.Action{sdk.NewAction("token.iost", "issue", "[]")}}, false, false},
+	}
+	for i, c := range cases {
+		err := p.Check(c.tx, kp.Pubkey, c.withSign)
+		assert.Equal(t, c.allowed, err == nil, "case %v: %v", i, err)
+	}
+
+	other, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	assert.NotNil(t, p.Check(newTx(8, "0", transfer()), other.Pubkey, false))
+}
+
+func TestLoadPolicy(t *testing.T) {
+	p, err := LoadPolicy("../config/isigner.yml")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1024), p.ChainID)
+	assert.Equal(t, "10000", p.caps["iost"].FloatString(0))
+
+	f := filepath.Join(t.TempDir(), "policy.yml")
+	assert.Nil(t, os.WriteFile(f, []byte("unknown_field: 1\n"), 0600))
+	_, err = LoadPolicy(f)
+	assert.NotNil(t, err)
+}
@@ -18,6 +18,10 @@ var (
 	initialRAM       int64
 	initialBalance   int64
 	initialGasPledge int64
+
+	// Used for hd keys.
+	fromMnemonic bool
+	hdAccount    uint32
 )
 
 type acc struct {
@@ -73,10 +77,21 @@ var updateCmd = &cobra.Command{
 }
 
 func getAccountInfoFromArgs(name string) (*AccountInfo, error) {
+	if fromMnemonic {
+		seed, err := readSeedFromStdin()
+		if err != nil {
+			return nil, err
+		}
+		return NewAccountInfoFromSeed(name, seed, hdAccount, signAlgo)
+	}
 	accInfo := NewAccountInfo()
 	accInfo.Name = name
 	if ownerKey == "" && activeKey == "" {
-		newKp, err := account.NewKeyPair(nil, sdk.GetSignAlgoByName(signAlgo))
+		algo, err := sdk.GetSignAlgoByName(signAlgo)
+		if err != nil {
+			return nil, err
+		}
+		newKp, err := account.NewKeyPair(nil, algo)
 		if err != nil {
 			err = fmt.Errorf("failed to create key pair: %v", err)
 			return nil, err
@@ -144,7 +159,8 @@ var createCmd = &cobra.Command{
 	Long:  `Create an account on blockchain`,
 	Example: `  iwallet account create test1 --account test0
   iwallet account create test2 --account test0 --initial_balance 0 --initial_gas_pledge 10 --initial_ram 0
-  iwallet account create test3 --account test0 --owner 7Z9US64vfcyopQpyEwV1FF52HTB8maEacjU4SYeAUrt1 --active 7Z9US64vfcyopQpyEwV1FF52HTB8maEacjU4SYeAUrt1`,
+  iwallet account create test3 --account test0 --owner 7Z9US64vfcyopQpyEwV1FF52HTB8maEacjU4SYeAUrt1 --active 7Z9US64vfcyopQpyEwV1FF52HTB8maEacjU4SYeAUrt1
+  iwallet account create test4 --account test0 --from_mnemonic --hd_account 4`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if err := checkArgsNumber(cmd, args, "accountName"); err != nil {
 			return err
@@ -196,6 +212,7 @@ var viewCmd = &cobra.Command{
 			var k key
 			k.Algorithm = ac.Keypairs["active"].KeyType
 			k.Pubkey = ac.Keypairs["active"].PubKey
+			k.HDPath = ac.Keypairs["active"].HDPath
 			if ac.IsEncrypted() {
 				k.Seckey = "---encrypted secret key---"
 			} else {
@@ -232,10 +249,15 @@ var encrypt bool
 var importCmd = &cobra.Command{
 	Use:   "import accountName accountPrivateKey",
 	Short: "Import an account by name and private key",
-	Long:  `Import an account by name and private key`,
+	Long: `Import an account by name and private key
+With flag --from_mnemonic, the keys are derived from the mnemonic read from stdin instead, see 'iwallet key mnemonic'`,
 	Example: `  iwallet account import test0 XXXXXXXXXXXXXXXXXXXXX
-  iwallet account import test0 active:XXXXXXXXXXXXXXXXXXXXX,owner:YYYYYYYYYYYYYYYYYYYYYYYY`,
+  iwallet account import test0 active:XXXXXXXXXXXXXXXXXXXXX,owner:YYYYYYYYYYYYYYYYYYYYYYYY
+  iwallet account import test0 --from_mnemonic --hd_account 1`,
 	Args: func(cmd *cobra.Command, args []string) error {
+		if fromMnemonic {
+			return checkArgsNumber(cmd, args, "accountName")
+		}
 		if err := checkArgsNumber(cmd, args, "accountName", "accountPrivateKey"); err != nil {
 			return err
 		}
@@ -243,6 +265,22 @@ var importCmd = &cobra.Command{
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		name := args[0]
+		if fromMnemonic {
+			seed, err := readSeedFromStdin()
+			if err != nil {
+				return err
+			}
+			acc, err := NewAccountInfoFromSeed(name, seed, hdAccount, signAlgo)
+			if err != nil {
+				return err
+			}
+			err = saveAccount(acc, encrypt)
+			if err != nil {
+				return fmt.Errorf("failed to save account: %v", err)
+			}
+			fmt.Printf("import account %v done\n", name)
+			return nil
+		}
 		acc := AccountInfo{Name: name, Keypairs: make(map[string]*KeyPairInfo)}
 		keys := strings.Split(args[1], ",")
 		if len(keys) == 1 {
@@ -302,6 +340,30 @@ var dumpKeyCmd = &cobra.Command{
 	},
 }
 
+var historyLimit int32
+var historyCursor string
+var historyCmd = &cobra.Command{
+	Use:   "history accountName",
+	Short: "List transactions of an account",
+	Long:  `List transactions published, signed or received by an account, newest first. The node must enable the tx index`,
+	Example: `  iwallet account history test0
+  iwallet account history test0 --limit 20 --cursor 2pzDR9hjE8HTnRWGbrL9vLTeLxvmvU8vb`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "accountName"); err != nil {
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		res, err := iwalletSDK.GetAccountTransactions(args[0], historyLimit, historyCursor)
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(res))
+		return nil
+	},
+}
+
 var deleteCmd = &cobra.Command{
 	Use:     "delete accountName",
 	Aliases: []string{"del"},
@@ -338,8 +400,15 @@ func init() {
 	createCmd.Flags().Int64VarP(&initialBalance, "initial_balance", "", 0, "transfer $initial_balance IOSTs to the new account")
 
 	accountCmd.AddCommand(importCmd)
+	for _, c := range []*cobra.Command{createCmd, importCmd, updateCmd} {
+		c.Flags().BoolVarP(&fromMnemonic, "from_mnemonic", "", false, "derive the owner and active keys from the mnemonic read from stdin")
+		c.Flags().Uint32VarP(&hdAccount, "hd_account", "", 0, "account index in the hd derivation path, used with --from_mnemonic")
+	}
 	accountCmd.AddCommand(viewCmd)
 	accountCmd.AddCommand(deleteCmd)
 	accountCmd.AddCommand(dumpKeyCmd)
 	accountCmd.AddCommand(updateCmd)
+	accountCmd.AddCommand(historyCmd)
+	historyCmd.Flags().Int32VarP(&historyLimit, "limit", "", 20, "max number of transactions to list, at most 100")
+	historyCmd.Flags().StringVarP(&historyCursor, "cursor", "", "", "cursor returned by the previous query to list older transactions")
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var traceTx bool
+
 // callCmd represents the call command that call a contract with given actions.
 var callCmd = &cobra.Command{
 	Use:   "call [ACTION]...",
@@ -17,7 +19,8 @@ var callCmd = &cobra.Command{
 	An ACTION is a group of 3 arguments: contract name, function name, method parameters.
 	The method parameters should be a string with format '["arg0","arg1",...]'.`,
 	Example: `  iwallet call "token.iost" "transfer" '["iost","user0001","user0002","123.45",""]' --account test0
-  iwallet call "token.iost" "transfer" '["iost","user0001","user0002","123.45",""]' --output tx.json`,
+  iwallet call "token.iost" "transfer" '["iost","user0001","user0002","123.45",""]' --output tx.json
+  iwallet call "ContractXXX" "swap" '["iost","100"]' --account test0 --trace`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if outputTxFile == "" {
 			return checkAccount(cmd)
@@ -49,4 +52,5 @@ var callCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(callCmd)
+	callCmd.Flags().BoolVarP(&traceTx, "trace", "", false, "Executes the call without creating a transaction on the block chain, and prints the contract calls, storage accesses and logs of the execution")
 }
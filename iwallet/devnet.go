@@ -0,0 +1,178 @@
+package iwallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var devServer string
+
+var devnetCmd = &cobra.Command{
+	Use:   "devnet",
+	Short: "Run and control a local development chain",
+	Long: `Run a local development chain booted from the genesis contracts, which mines blocks on demand, travels in time
+and snapshots its state, and control it by the dev api. The chain serves the normal rpc with the chain id of flag --chain_id,
+so other commands work against it as they do against a node. The clock of the devnet runs ahead of the local clock after
+traveling in time, stamp transactions with flag --tx_time_from_server then`,
+	Example: `  iwallet devnet start
+  iwallet devnet mine 1200
+  iwallet devnet increase-time 604800`,
+}
+
+var devnetMineCmd = &cobra.Command{
+	Use:     "mine [blocks]",
+	Short:   "Mine blocks",
+	Long:    `Mine blocks with the pending transactions, one block by default`,
+	Example: `  iwallet devnet mine 10`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			return checkInt(cmd, args[0], "blocks")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		params := neturl.Values{}
+		if len(args) > 0 {
+			params.Set("blocks", args[0])
+		}
+		var blk devBlockInfo
+		if err := callDevAPI("mine", params, &blk); err != nil {
+			return err
+		}
+		fmt.Println("Mined to", blk)
+		return nil
+	},
+}
+
+var devnetTimeCmd = &cobra.Command{
+	Use:   "time",
+	Short: "Print the time of the chain",
+	Long:  `Print the time of the chain and how far it is from the local time`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var t devTimeInfo
+		if err := callDevAPI("time", nil, &t); err != nil {
+			return err
+		}
+		fmt.Println(t)
+		return nil
+	},
+}
+
+var devnetIncreaseTimeCmd = &cobra.Command{
+	Use:   "increase-time seconds",
+	Short: "Move the clock of the chain forward",
+	Long: `Move the clock of the chain forward, the next block is mined at the new time.
+Contracts reading the block time, such as the weekly bonus of producers, see the time passed`,
+	Example: `  iwallet devnet increase-time 86400`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "seconds"); err != nil {
+			return err
+		}
+		return checkInt(cmd, args[0], "seconds")
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var t devTimeInfo
+		if err := callDevAPI("increaseTime", neturl.Values{"seconds": {args[0]}}, &t); err != nil {
+			return err
+		}
+		fmt.Println(t)
+		return nil
+	},
+}
+
+var devnetSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Snapshot the chain",
+	Long:  `Snapshot the state and the time of the chain, and print the id to revert to it`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var s struct {
+			ID int `json:"id"`
+		}
+		if err := callDevAPI("snapshot", nil, &s); err != nil {
+			return err
+		}
+		fmt.Println(s.ID)
+		return nil
+	},
+}
+
+var devnetRevertCmd = &cobra.Command{
+	Use:   "revert id",
+	Short: "Revert the chain to a snapshot",
+	Long: `Revert the chain to a snapshot, dropping the pending transactions. The snapshot and the ones taken after it
+can not be reverted to again`,
+	Example: `  iwallet devnet revert 1`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "id"); err != nil {
+			return err
+		}
+		return checkInt(cmd, args[0], "id")
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var blk devBlockInfo
+		if err := callDevAPI("revert", neturl.Values{"id": {args[0]}}, &blk); err != nil {
+			return err
+		}
+		fmt.Println("Reverted to", blk)
+		return nil
+	},
+}
+
+type devBlockInfo struct {
+	Number int64  `json:"number"`
+	Hash   string `json:"hash"`
+	Time   int64  `json:"time"`
+	TxNum  int    `json:"tx_num"`
+}
+
+func (b devBlockInfo) String() string {
+	return fmt.Sprintf("block %v %v at %v with %v txs", b.Number, b.Hash, time.Unix(0, b.Time).Format(time.RFC3339), b.TxNum)
+}
+
+type devTimeInfo struct {
+	Time   int64 `json:"time"`
+	Offset int64 `json:"offset"`
+}
+
+func (t devTimeInfo) String() string {
+	return fmt.Sprintf("%v, %v ahead of the local time", time.Unix(0, t.Time).Format(time.RFC3339), time.Duration(t.Offset))
+}
+
+func callDevAPI(method string, params neturl.Values, v interface{}) error {
+	server := devServer
+	if !strings.Contains(server, "://") {
+		server = "http://" + server
+	}
+	u := server + "/dev/" + method
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%v failed: %v", method, strings.TrimSpace(string(b)))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func init() {
+	rootCmd.AddCommand(devnetCmd)
+	devnetCmd.AddCommand(devnetMineCmd)
+	devnetCmd.AddCommand(devnetTimeCmd)
+	devnetCmd.AddCommand(devnetIncreaseTimeCmd)
+	devnetCmd.AddCommand(devnetSnapshotCmd)
+	devnetCmd.AddCommand(devnetRevertCmd)
+
+	devnetCmd.PersistentFlags().StringVarP(&devServer, "dev_server", "", "localhost:30004", "address of the dev api of the devnet")
+}
@@ -0,0 +1,24 @@
+//go:build !devnet
+// +build !devnet
+
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var devnetStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start a devnet",
+	Long: `Start a devnet. Running the chain needs the javascript vm, which iwallet is not built with by default.
+Build iwallet with "make iwallet_devnet" to start a devnet`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return fmt.Errorf(`iwallet is built without the devnet, build it with "make iwallet_devnet"`)
+	},
+}
+
+func init() {
+	devnetCmd.AddCommand(devnetStartCmd)
+}
@@ -0,0 +1,85 @@
+//go:build devnet
+// +build devnet
+
+package iwallet
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/crypto"
+	"github.com/iost-official/go-iost/v3/devchain"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/spf13/cobra"
+)
+
+var devnetGenesis string
+var devnetWitnessKeys []string
+var devnetGRPCAddr string
+var devnetGatewayAddr string
+var devnetAutoMine bool
+var devnetBlockTime time.Duration
+var devnetLogLevel string
+
+var devnetStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start a devnet",
+	Long: `Start a devnet booted from the genesis contracts in memory, which is dropped on exit.
+The chain id is the one of flag --chain_id. Blocks are mined as soon as transactions are pending unless flag --automine=false is set`,
+	Example: `  iwallet devnet start
+  iwallet devnet start --genesis config/genesis --automine=false --block_time 3s`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ilog.SetLevel(ilog.NewLevel(devnetLogLevel))
+
+		conf := devchain.DefaultConfig()
+		conf.Genesis = devnetGenesis
+		conf.ChainID = chainID
+		conf.RPC.GRPCAddr = devnetGRPCAddr
+		conf.RPC.GatewayAddr = devnetGatewayAddr
+		conf.DevAddr = devServer
+		conf.AutoMine = devnetAutoMine
+		conf.BlockTime = devnetBlockTime
+		conf.ContractLog = true
+		conf.Witnesses = nil
+		for _, k := range devnetWitnessKeys {
+			kp, err := account.NewKeyPair(common.Base58Decode(k), crypto.Ed25519)
+			if err != nil {
+				return fmt.Errorf("invalid witness key: %v", err)
+			}
+			conf.Witnesses = append(conf.Witnesses, kp)
+		}
+
+		chain, err := devchain.New(conf)
+		if err != nil {
+			return err
+		}
+		defer chain.Close()
+		if err := chain.Start(); err != nil {
+			return err
+		}
+		fmt.Printf("Devnet of chain id %v started, grpc: %v, gateway: %v, dev api: %v\n",
+			conf.ChainID, conf.RPC.GRPCAddr, conf.RPC.GatewayAddr, conf.DevAddr)
+
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+		<-c
+		return nil
+	},
+}
+
+func init() {
+	devnetCmd.AddCommand(devnetStartCmd)
+
+	devnetStartCmd.Flags().StringVarP(&devnetGenesis, "genesis", "", "config/genesis", "directory of genesis.yml and the genesis contracts")
+	devnetStartCmd.Flags().StringSliceVarP(&devnetWitnessKeys, "witness_keys", "", []string{devchain.DefaultWitnessKey}, "ed25519 private keys of the witnesses to produce blocks, split by comma")
+	devnetStartCmd.Flags().StringVarP(&devnetGRPCAddr, "grpc_addr", "", "0.0.0.0:30002", "address to serve the grpc")
+	devnetStartCmd.Flags().StringVarP(&devnetGatewayAddr, "gateway_addr", "", "0.0.0.0:30001", "address to serve the http gateway")
+	devnetStartCmd.Flags().BoolVarP(&devnetAutoMine, "automine", "", true, "mine a block as soon as transactions are pending")
+	devnetStartCmd.Flags().DurationVarP(&devnetBlockTime, "block_time", "", 0, "mine blocks periodically at this interval if not zero")
+	devnetStartCmd.Flags().StringVarP(&devnetLogLevel, "log_level", "", "info", "log level: debug, info, warn, error or fatal")
+}
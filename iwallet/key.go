@@ -6,6 +6,7 @@ import (
 
 	"github.com/iost-official/go-iost/v3/account"
 	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/crypto/hd"
 	"github.com/iost-official/go-iost/v3/sdk"
 	"github.com/spf13/cobra"
 )
@@ -14,24 +15,55 @@ type key struct {
 	Algorithm string
 	Pubkey    string
 	Seckey    string
+	HDPath    string `json:",omitempty"`
 }
 
+var hdIndex uint32
+var hdPath string
+var mnemonicBits int
+
 // keyCmd represents the keyPair command
 var keyCmd = &cobra.Command{
-	Use:     "key",
-	Short:   "Create a key pair",
-	Long:    `Create a key pair`,
-	Example: `  iwallet key`,
+	Use:   "key",
+	Short: "Create a key pair",
+	Long: `Create a key pair
+With flag --from_mnemonic, the key is derived from the mnemonic read from stdin at path m/44'/291'/hd_account'/0'/hd_index',
+where 291 is the coin type of IOST. Only ed25519 and secp256k1 keys can be derived.`,
+	Example: `  iwallet key
+  iwallet key --from_mnemonic --hd_account 0 --hd_index 5
+  iwallet key --from_mnemonic --hd_path "m/44'/291'/0'/0'/5'" --sign_algo secp256k1`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		n, err := account.NewKeyPair(nil, sdk.GetSignAlgoByName(signAlgo))
-		if err != nil {
-			return fmt.Errorf("failed to new key pair: %v", err)
-		}
-
 		var k key
-		k.Algorithm = n.Algorithm.String()
-		k.Pubkey = common.Base58Encode(n.Pubkey)
-		k.Seckey = common.Base58Encode(n.Seckey)
+		if fromMnemonic {
+			seed, err := readSeedFromStdin()
+			if err != nil {
+				return err
+			}
+			path := hdPath
+			if path == "" {
+				path = hd.Path(hdAccount, hdIndex)
+			}
+			kp, err := sdk.NewKeyPairInfoFromSeed(seed, path, signAlgo)
+			if err != nil {
+				return fmt.Errorf("failed to derive key pair: %v", err)
+			}
+			k.Algorithm = kp.KeyType
+			k.Pubkey = kp.PubKey
+			k.Seckey = kp.RawKey
+			k.HDPath = kp.HDPath
+		} else {
+			algo, err := sdk.GetSignAlgoByName(signAlgo)
+			if err != nil {
+				return err
+			}
+			n, err := account.NewKeyPair(nil, algo)
+			if err != nil {
+				return fmt.Errorf("failed to new key pair: %v", err)
+			}
+			k.Algorithm = n.Algorithm.String()
+			k.Pubkey = common.Base58Encode(n.Pubkey)
+			k.Seckey = common.Base58Encode(n.Seckey)
+		}
 
 		ret, err := json.MarshalIndent(k, "", "    ")
 		if err != nil {
@@ -42,6 +74,30 @@ var keyCmd = &cobra.Command{
 	},
 }
 
+var mnemonicCmd = &cobra.Command{
+	Use:   "mnemonic",
+	Short: "Create a mnemonic",
+	Long: `Create a BIP-39 mnemonic, from which the keys of accounts can be derived with flag --from_mnemonic
+Keep the mnemonic safe, anyone knowing it can regenerate every key derived from it.`,
+	Example: `  iwallet key mnemonic
+  iwallet key mnemonic --bits 128`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		m, err := hd.NewMnemonic(mnemonicBits)
+		if err != nil {
+			return fmt.Errorf("failed to new mnemonic: %v", err)
+		}
+		fmt.Println(m)
+		return nil
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(keyCmd)
+	keyCmd.Flags().BoolVarP(&fromMnemonic, "from_mnemonic", "", false, "derive the key from the mnemonic read from stdin")
+	keyCmd.Flags().Uint32VarP(&hdAccount, "hd_account", "", 0, "account index in the hd derivation path")
+	keyCmd.Flags().Uint32VarP(&hdIndex, "hd_index", "", 0, "key index in the hd derivation path")
+	keyCmd.Flags().StringVarP(&hdPath, "hd_path", "", "", "hd derivation path, overrides --hd_account and --hd_index")
+
+	keyCmd.AddCommand(mnemonicCmd)
+	mnemonicCmd.Flags().IntVarP(&mnemonicBits, "bits", "", 256, "entropy bits of the mnemonic, 128 for 12 words and 256 for 24 words")
 }
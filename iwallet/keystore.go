@@ -7,7 +7,9 @@ import (
 	"io"
 	"os"
 	"path"
+	"strings"
 
+	"github.com/iost-official/go-iost/v3/crypto/hd"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/sdk"
 	. "github.com/iost-official/go-iost/v3/sdk"
@@ -76,20 +78,6 @@ func ensureDecryptAccount(a *AccountInfo) error {
 	return a.Decrypt(password)
 }
 
-func loadAccountFrom(fileName string, ensureDecrypt bool) (*AccountInfo, error) {
-	a, err := LoadAccountFrom(fileName)
-	if err != nil {
-		return nil, err
-	}
-	if ensureDecrypt {
-		err = ensureDecryptAccount(a)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return a, nil
-}
-
 func loadAccount(ensureDecrypt bool) (*AccountInfo, error) {
 	var acc *AccountInfo
 	var err error
@@ -119,17 +107,81 @@ func loadAccount(ensureDecrypt bool) (*AccountInfo, error) {
 	return acc, err
 }
 
-func initAccountForSDK(s *sdk.IOSTDevSDK) error {
-	a, err := loadAccount(true)
+// newAccountSigners returns the signers of the keys of an account. Encrypted keys are only decrypted while signing.
+func newAccountSigners(a *AccountInfo) (map[string]sdk.Signer, error) {
+	var password []byte
+	passwordRead := false
+	signers := make(map[string]sdk.Signer)
+	for p, kp := range a.Keypairs {
+		if kp.EncryptedKey == "" {
+			keyPair, err := kp.ToKeyPair()
+			if err != nil {
+				return nil, err
+			}
+			signers[p] = sdk.NewKeyPairSigner(keyPair)
+			continue
+		}
+		if !passwordRead {
+			var err error
+			password, err = readPasswordFromStdin(false)
+			if err != nil {
+				return nil, err
+			}
+			passwordRead = true
+		}
+		signer, err := sdk.NewKeystoreSigner(kp, password)
+		if err != nil {
+			return nil, err
+		}
+		signers[p] = signer
+	}
+	return signers, nil
+}
+
+// loadSigner returns the signer of signPerm from an account json file or private key file,
+// or the external signer if keyFile is a signer uri.
+func loadSigner(keyFile string) (sdk.Signer, error) {
+	if strings.Contains(keyFile, "://") {
+		return sdk.OpenSigner(keyFile)
+	}
+	a, err := LoadAccountFrom(keyFile)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	kp, ok := a.Keypairs[signPerm]
+	if !ok {
+		return nil, fmt.Errorf("invalid permission %v", signPerm)
 	}
-	for p := range a.Keypairs {
-		keyPair, err := a.GetKeyPair(p)
+	signers, err := newAccountSigners(&AccountInfo{Name: a.Name, Keypairs: map[string]*KeyPairInfo{signPerm: kp}})
+	if err != nil {
+		return nil, err
+	}
+	return signers[signPerm], nil
+}
+
+func initAccountForSDK(s *sdk.IOSTDevSDK) error {
+	if signerURI != "" {
+		if accountName == "" {
+			return fmt.Errorf("please provide the account with flag --account/-a when using --signer")
+		}
+		signer, err := sdk.OpenSigner(signerURI)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to open signer %v: %v", signerURI, err)
 		}
-		s.AddAccountWithPerm(a.Name, keyPair, p)
+		s.AddAccountWithSigner(accountName, signer, signPerm)
+		s.UseAccountAndPerm(accountName, signPerm)
+		return nil
+	}
+	a, err := loadAccount(false)
+	if err != nil {
+		return err
+	}
+	signers, err := newAccountSigners(a)
+	if err != nil {
+		return err
+	}
+	for p, signer := range signers {
+		s.AddAccountWithSigner(a.Name, signer, p)
 	}
 	s.UseAccountAndPerm(a.Name, signPerm)
 	return nil
@@ -188,3 +240,15 @@ func readPasswordFromStdin(repeat bool) ([]byte, error) {
 		return bytePassword, nil
 	}
 }
+
+func readSeedFromStdin() ([]byte, error) {
+	mnemonic, err := readPassword("Enter Mnemonic:  ")
+	if err != nil {
+		return nil, err
+	}
+	passphrase, err := readPassword("Enter Passphrase (empty for none):  ")
+	if err != nil {
+		return nil, err
+	}
+	return hd.NewSeed(string(mnemonic), string(passphrase))
+}
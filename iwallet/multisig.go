@@ -0,0 +1,256 @@
+package iwallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iost-official/go-iost/v3/account"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/sdk"
+)
+
+var multiSigForce bool
+
+// multiSigCmd represents the multisig command.
+var multiSigCmd = &cobra.Command{
+	Use:   "multisig",
+	Short: "Collect signatures of multi-signature accounts",
+	Long: `Collect signatures of multi-signature accounts in an envelope file passed around the key holders.
+The envelope holds the transaction and the signatures collected so far. Signer signatures must be collected
+before publisher signatures, since the publisher signs the transaction together with the signer signatures.`,
+	Example: `  iwallet transfer iost admin bob 100 --signers admin@active --output tx.json
+  iwallet multisig create tx.json envelope.json --account admin
+  iwallet multisig sign envelope.json ~/.iwallet/key0.json
+  iwallet multisig inspect envelope.json
+  iwallet multisig submit envelope.json`,
+}
+
+var multiSigCreateCmd = &cobra.Command{
+	Use:     "create txFile outputFile",
+	Short:   "Create a multi-signature envelope from a transaction",
+	Long:    `Create a multi-signature envelope from a transaction file saved with flag --output, the publisher is given by flag --account`,
+	Example: `  iwallet multisig create tx.json envelope.json --account admin`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "txFile", "outputFile"); err != nil {
+			return err
+		}
+		if accountName == "" {
+			return errorWithHelp(cmd, "please provide the publisher with flag --account/-a")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		txFile := args[0]
+		outputFile := args[1]
+
+		trx := &rpcpb.TransactionRequest{}
+		err := sdk.LoadProtoStructFromJSONFile(txFile, trx)
+		if err != nil {
+			return fmt.Errorf("failed to load transaction file %v: %v", txFile, err)
+		}
+		if err := checkSigners(trx.Signers); err != nil {
+			return err
+		}
+		m := sdk.NewMultiSigTx(trx, accountName)
+		if err := sdk.VerifySignature(m.Tx); err != nil {
+			return err
+		}
+		if err := sdk.SaveMultiSigTx(m, outputFile); err != nil {
+			return fmt.Errorf("failed to save envelope as file %v: %v", outputFile, err)
+		}
+		fmt.Println("Successfully saved envelope as:", outputFile)
+		return nil
+	},
+}
+
+var multiSigSignCmd = &cobra.Command{
+	Use:   "sign envelopeFile keyFile [outputFile]",
+	Short: "Sign the transaction of an envelope",
+	Long: `Sign the transaction of an envelope with keyFile(account json file, private key file or external signer uri) and add the signature to the envelope.
+The envelope is updated in place unless outputFile is given. Use flag --as_publisher_sign to sign as the publisher.`,
+	Example: `  iwallet multisig sign envelope.json ~/.iwallet/key0.json
+  iwallet multisig sign envelope.json ~/.iwallet/admin.json envelope_signed.json --as_publisher_sign`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "envelopeFile", "keyFile"); err != nil {
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		envelopeFile := args[0]
+		signKeyFile := args[1]
+		outputFile := envelopeFile
+		if len(args) > 2 {
+			outputFile = args[2]
+		}
+
+		m, err := sdk.LoadMultiSigTx(envelopeFile)
+		if err != nil {
+			return err
+		}
+		signer, err := loadSigner(signKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load signer from %v: %v", signKeyFile, err)
+		}
+		sig, err := signer.SignTx(m.Tx, asPublisherSign)
+		if err != nil {
+			return fmt.Errorf("failed to sign: %v", err)
+		}
+		if err := m.AddSignature(sig, asPublisherSign); err != nil {
+			return err
+		}
+		if err := sdk.SaveMultiSigTx(m, outputFile); err != nil {
+			return fmt.Errorf("failed to save envelope as file %v: %v", outputFile, err)
+		}
+		fmt.Println("Signed with public key:", account.EncodePubkey(sig.PublicKey))
+		fmt.Println("Successfully saved envelope as:", outputFile)
+		return nil
+	},
+}
+
+var multiSigInspectCmd = &cobra.Command{
+	Use:     "inspect envelopeFile",
+	Short:   "Show the signing progress of an envelope",
+	Long:    `Show the permissions the transaction of an envelope needs, and the weights of the permission items satisfied by the collected signatures against the accounts on chain`,
+	Example: `  iwallet multisig inspect envelope.json`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "envelopeFile"); err != nil {
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		m, err := sdk.LoadMultiSigTx(args[0])
+		if err != nil {
+			return err
+		}
+		if verbose {
+			fmt.Println("Transaction:")
+			fmt.Println(sdk.MarshalTextString(m.Tx))
+		}
+		status, err := m.CheckPermissions(iwalletSDK.GetAccountInfo)
+		if err != nil {
+			return err
+		}
+		ready := true
+		for i, s := range status {
+			if i < len(m.Tx.Signers) {
+				fmt.Println("Signer:")
+			} else {
+				fmt.Println("Publisher:")
+			}
+			printPermissionStatus(s, 1)
+			ready = ready && s.Satisfied
+		}
+		fmt.Printf("Signatures: %v signer, %v publisher\n", len(m.Tx.Signatures), len(m.Tx.PublisherSigs))
+		if ready {
+			fmt.Println("All thresholds are reached, the transaction can be submitted.")
+		} else {
+			fmt.Println("Thresholds are not reached yet.")
+		}
+		return nil
+	},
+}
+
+func printPermissionStatus(s *sdk.PermissionStatus, depth int) {
+	indent := strings.Repeat("    ", depth)
+	fmt.Printf("%v%v@%v weight %v/%v satisfied: %v\n", indent, s.Account, s.Permission, s.Weight, s.Threshold, s.Satisfied)
+	for _, item := range s.Items {
+		mark := "[ ]"
+		if item.Signed {
+			mark = "[x]"
+		}
+		fmt.Printf("%v    %v %v weight %v\n", indent, mark, item.ID, item.Weight)
+		if item.Permission != nil && len(item.Permission.Items) > 0 {
+			printPermissionStatus(item.Permission, depth+2)
+		}
+	}
+	if s.Fallback != nil {
+		fmt.Printf("%v    or\n", indent)
+		printPermissionStatus(s.Fallback, depth+1)
+	}
+}
+
+var multiSigCombineCmd = &cobra.Command{
+	Use:     "combine outputFile envelopeFile...",
+	Short:   "Combine the signatures of envelopes",
+	Long:    `Combine the signatures of envelopes of the same transaction signed separately into one envelope`,
+	Example: `  iwallet multisig combine envelope.json envelope_key0.json envelope_key1.json`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "outputFile", "envelopeFile"); err != nil {
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		outputFile := args[0]
+		var m *sdk.MultiSigTx
+		for _, f := range args[1:] {
+			o, err := sdk.LoadMultiSigTx(f)
+			if err != nil {
+				return err
+			}
+			if m == nil {
+				m = o
+				continue
+			}
+			if err := m.Combine(o); err != nil {
+				return fmt.Errorf("failed to combine envelope %v: %v", f, err)
+			}
+		}
+		if err := sdk.SaveMultiSigTx(m, outputFile); err != nil {
+			return fmt.Errorf("failed to save envelope as file %v: %v", outputFile, err)
+		}
+		fmt.Printf("Signatures: %v signer, %v publisher\n", len(m.Tx.Signatures), len(m.Tx.PublisherSigs))
+		fmt.Println("Successfully saved envelope as:", outputFile)
+		return nil
+	},
+}
+
+var multiSigSubmitCmd = &cobra.Command{
+	Use:     "submit envelopeFile",
+	Short:   "Send the transaction of an envelope",
+	Long:    `Send the transaction of an envelope once the thresholds of the permissions it needs are reached`,
+	Example: `  iwallet multisig submit envelope.json`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "envelopeFile"); err != nil {
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		m, err := sdk.LoadMultiSigTx(args[0])
+		if err != nil {
+			return err
+		}
+		if !multiSigForce {
+			status, err := m.CheckPermissions(iwalletSDK.GetAccountInfo)
+			if err != nil {
+				return err
+			}
+			for _, s := range status {
+				if !s.Satisfied {
+					return fmt.Errorf("threshold of %v@%v is not reached, weight %v/%v", s.Account, s.Permission, s.Weight, s.Threshold)
+				}
+			}
+		}
+		if err := checkTxTime(m.Tx); err != nil {
+			return err
+		}
+		_, err = iwalletSDK.SendSignedTx(m.Tx)
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(multiSigCmd)
+
+	multiSigCmd.AddCommand(multiSigCreateCmd)
+	multiSigCmd.AddCommand(multiSigSignCmd)
+	multiSigCmd.AddCommand(multiSigInspectCmd)
+	multiSigCmd.AddCommand(multiSigCombineCmd)
+	multiSigCmd.AddCommand(multiSigSubmitCmd)
+	multiSigSubmitCmd.Flags().BoolVarP(&multiSigForce, "force", "", false, "send the transaction without checking the thresholds")
+}
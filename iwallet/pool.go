@@ -0,0 +1,56 @@
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iost-official/go-iost/v3/sdk"
+)
+
+// poolCmd represents the pool command.
+var poolCmd = &cobra.Command{
+	Use:   "pool",
+	Short: "Show the tx pool of the node",
+	Long:  `Show the statistics of the pending transactions in the tx pool of the node`,
+	Example: `  iwallet pool
+  iwallet pool list --publisher test0`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		stats, err := iwalletSDK.GetTxPoolStats()
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(stats))
+		return nil
+	},
+}
+
+var poolPublisher string
+var poolContract string
+var poolOffset int32
+var poolLimit int32
+var poolListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pending transactions",
+	Long:  `List the pending transactions in the tx pool, ordered by priority`,
+	Example: `  iwallet pool list
+  iwallet pool list --publisher test0 --contract token.iost --offset 20 --limit 20`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		res, err := iwalletSDK.ListPendingTransactions(poolPublisher, poolContract, poolOffset, poolLimit)
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(res))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(poolCmd)
+
+	poolCmd.AddCommand(poolListCmd)
+	poolListCmd.Flags().StringVarP(&poolPublisher, "publisher", "", "", "only list the transactions of the publisher")
+	poolListCmd.Flags().StringVarP(&poolContract, "contract", "", "", "only list the transactions calling the contract")
+	poolListCmd.Flags().Int32VarP(&poolOffset, "offset", "", 0, "number of transactions to skip")
+	poolListCmd.Flags().Int32VarP(&poolLimit, "limit", "", 20, "max number of transactions to list, at most 100")
+}
@@ -3,6 +3,7 @@ package iwallet
 import (
 	"fmt"
 
+	"github.com/iost-official/go-iost/v3/core/contract"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/sdk"
 
@@ -11,15 +12,24 @@ import (
 
 var update bool
 var postCheck string
+var checkUpgrade bool
 
 // publishCmd represents the publish command.
 var publishCmd = &cobra.Command{
 	Use:     "publish codePath abiPath [contractID [updateID]]",
 	Aliases: []string{"pub"},
 	Short:   "Publish a contract",
-	Long:    `Publish a contract by a contract and an abi file`,
+	Long: `Publish a contract by a contract and an abi file. Set "lang": "wasm" in the abi file to publish a WebAssembly binary.
+When updating with --check-upgrade, the abi is compared with the contract on chain first, and the update is refused if it
+removes or changes any api. The owner can have the chain enforce it by
+  iwallet call system.iost setStrictUpgrade '["ContractXXX", true]'
+once the admin has updated system.iost to the native version 1.0.1, which provides setStrictUpgrade, by
+  iwallet call system.iost updateNativeCode '["system.iost", "1.0.1", ""]' --account admin
+Before that the call fails with "abi setStrictUpgrade not found".`,
 	Example: `  iwallet publish ./example.js ./example.js.abi --account test0
-  iwallet publish -u ./example.js ./example.js.abi ContractXXX --account test0`,
+  iwallet publish -u ./example.js ./example.js.abi ContractXXX --account test0
+  iwallet publish -u --check-upgrade ./example.js ./example.js.abi ContractXXX --account test0
+  iwallet publish ./example.wasm ./example.wasm.abi --account test0`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		var err error
 		if update {
@@ -48,6 +58,14 @@ var publishCmd = &cobra.Command{
 			updateID = args[3]
 		}
 
+		if checkUpgrade {
+			if !update {
+				ilog.Warn("Contract is not being updated, skip --check-upgrade")
+			} else if err := checkContractUpgrade(conID, abiPath); err != nil {
+				return err
+			}
+		}
+
 		actions, err := iwalletSDK.PublishContractActions(codePath, abiPath, conID, update, updateID)
 		if err != nil {
 			return err
@@ -74,10 +92,36 @@ var publishCmd = &cobra.Command{
 	},
 }
 
+func checkContractUpgrade(conID string, abiPath string) error {
+	changes, err := iwalletSDK.CompareContractABI(conID, abiPath)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		fmt.Println("No abi change")
+		return nil
+	}
+	breaking := 0
+	fmt.Println("Abi changes:")
+	for _, c := range changes {
+		mark := " "
+		if c.Breaking() {
+			mark = "!"
+			breaking++
+		}
+		fmt.Printf("%v %v\n", mark, c)
+	}
+	if breaking > 0 {
+		return fmt.Errorf("%w: %v breaking changes", contract.ErrIncompatibleUpgrade, breaking)
+	}
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(publishCmd)
 	publishCmd.Flags().BoolVarP(&update, "update", "u", false, "update contract")
 	publishCmd.Flags().StringVarP(&postCheck, "post_check", "", "", "method to call after the publish."+
 		" It this method fails, the whole tx will fail. For example, you can check `can_update` in a method as post publish hook, "+
 		"to prevent disabling `can_update` accidentally")
+	publishCmd.Flags().BoolVarP(&checkUpgrade, "check-upgrade", "", false, "refuse updating if the abi removes or changes any api of the contract on chain")
 }
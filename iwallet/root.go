@@ -80,9 +80,10 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&useLongestChain, "use_longest", "", false, "get info on longest chain")
 	rootCmd.PersistentFlags().BoolVarP(&checkResult, "check_result", "", true, "check publish/call status after sending to chain")
 	rootCmd.PersistentFlags().BoolVarP(&tryTx, "try_tx", "", false, "Executes a new call immediately without creating a transaction on the block chain")
+	rootCmd.PersistentFlags().BoolVarP(&estimateTx, "estimate_tx", "", false, "Estimates the gas, ram and gas ratio of a new call without creating a transaction on the block chain")
 	rootCmd.PersistentFlags().Float32VarP(&checkResultDelay, "check_result_delay", "", 3, "rpc checking will occur at [checkResultDelay] seconds after sending to chain.")
 	rootCmd.PersistentFlags().Int32VarP(&checkResultMaxRetry, "check_result_max_retry", "", 30, "max times to call grpc to check tx status")
-	rootCmd.PersistentFlags().StringVarP(&signAlgo, "sign_algo", "", "ed25519", "sign algorithm")
+	rootCmd.PersistentFlags().StringVarP(&signAlgo, "sign_algo", "", "ed25519", "sign algorithm: ed25519, secp256k1, secp256r1 or bls")
 	rootCmd.PersistentFlags().StringSliceVarP(&signers, "signers", "", []string{}, "additional signers")
 	rootCmd.PersistentFlags().Float64VarP(&gasLimit, "gas_limit", "l", 1000000, "gas limit for a transaction")
 	rootCmd.PersistentFlags().Float64VarP(&gasRatio, "gas_ratio", "p", 1.0, "gas ratio for a transaction")
@@ -91,7 +92,9 @@ func init() {
 	rootCmd.PersistentFlags().Uint32VarP(&chainID, "chain_id", "", uint32(1024), "chain id which distinguishes different network")
 	rootCmd.PersistentFlags().StringVarP(&txTime, "tx_time", "", "", fmt.Sprintf("use the special tx time instead of now, format: %v", time.Now().Format(time.RFC3339)))
 	rootCmd.PersistentFlags().Uint32VarP(&txTimeDelay, "tx_time_delay", "", 0, "delay the tx time from now")
+	rootCmd.PersistentFlags().BoolVarP(&txTimeFromServer, "tx_time_from_server", "", false, "use the time of the server instead of the local time as now, for chains traveling in time like the devnet")
 	rootCmd.PersistentFlags().StringVarP(&signPerm, "sign_permission", "", "active", "permission used to sign transactions")
+	rootCmd.PersistentFlags().StringVarP(&signerURI, "signer", "", "", "sign transactions of the account with an external signer instead of local keys, eg http://127.0.0.1:30010/?public_key=xxx")
 	rootCmd.PersistentFlags().StringVarP(&outputTxFile, "output", "o", "", "output json file to save transaction request")
 	// the following three flags are used for multiple signature
 	rootCmd.PersistentFlags().StringSliceVarP(&signKeyFiles, "sign_key_files", "", []string{}, "optional private key files used for signing, split by comma")
@@ -140,15 +143,17 @@ var (
 	signAlgo      string
 	signers       []string
 	signPerm      string
-
-	gasLimit     float64
-	gasRatio     float64
-	expiration   int64
-	amountLimit  string
-	delaySecond  int64
-	txTime       string
-	txTimeDelay  uint32
-	outputTxFile string
+	signerURI     string
+
+	gasLimit         float64
+	gasRatio         float64
+	expiration       int64
+	amountLimit      string
+	delaySecond      int64
+	txTime           string
+	txTimeDelay      uint32
+	txTimeFromServer bool
+	outputTxFile     string
 
 	// Used for multi sig.
 	signKeyFiles    []string
@@ -156,6 +161,7 @@ var (
 	asPublisherSign bool
 
 	tryTx               bool
+	estimateTx          bool
 	checkResult         bool
 	checkResultDelay    float32
 	checkResultMaxRetry int32
@@ -13,9 +13,10 @@ import (
 var signCmd = &cobra.Command{
 	Use:   "sign txFile keyFile outputFile",
 	Short: "Sign a tx and save the signature",
-	Long:  `Sign a transaction loaded from given txFile with keyFile(account json file or private key file) and save the signature as outputFile`,
+	Long:  `Sign a transaction loaded from given txFile with keyFile(account json file, private key file or external signer uri) and save the signature as outputFile`,
 	Example: `  iwallet sign tx.json ~/.iwallet/test0.json sign.json
-  iwallet sign tx.json ~/.iwallet/test0_ed25519 sign.json`,
+  iwallet sign tx.json ~/.iwallet/test0_ed25519 sign.json
+  iwallet sign tx.json "http://127.0.0.1:30010/?public_key=6sNQa7PV2SFzqCBtQUcQYJGGoU7XaB6R4xuCQVXNZe6b" sign.json`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if err := checkArgsNumber(cmd, args, "txFile", "keyFile", "outputFile"); err != nil {
 			return err
@@ -32,15 +33,14 @@ var signCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed to load transaction file %v: %v", txFile, err)
 		}
-		accInfo, err := loadAccountFrom(signKeyFile, true)
+		signer, err := loadSigner(signKeyFile)
 		if err != nil {
-			return fmt.Errorf("failed to load account from file %v: %v", signKeyFile, err)
+			return fmt.Errorf("failed to load signer from %v: %v", signKeyFile, err)
 		}
-		kp, err := accInfo.GetKeyPair(signPerm)
+		sig, err := signer.SignTx(trx, asPublisherSign)
 		if err != nil {
-			return fmt.Errorf("failed to get key pair from file %v: %v", signKeyFile, err)
+			return fmt.Errorf("failed to sign: %v", err)
 		}
-		sig := sdk.GetSignatureOfTx(trx, kp, asPublisherSign)
 		if verbose {
 			fmt.Println("Signature:")
 			fmt.Println(sdk.MarshalTextString(sig))
@@ -0,0 +1,308 @@
+package iwallet
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/sdk"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/spf13/cobra"
+)
+
+var storageMap bool
+var storagePrefix string
+var storageFrom string
+var storageTo string
+var storageLimit int64
+var storageAll bool
+var storageFormat string
+var storageFile string
+var storageBlockNumber int64
+
+var storageCmd = &cobra.Command{
+	Use:   "storage",
+	Short: "Browse contract storage decoded by its schema",
+	Long: `Browse contract storage decoded by its schema. Contracts declare the schema in the "storage" of the abi file,
+native contracts have it built in. Values not described by the schema are printed as they are stored`,
+}
+
+var storageSchemaCmd = &cobra.Command{
+	Use:     "schema contract",
+	Short:   "Print the storage schema of a contract",
+	Long:    `Print the storage schema of a contract`,
+	Example: `  iwallet storage schema token.iost`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		return checkArgsNumber(cmd, args, "contract")
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := iwalletSDK.GetContract(args[0])
+		if err != nil {
+			return err
+		}
+		if len(c.Storage) == 0 {
+			fmt.Println("No storage schema declared")
+			return nil
+		}
+		for _, s := range c.Storage {
+			kind := "kv"
+			if s.Field != "" {
+				kind = "map"
+			}
+			fmt.Printf("%-4v %v", kind, s.Key)
+			if s.Field != "" {
+				fmt.Printf(" [%v]", s.Field)
+			}
+			fmt.Printf(": %v", s.Type)
+			if s.Description != "" {
+				fmt.Printf(" // %v", s.Description)
+			}
+			fmt.Println()
+		}
+		return nil
+	},
+}
+
+var storageGetCmd = &cobra.Command{
+	Use:   "get contract key [field]",
+	Short: "Get a decoded value of contract storage",
+	Long:  `Get a decoded value of contract storage as json`,
+	Example: `  iwallet storage get token.iost TBadmin iost
+  iwallet storage get system.iost contract_owner ContractXXX`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		return checkArgsNumber(cmd, args, "contract", "key")
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var field string
+		if len(args) > 2 {
+			field = args[2]
+		}
+		res, err := iwalletSDK.GetContractStorage(&rpcpb.GetContractStorageRequest{
+			Id:             args[0],
+			Key:            args[1],
+			Field:          field,
+			ByLongestChain: useLongestChain,
+			Decode:         true,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println(res.Data)
+		return nil
+	},
+}
+
+var storageProveCmd = &cobra.Command{
+	Use:   "prove contract key [field]",
+	Short: "Get a value of contract storage with the merkle proof of it",
+	Long: `Get a value of contract storage with the merkle proof of it, and check the proof against the state root in the head of
+the block. The block head is fetched from the same node, check its hash with a trusted source to trust the value`,
+	Example: `  iwallet storage prove token.iost TBadmin iost
+  iwallet storage prove token.iost TBadmin iost --block_number 1000`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		return checkArgsNumber(cmd, args, "contract", "key")
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := iwalletSDK.Connect(); err != nil {
+			return err
+		}
+		defer iwalletSDK.CloseConn()
+
+		req := &rpcpb.GetStateProofRequest{
+			Id:             args[0],
+			Key:            args[1],
+			ByLongestChain: useLongestChain,
+			BlockNumber:    storageBlockNumber,
+		}
+		if len(args) > 2 {
+			req.Field = args[2]
+		}
+		res, err := iwalletSDK.GetStateProof(req)
+		if err != nil {
+			return err
+		}
+		blk, err := iwalletSDK.GetBlockByHash(res.BlockHash, false)
+		if err != nil {
+			return err
+		}
+		if err := sdk.VerifyStateProof(req, res, blk.Block.StateRoot); err != nil {
+			return fmt.Errorf("invalid proof: %v", err)
+		}
+		if !res.Exist {
+			fmt.Println("Key does not exist")
+		} else {
+			// print the proven value decoded locally, not the data formatted by the node
+			data, err := provenData(res.Value)
+			if err != nil {
+				return err
+			}
+			fmt.Println(data)
+		}
+		fmt.Printf("Proved by the state root %v of block %v %v\n", blk.Block.StateRoot, res.BlockNumber, res.BlockHash)
+		return nil
+	},
+}
+
+// provenData decodes the proven storage value in the format of the data returned by the node.
+func provenData(value string) (string, error) {
+	v := database.Unmarshal(value)
+	if err, ok := v.(error); ok {
+		return "", fmt.Errorf("invalid value: %v", err)
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal %v", v)
+	}
+	return string(b), nil
+}
+
+var storageListCmd = &cobra.Command{
+	Use:   "list contract",
+	Short: "List decoded contract storage",
+	Long: `List decoded contract storage as json or csv. Keys are listed in order, a page at a time unless flag --all is set.
+The key to list the next page from is printed to stderr`,
+	Example: `  iwallet storage list token.iost --map --prefix TB --all --format csv --file balances.csv
+  iwallet storage list ContractXXX --prefix user_ --limit 20 --from user_bob`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "contract"); err != nil {
+			return err
+		}
+		if storageFormat != "json" && storageFormat != "csv" {
+			return fmt.Errorf("invalid format %v, should be json or csv", storageFormat)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := iwalletSDK.Connect(); err != nil {
+			return err
+		}
+		defer iwalletSDK.CloseConn()
+
+		req := &rpcpb.ListContractStorageRequest{
+			Id:             args[0],
+			From:           storageFrom,
+			To:             storageTo,
+			Prefix:         storagePrefix,
+			Limit:          storageLimit,
+			ByLongestChain: useLongestChain,
+			Decode:         true,
+		}
+		if storageMap {
+			req.StorageType = rpcpb.ListContractStorageRequest_MAP
+		}
+		var datas []*rpcpb.ListContractStorageResponse_Data
+		for {
+			res, err := iwalletSDK.ListContractStorage(req)
+			if err != nil {
+				return err
+			}
+			datas = append(datas, res.Datas...)
+			req.From = res.Next
+			if !storageAll || res.Next == "" {
+				break
+			}
+		}
+		if req.From != "" {
+			fmt.Fprintln(os.Stderr, "next:", req.From)
+		}
+
+		w := io.Writer(os.Stdout)
+		if storageFile != "" {
+			f, err := os.Create(storageFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		if storageFormat == "csv" {
+			return writeStorageCSV(w, datas)
+		}
+		return writeStorageJSON(w, datas)
+	},
+}
+
+type storageEntry struct {
+	Key    string            `json:"key"`
+	Field  string            `json:"field,omitempty"`
+	Type   string            `json:"type,omitempty"`
+	Params map[string]string `json:"params,omitempty"`
+	Value  json.RawMessage   `json:"value"`
+}
+
+func writeStorageJSON(w io.Writer, datas []*rpcpb.ListContractStorageResponse_Data) error {
+	entries := make([]*storageEntry, 0, len(datas))
+	for _, d := range datas {
+		entries = append(entries, &storageEntry{
+			Key:    d.Key,
+			Field:  d.Field,
+			Type:   d.Type,
+			Params: d.Params,
+			Value:  json.RawMessage(d.Value),
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	return enc.Encode(entries)
+}
+
+func writeStorageCSV(w io.Writer, datas []*rpcpb.ListContractStorageResponse_Data) error {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, d := range datas {
+		for name := range d.Params {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	cw := csv.NewWriter(w)
+	header := append([]string{"key", "field"}, names...)
+	if err := cw.Write(append(header, "type", "value")); err != nil {
+		return err
+	}
+	for _, d := range datas {
+		record := []string{d.Key, d.Field}
+		for _, name := range names {
+			record = append(record, d.Params[name])
+		}
+		value := d.Value
+		var s string
+		if json.Unmarshal([]byte(d.Value), &s) == nil {
+			value = s
+		}
+		if err := cw.Write(append(record, d.Type, value)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func init() {
+	rootCmd.AddCommand(storageCmd)
+	storageCmd.AddCommand(storageSchemaCmd)
+	storageCmd.AddCommand(storageGetCmd)
+	storageCmd.AddCommand(storageProveCmd)
+	storageCmd.AddCommand(storageListCmd)
+
+	storageProveCmd.Flags().Int64VarP(&storageBlockNumber, "block_number", "", 0, "prove the value at this block instead of the head or the last irreversible block")
+	storageListCmd.Flags().BoolVarP(&storageMap, "map", "", false, "list map storage instead of kv storage")
+	storageListCmd.Flags().StringVarP(&storagePrefix, "prefix", "", "", "list keys with the prefix")
+	storageListCmd.Flags().StringVarP(&storageFrom, "from", "", "", "list keys from this one")
+	storageListCmd.Flags().StringVarP(&storageTo, "to", "", "", "list keys before this one")
+	storageListCmd.Flags().Int64VarP(&storageLimit, "limit", "", 100, "max count of keys of a page, up to 100")
+	storageListCmd.Flags().BoolVarP(&storageAll, "all", "", false, "list all pages")
+	storageListCmd.Flags().StringVarP(&storageFormat, "format", "", "json", "output format: json or csv")
+	storageListCmd.Flags().StringVarP(&storageFile, "file", "", "", "write to the file instead of stdout")
+}
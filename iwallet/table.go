@@ -20,7 +20,7 @@ func getContractStorage(contract, key, field string) (*rpcpb.GetContractStorageR
 var tableCmd = &cobra.Command{
 	Use:   "table contract key [field]",
 	Short: "Fetch stored info of given contract",
-	Long:  `Fetch stored info of given contract`,
+	Long:  `Fetch stored info of given contract. Use "iwallet storage" to decode it by the storage schema of the contract`,
 	Example: `  iwallet table vote_producer.iost currentProducerList
   iwallet table vote_producer.iost producerTable producer000`,
 	Args: func(cmd *cobra.Command, args []string) error {
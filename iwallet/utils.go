@@ -45,6 +45,14 @@ func checkFloat(cmd *cobra.Command, arg string, argName string) error {
 	return nil
 }
 
+func checkInt(cmd *cobra.Command, arg string, argName string) error {
+	_, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return errorWithHelp(cmd, `invalid value "%v" for argument "%v": %v`, arg, argName, err)
+	}
+	return nil
+}
+
 func checkSigners(signers []string) error {
 	for _, s := range signers {
 		if !(len(strings.Split(s, "@")) == 2) {
@@ -87,6 +95,9 @@ func parseTxTime() (int64, error) {
 	if txTime != "" && txTimeDelay != 0 {
 		return 0, fmt.Errorf("can not set flags --tx_time and --tx_time_delay simultaneously")
 	}
+	if txTime != "" && txTimeFromServer {
+		return 0, fmt.Errorf("can not set flags --tx_time and --tx_time_from_server simultaneously")
+	}
 	if txTime != "" {
 		t, err := time.Parse(time.RFC3339, txTime)
 		if err != nil {
@@ -95,11 +106,22 @@ func parseTxTime() (int64, error) {
 		return t.UnixNano(), nil
 	}
 	t := time.Now()
+	if txTimeFromServer {
+		info, err := iwalletSDK.GetNodeInfo()
+		if err != nil {
+			return 0, err
+		}
+		t = time.Unix(0, info.ServerTime)
+	}
 	t = t.Add(time.Second * time.Duration(txTimeDelay))
 	return t.UnixNano(), nil
 }
 
 func checkTxTime(tx *rpcpb.TransactionRequest) error {
+	if txTimeFromServer {
+		// the clock of the server may run ahead of the local one
+		return nil
+	}
 	timepoint := time.Unix(0, tx.Time)
 	delta := time.Until(timepoint)
 	if math.Abs(delta.Seconds()) > 1 {
@@ -179,15 +201,15 @@ func handleMultiSig(tx *rpcpb.TransactionRequest, signatureFiles []string, signK
 	}
 	if len(signKeyFiles) > 0 {
 		for _, f := range signKeyFiles {
-			accInfo, err := loadAccountFrom(f, true)
+			signer, err := loadSigner(f)
 			if err != nil {
-				return fmt.Errorf("failed to load account from file %v: %v", f, err)
+				return fmt.Errorf("failed to load signer from %v: %v", f, err)
 			}
-			kp, err := accInfo.GetKeyPair("avtive")
+			sig, err := signer.SignTx(tx, asPublisherSign)
 			if err != nil {
-				return fmt.Errorf("failed to get key pair from file %v: %v", f, err)
+				return fmt.Errorf("failed to sign with %v: %v", f, err)
 			}
-			sigs = append(sigs, sdk.GetSignatureOfTx(tx, kp, asPublisherSign))
+			sigs = append(sigs, sig)
 			fmt.Println("Signed transaction with private key file:", f)
 		}
 	} else if len(signatureFiles) > 0 {
@@ -261,6 +283,22 @@ func processTx(tx *rpcpb.TransactionRequest) (string, error) {
 		fmt.Println(sdk.MarshalTextString(r))
 		return "", nil
 	}
+	if estimateTx {
+		r, err := iwalletSDK.EstimateTx(tx)
+		if err != nil {
+			return "", err
+		}
+		fmt.Println(sdk.MarshalTextString(r))
+		return "", nil
+	}
+	if traceTx {
+		r, err := iwalletSDK.TraceTx(tx)
+		if err != nil {
+			return "", err
+		}
+		fmt.Println(sdk.MarshalTextString(r))
+		return "", nil
+	}
 	return iwalletSDK.SendTx(tx)
 }
 
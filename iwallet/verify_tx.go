@@ -0,0 +1,68 @@
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iost-official/go-iost/v3/common"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/sdk"
+)
+
+var trustedBlockHash string
+
+var verifyTxCmd = &cobra.Command{
+	Use:   "verify-tx transactionHash",
+	Short: "Verify that a transaction and its receipt are included in a trusted block",
+	Long: `Verify that an irreversible transaction and its receipt are included in a block, with the merkle proofs from the node
+against the block head. The hash of the block should come from a source trusted more than the node, such as a light client
+or a node of your own`,
+	Example: `  iwallet verify-tx 7MDfKBeZToQnnfNHD58cbZ7o4Y2AktKLmiEg776HLPBT --block_hash 5YGSgcGu9mQ9pj9W5Xb1d2WNRMCsNdx3ZVhj9fzRoGZ6`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "transactionHash"); err != nil {
+			return err
+		}
+		if trustedBlockHash == "" {
+			return fmt.Errorf("flag --block_hash is required, find the block of the tx by \"iwallet tx\" and check its hash with a trusted source")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := iwalletSDK.Connect(); err != nil {
+			return err
+		}
+		defer iwalletSDK.CloseConn()
+
+		txProof, err := iwalletSDK.GetTxInclusionProof(args[0])
+		if err != nil {
+			return err
+		}
+		t, head, err := sdk.VerifyTxInclusionProof(txProof, trustedBlockHash)
+		if err != nil {
+			return fmt.Errorf("invalid tx proof: %v", err)
+		}
+		if hash := common.Base58Encode(t.Hash()); hash != args[0] {
+			return fmt.Errorf("invalid tx proof: proved tx %v instead", hash)
+		}
+		receiptProof, err := iwalletSDK.GetReceiptInclusionProof(args[0])
+		if err != nil {
+			return err
+		}
+		r, _, err := sdk.VerifyReceiptInclusionProof(receiptProof, trustedBlockHash)
+		if err != nil {
+			return fmt.Errorf("invalid receipt proof: %v", err)
+		}
+		if hash := common.Base58Encode(r.TxHash); hash != args[0] {
+			return fmt.Errorf("invalid receipt proof: proved the receipt of tx %v instead", hash)
+		}
+		fmt.Printf("Tx %v is included in block %v %v signed by %v\n", args[0], head.Number, trustedBlockHash, head.Witness)
+		fmt.Printf("Receipt status: %v %v\n", rpcpb.TxReceipt_StatusCode(r.Status.Code), r.Status.Message)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(verifyTxCmd)
+	verifyTxCmd.Flags().StringVarP(&trustedBlockHash, "block_hash", "", "", "hash of the block including the tx, from a trusted source")
+}
@@ -52,6 +52,10 @@ const (
 	SyncBlockResponse
 	SyncHeight
 	PublishTx
+	SnapshotManifestRequest
+	SnapshotManifestResponse
+	SnapshotChunkRequest
+	SnapshotChunkResponse
 
 	UrgentMessage = 1
 	NormalMessage = 2
@@ -81,6 +85,14 @@ func (m MessageType) String() string {
 		return "PublishTx"
 	case NewBlockHash:
 		return "NewBlockHash"
+	case SnapshotManifestRequest:
+		return "SnapshotManifestRequest"
+	case SnapshotManifestResponse:
+		return "SnapshotManifestResponse"
+	case SnapshotChunkRequest:
+		return "SnapshotChunkRequest"
+	case SnapshotChunkResponse:
+		return "SnapshotChunkResponse"
 	default:
 		return "unknown_type:" + strconv.Itoa(int(m))
 	}
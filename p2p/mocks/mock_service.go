@@ -34,6 +34,16 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AdjustPeerScore mocks base method
+func (m *MockService) AdjustPeerScore(arg0 peer.ID, arg1 int) {
+	m.ctrl.Call(m, "AdjustPeerScore", arg0, arg1)
+}
+
+// AdjustPeerScore indicates an expected call of AdjustPeerScore
+func (mr *MockServiceMockRecorder) AdjustPeerScore(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustPeerScore", reflect.TypeOf((*MockService)(nil).AdjustPeerScore), arg0, arg1)
+}
+
 // Broadcast mocks base method
 func (m *MockService) Broadcast(arg0 []byte, arg1 p2p.MessageType, arg2 p2p.MessagePriority) {
 	m.ctrl.Call(m, "Broadcast", arg0, arg1, arg2)
@@ -93,6 +103,18 @@ func (mr *MockServiceMockRecorder) ID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockService)(nil).ID))
 }
 
+// PeerScore mocks base method
+func (m *MockService) PeerScore(arg0 peer.ID) int {
+	ret := m.ctrl.Call(m, "PeerScore", arg0)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PeerScore indicates an expected call of PeerScore
+func (mr *MockServiceMockRecorder) PeerScore(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeerScore", reflect.TypeOf((*MockService)(nil).PeerScore), arg0)
+}
+
 // PutPeerToBlack mocks base method
 func (m *MockService) PutPeerToBlack(arg0 string) {
 	m.ctrl.Call(m, "PutPeerToBlack", arg0)
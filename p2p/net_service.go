@@ -43,6 +43,8 @@ type Service interface {
 	ID() string
 	ConnectBPs([]string)
 	PutPeerToBlack(string)
+	AdjustPeerScore(PeerID, int)
+	PeerScore(PeerID) int
 
 	Broadcast([]byte, MessageType, MessagePriority)
 	SendToPeer(PeerID, []byte, MessageType, MessagePriority)
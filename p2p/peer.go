@@ -91,6 +91,16 @@ func (p *Peer) Addr() string {
 	return p.addr.String()
 }
 
+// IP returns the ip address of the peer, or an empty string if the address has no ip.
+func (p *Peer) IP() string {
+	for _, code := range []int{multiaddr.P_IP4, multiaddr.P_IP6} {
+		if ip, err := p.addr.ValueForProtocol(code); err == nil {
+			return ip
+		}
+	}
+	return ""
+}
+
 // Start starts peer's loop.
 func (p *Peer) Start() {
 	ilog.Infof("peer is started. id=%s", p.ID())
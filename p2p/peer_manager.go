@@ -34,6 +34,9 @@ var (
 
 	dialTimeout        = 10 * time.Second
 	deadPeerRetryTimes = 5
+
+	// minPeerScore is the score below which a peer is put to black list.
+	minPeerScore = -100
 )
 
 type connDirection int
@@ -91,6 +94,9 @@ type PeerManager struct {
 
 	retryTimes map[string]int
 	rtMutex    sync.RWMutex
+
+	scores     map[peer.ID]int
+	scoreMutex sync.RWMutex
 }
 
 // NewPeerManager returns a new instance of PeerManager struct.
@@ -110,6 +116,7 @@ func NewPeerManager(host host.Host, config *common.P2PConfig) *PeerManager {
 		blackPIDs:     make(map[string]bool),
 		blackIPs:      make(map[string]bool),
 		retryTimes:    make(map[string]int),
+		scores:        make(map[peer.ID]int),
 	}
 	if config.InboundConn <= 0 {
 		pm.neighborCap[inbound] = defaultOutboundConn
@@ -914,6 +921,30 @@ func (pm *PeerManager) isPIDBlack(pid peer.ID) bool {
 	return pm.blackPIDs[pid.Pretty()]
 }
 
+// AdjustPeerScore adds delta to the score of the peer. The peer is put to black list once its score is below
+// minPeerScore, so the modules should lower the score of the peers sending invalid data.
+func (pm *PeerManager) AdjustPeerScore(peerID peer.ID, delta int) {
+	pm.scoreMutex.Lock()
+	pm.scores[peerID] += delta
+	score := pm.scores[peerID]
+	if score < minPeerScore {
+		delete(pm.scores, peerID)
+	}
+	pm.scoreMutex.Unlock()
+
+	if score < minPeerScore {
+		ilog.Warnf("score of peer %v is %v, put it to black list", peerID.Pretty(), score)
+		pm.PutPeerToBlack(peerID.Pretty())
+	}
+}
+
+// PeerScore returns the score of the peer, which starts from 0.
+func (pm *PeerManager) PeerScore(peerID peer.ID) int {
+	pm.scoreMutex.RLock()
+	defer pm.scoreMutex.RUnlock()
+	return pm.scores[peerID]
+}
+
 func (pm *PeerManager) recordDialFail(pid peer.ID) {
 	pm.rtMutex.Lock()
 	defer pm.rtMutex.Unlock()
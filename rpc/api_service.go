@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -24,9 +25,12 @@ import (
 	"github.com/iost-official/go-iost/v3/consensus/cverifier"
 	"github.com/iost-official/go-iost/v3/core/block"
 	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/contract"
 	"github.com/iost-official/go-iost/v3/core/event"
 	"github.com/iost-official/go-iost/v3/core/global"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
 	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/core/txindex"
 	"github.com/iost-official/go-iost/v3/core/txpool"
 	"github.com/iost-official/go-iost/v3/db"
 	"github.com/iost-official/go-iost/v3/ilog"
@@ -46,7 +50,11 @@ type APIService struct {
 	txpool     txpool.TxPool
 	blockchain block.Chain
 	stateDB    db.MVCCDB
+	archive    *db.Archive
+	txIndex    *txindex.Indexer
+	events     *event.Store
 	config     *common.Config
+	clock      *common.Clock
 
 	quitCh chan struct{}
 }
@@ -59,7 +67,11 @@ func NewAPIService(tp txpool.TxPool, chainBase *chainbase.ChainBase, config *com
 		blockchain: chainBase.BlockChain(),
 		bc:         chainBase.BlockCache(),
 		stateDB:    chainBase.StateDB(),
+		archive:    chainBase.Archive(),
+		txIndex:    chainBase.TxIndex(),
+		events:     chainBase.EventStore(),
 		config:     config,
+		clock:      chainBase.Clock(),
 		quitCh:     quitCh,
 	}
 }
@@ -72,7 +84,7 @@ func (as *APIService) GetNodeInfo(context.Context, *rpcpb.EmptyRequest) (*rpcpb.
 		CodeVersion: global.CodeVersion,
 		Mode:        common.Mode(),
 		Network:     &rpcpb.NetworkInfo{},
-		ServerTime:  time.Now().UnixNano(),
+		ServerTime:  as.clock.Now().UnixNano(),
 	}
 	p2pNeighbors := as.p2pService.GetAllNeighbors()
 	networkInfo := &rpcpb.NetworkInfo{
@@ -178,9 +190,96 @@ func (as *APIService) GetTxReceiptByTxHash(ctx context.Context, req *rpcpb.TxHas
 	if err != nil {
 		return nil, err
 	}
+	if as.config.DB.StateDiff {
+		receipt.StateDiff, err = as.blockchain.GetStateDiffByTxHash(txHashBytes)
+		if err != nil {
+			ilog.Debugf("Get state diff of tx %v failed: %v", req.GetHash(), err)
+		}
+	}
 	return toPbTxReceipt(receipt), nil
 }
 
+// GetTxInclusionProof returns the merkle path of an irreversible tx to the tx merkle hash of the block.
+func (as *APIService) GetTxInclusionProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.TxInclusionProofResponse, error) {
+	blk, err := as.getIrreversibleBlockByTxHash(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([][]byte, 0, len(blk.Txs))
+	for _, t := range blk.Txs {
+		hashes = append(hashes, t.Hash())
+	}
+	m := merkletree.MerkleTree{}
+	m.Build(hashes)
+	idx, mp, err := m.MerkleProof(common.Base58Decode(req.GetHash()))
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.TxInclusionProofResponse{
+		BlockHead:   blk.Head.ToPb(),
+		BlockSign:   blk.Sign.ToPb(),
+		Transaction: blk.Txs[idx].ToPb(),
+		Index:       idx,
+		MerklePath:  base58EncodeAll(mp),
+	}, nil
+}
+
+// GetReceiptInclusionProof returns the merkle path of the receipt of an irreversible tx to the tx receipt merkle hash of the block.
+func (as *APIService) GetReceiptInclusionProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.ReceiptInclusionProofResponse, error) {
+	blk, err := as.getIrreversibleBlockByTxHash(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	txHash := common.Base58Decode(req.GetHash())
+	var receipt *tx.TxReceipt
+	hashes := make([][]byte, 0, len(blk.Receipts))
+	for _, r := range blk.Receipts {
+		hashes = append(hashes, r.Hash())
+		if bytes.Equal(r.TxHash, txHash) {
+			receipt = r
+		}
+	}
+	if receipt == nil {
+		return nil, errors.New("txreceipt not found")
+	}
+	m := merkletree.MerkleTree{}
+	m.Build(hashes)
+	idx, mp, err := m.MerkleProof(receipt.Hash())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.ReceiptInclusionProofResponse{
+		BlockHead:  blk.Head.ToPb(),
+		BlockSign:  blk.Sign.ToPb(),
+		Receipt:    receipt.ToPb(),
+		Index:      idx,
+		MerklePath: base58EncodeAll(mp),
+	}, nil
+}
+
+func (as *APIService) getIrreversibleBlockByTxHash(hash string) (*block.Block, error) {
+	if err := checkHashValid(hash); err != nil {
+		return nil, err
+	}
+	number, err := as.blockchain.GetBlockNumberByTxHash(common.Base58Decode(hash))
+	if err != nil {
+		return nil, errors.New("tx not found in irreversible blocks")
+	}
+	blk, err := as.blockchain.GetBlockByNumber(number)
+	if err != nil {
+		return nil, fmt.Errorf("block not found: %v", err)
+	}
+	return blk, nil
+}
+
+func base58EncodeAll(hashes [][]byte) []string {
+	ret := make([]string, 0, len(hashes))
+	for _, h := range hashes {
+		ret = append(ret, common.Base58Encode(h))
+	}
+	return ret
+}
+
 // GetBlockByHash returns block corresponding to the given hash.
 func (as *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHashRequest) (*rpcpb.BlockResponse, error) {
 	err := checkHashValid(req.GetHash())
@@ -289,7 +388,7 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 		return nil, err
 	}
 
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -311,13 +410,7 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 	}
 
 	// pack gas information
-	var blkTime int64
-	if req.GetByLongestChain() {
-		blkTime = as.bc.Head().Head.Time
-	} else {
-		blkTime = as.bc.LinkedRoot().Head.Time
-	}
-	totalGas := dbVisitor.PGasAtTime(req.GetName(), blkTime)
+	totalGas := dbVisitor.PGasAtTime(req.GetName(), bcn.Head.Time)
 	gasLimit := dbVisitor.GasLimit(req.GetName())
 	gasRate := dbVisitor.GasPledgeTotal(req.GetName()).Multiply(database.GasIncreaseRate)
 	pledgedInfo := dbVisitor.PledgerInfo(req.GetName())
@@ -337,7 +430,7 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 
 	// pack frozen balance information
 	frozen := dbVisitor.AllFreezedTokenBalanceFixed("iost", req.GetName())
-	unfrozen, stillFrozen := as.getUnfrozenToken(frozen, req.ByLongestChain)
+	unfrozen, stillFrozen := as.getUnfrozenToken(frozen, bcn.Head.Time)
 	ret.FrozenBalances = stillFrozen
 	ret.Balance += unfrozen
 
@@ -360,7 +453,7 @@ func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBa
 		return nil, err
 	}
 
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -371,7 +464,7 @@ func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBa
 	balance := dbVisitor.TokenBalanceFixed(req.GetToken(), req.GetAccount()).ToFloat()
 	// pack frozen balance information
 	frozen := dbVisitor.AllFreezedTokenBalanceFixed(req.GetToken(), req.GetAccount())
-	unfrozen, stillFrozen := as.getUnfrozenToken(frozen, req.ByLongestChain)
+	unfrozen, stillFrozen := as.getUnfrozenToken(frozen, bcn.Head.Time)
 	return &rpcpb.GetTokenBalanceResponse{
 		Balance:        balance + unfrozen,
 		FrozenBalances: stillFrozen,
@@ -385,7 +478,7 @@ func (as *APIService) GetToken721Balance(ctx context.Context, req *rpcpb.GetToke
 		return nil, err
 	}
 
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -403,7 +496,7 @@ func (as *APIService) GetToken721Balance(ctx context.Context, req *rpcpb.GetToke
 
 // GetToken721Metadata returns metadata of an specific token721 token.
 func (as *APIService) GetToken721Metadata(ctx context.Context, req *rpcpb.GetToken721InfoRequest) (*rpcpb.GetToken721MetadataResponse, error) {
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -415,7 +508,7 @@ func (as *APIService) GetToken721Metadata(ctx context.Context, req *rpcpb.GetTok
 
 // GetToken721Owner returns owner of an specific token721 token.
 func (as *APIService) GetToken721Owner(ctx context.Context, req *rpcpb.GetToken721InfoRequest) (*rpcpb.GetToken721OwnerResponse, error) {
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -431,7 +524,7 @@ func (as *APIService) GetContract(ctx context.Context, req *rpcpb.GetContractReq
 	if err != nil {
 		return nil, err
 	}
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -448,7 +541,7 @@ func (as *APIService) GetContractVote(ctx context.Context, req *rpcpb.GetContrac
 	if err != nil {
 		return nil, err
 	}
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -494,7 +587,7 @@ func (as *APIService) GetProducerVoteInfo(ctx context.Context, req *rpcpb.GetPro
 	if err != nil {
 		return nil, err
 	}
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -520,7 +613,7 @@ func (as *APIService) GetProducerVoteInfo(ctx context.Context, req *rpcpb.GetPro
 
 // GetContractStorage returns contract storage corresponding to the given key and field.
 func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -532,20 +625,25 @@ func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetCont
 	default:
 		value, _ = h.GlobalMapGet(req.GetId(), req.GetKey(), req.GetField())
 	}
-	data, err := formatInternalValue(value)
+	ret := &rpcpb.GetContractStorageResponse{
+		BlockHash:   common.Base58Encode(bcn.HeadHash()),
+		BlockNumber: bcn.Head.Number,
+	}
+	if req.Decode {
+		schema := storageSchema(dbVisitor.Contract(req.GetId()))
+		ret.Data, ret.Type, _, err = decodeStorageValue(schema, req.GetKey(), req.GetField(), value)
+	} else {
+		ret.Data, err = formatInternalValue(value)
+	}
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.GetContractStorageResponse{
-		Data:        data,
-		BlockHash:   common.Base58Encode(bcn.HeadHash()),
-		BlockNumber: bcn.Head.Number,
-	}, nil
+	return ret, nil
 }
 
 // GetBatchContractStorage returns contract storage corresponding to the given keys and fields.
 func (as *APIService) GetBatchContractStorage(ctx context.Context, req *rpcpb.GetBatchContractStorageRequest) (*rpcpb.GetBatchContractStorageResponse, error) {
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -582,7 +680,7 @@ func (as *APIService) GetBatchContractStorage(ctx context.Context, req *rpcpb.Ge
 
 // GetContractStorageFields returns contract storage corresponding to the given fields.
 func (as *APIService) GetContractStorageFields(ctx context.Context, req *rpcpb.GetContractStorageFieldsRequest) (*rpcpb.GetContractStorageFieldsResponse, error) {
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -597,6 +695,60 @@ func (as *APIService) GetContractStorageFields(ctx context.Context, req *rpcpb.G
 	}, nil
 }
 
+// GetStateProof returns a value of contract storage with the merkle proof of it against the state root of the block.
+func (as *APIService) GetStateProof(ctx context.Context, req *rpcpb.GetStateProofRequest) (*rpcpb.GetStateProofResponse, error) {
+	if req.Id == "" {
+		return nil, fmt.Errorf("invalid contract id")
+	}
+	mvcc, bcn, err := as.getStateDBAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if bcn.Head.Version < block.V2 {
+		return nil, fmt.Errorf("block %v does not commit to the state root", bcn.Head.Number)
+	}
+	key := database.StateKey(req.Id, req.Key, req.Field)
+	exist, err := mvcc.Has(database.StateTable, key)
+	if err != nil {
+		return nil, err
+	}
+	var value string
+	var v interface{}
+	if exist {
+		value, err = mvcc.Get(database.StateTable, key)
+		if err != nil {
+			return nil, err
+		}
+		v = database.Unmarshal(value)
+		if err, ok := v.(error); ok {
+			return nil, err
+		}
+	}
+	data, err := formatInternalValue(v)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := verifier.NewStateTree(mvcc).Prove(bcn.Head.StateRoot, []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove the state: %v", err)
+	}
+	ret := &rpcpb.GetStateProofResponse{
+		StateKey:    key,
+		Exist:       exist,
+		Value:       value,
+		Data:        data,
+		BlockHash:   common.Base58Encode(bcn.HeadHash()),
+		BlockNumber: bcn.Head.Number,
+		StateRoot:   common.Base58Encode(bcn.Head.StateRoot),
+		LeafKey:     common.Base58Encode(proof.LeafKey),
+		LeafValue:   common.Base58Encode(proof.LeafValue),
+	}
+	for _, s := range proof.Siblings {
+		ret.Siblings = append(ret.Siblings, common.Base58Encode(s))
+	}
+	return ret, nil
+}
+
 // nolint: gocyclo
 func (as *APIService) ListContractStorage(ctx context.Context, req *rpcpb.ListContractStorageRequest) (*rpcpb.ListContractStorageResponse, error) {
 	if req.Id == "" {
@@ -609,13 +761,7 @@ func (as *APIService) ListContractStorage(ctx context.Context, req *rpcpb.ListCo
 	if limit == 0 {
 		limit = 100
 	}
-	var bcn *blockcache.BlockCacheNode
-	if req.ByLongestChain {
-		bcn = as.bc.Head()
-	} else {
-		bcn = as.bc.LinkedRoot()
-	}
-	mvcc, err := as.getStateDBByBlock(bcn)
+	mvcc, bcn, err := as.getStateDBAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -633,7 +779,7 @@ func (as *APIService) ListContractStorage(ctx context.Context, req *rpcpb.ListCo
 		queryRange.Limit = []byte(prefix + req.To)
 	}
 	keys, err := mvcc.KeysByRange(database.StateTable, string(queryRange.Start),
-		string(queryRange.Limit), int(limit))
+		string(queryRange.Limit), int(limit)+1)
 	if err != nil {
 		return nil, err
 	}
@@ -641,13 +787,37 @@ func (as *APIService) ListContractStorage(ctx context.Context, req *rpcpb.ListCo
 		BlockHash:   common.Base58Encode(bcn.HeadHash()),
 		BlockNumber: bcn.Head.Number,
 	}
+	if len(keys) > int(limit) {
+		results.Next = strings.TrimPrefix(keys[limit], prefix)
+		keys = keys[:limit]
+	}
+	var schema []*contract.StorageItem
+	if req.Decode {
+		schema = storageSchema(database.NewVisitor(0, mvcc, bcn.Head.Rules()).Contract(req.Id))
+	}
 	for _, k := range keys {
 		value, err := mvcc.Get(database.StateTable, k)
 		if err != nil {
 			return nil, err
 		}
+		isMap := req.StorageType == rpcpb.ListContractStorageRequest_MAP
+		if req.Decode {
+			if isMap && strings.HasPrefix(value, database.MapKeysSeparator) {
+				continue
+			}
+			d := &rpcpb.ListContractStorageResponse_Data{Key: strings.TrimPrefix(k, prefix)}
+			if isMap {
+				d.Key, d.Field = splitMapKey(schema, d.Key)
+			}
+			d.Value, d.Type, d.Params, err = decodeStorageValue(schema, d.Key, d.Field, database.Unmarshal(value))
+			if err != nil {
+				return nil, err
+			}
+			results.Datas = append(results.Datas, d)
+			continue
+		}
 		var res interface{}
-		if (req.StorageType == rpcpb.ListContractStorageRequest_MAP) && strings.HasPrefix(value, database.MapKeysSeparator) {
+		if isMap && strings.HasPrefix(value, database.MapKeysSeparator) {
 			// map keys
 			res = strings.Split(value, database.MapKeysSeparator)[1:]
 		} else {
@@ -665,20 +835,67 @@ func (as *APIService) ListContractStorage(ctx context.Context, req *rpcpb.ListCo
 	return results, nil
 }
 
-func (as *APIService) tryTransaction(t *tx.Tx) (*tx.TxReceipt, error) {
+// splitMapKey splits the key of a map value into the key and field, by the first separator
+// giving a key and field matched by the schema.
+func splitMapKey(schema []*contract.StorageItem, k string) (string, string) {
+	first := strings.Index(k, database.Separator)
+	if first < 0 {
+		return k, ""
+	}
+	for i := first; i >= 0; {
+		if item, _ := contract.MatchStorage(schema, k[:i], k[i+1:]); item != nil {
+			return k[:i], k[i+1:]
+		}
+		next := strings.Index(k[i+1:], database.Separator)
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return k[:first], k[first+1:]
+}
+
+// decodeStorageValue returns the value as json decoded by the storage item matching the key and field.
+// The value is returned as is if no item matches or it is not of the type of the item.
+func decodeStorageValue(schema []*contract.StorageItem, key, field string, value interface{}) (data, typ string, params map[string]string, err error) {
+	if item, p := contract.MatchStorage(schema, key, field); item != nil {
+		if v, err := item.Decode(value); err == nil {
+			value, typ, params = v, item.Type, p
+		}
+	}
+	if b, ok := value.(database.SerializedJSON); ok && json.Valid(b) {
+		value = json.RawMessage(b)
+	}
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("cannot marshal %v", value)
+	}
+	return string(bytes), typ, params, nil
+}
+
+// pendingState returns the head of the block next to the head and the state to execute txs on.
+func (as *APIService) pendingState() (*block.BlockHead, db.MVCCDB, error) {
 	topBlock := as.bc.Head()
 	blkHead := &block.BlockHead{
 		Version:    block.V1,
 		ParentHash: topBlock.HeadHash(),
 		Number:     topBlock.Head.Number + 1,
-		Time:       time.Now().UnixNano(),
+		Time:       as.clock.Now().UnixNano(),
 	}
-	v := verifier.Executor{}
 	stateDB := as.stateDB.Fork()
 	ok := stateDB.Checkout(string(topBlock.HeadHash()))
 	if !ok {
-		return nil, fmt.Errorf("failed to checkout blockhash: %s", common.Base58Encode(topBlock.HeadHash()))
+		return nil, nil, fmt.Errorf("failed to checkout blockhash: %s", common.Base58Encode(topBlock.HeadHash()))
+	}
+	return blkHead, stateDB, nil
+}
+
+func (as *APIService) tryTransaction(t *tx.Tx) (*tx.TxReceipt, error) {
+	blkHead, stateDB, err := as.pendingState()
+	if err != nil {
+		return nil, err
 	}
+	v := verifier.Executor{}
 	return v.Try(blkHead, stateDB, t, cverifier.TxExecTimeLimit)
 }
 
@@ -714,10 +931,10 @@ func (as *APIService) SendTransaction(ctx context.Context, req *rpcpb.Transactio
 	if err != nil {
 		return nil, err
 	}
-	if t.Time < time.Now().UnixNano() {
+	if t.Time < as.clock.Now().UnixNano() {
 		as.p2pService.Broadcast(t.Encode(), p2p.PublishTx, p2p.NormalMessage)
 	} else {
-		waitTime := time.Until(time.Unix(0, t.Time))
+		waitTime := time.Duration(t.Time - as.clock.Now().UnixNano())
 		time.AfterFunc(waitTime, func() {
 			as.p2pService.Broadcast(t.Encode(), p2p.PublishTx, p2p.NormalMessage)
 		})
@@ -731,13 +948,100 @@ func (as *APIService) ExecTransaction(ctx context.Context, req *rpcpb.Transactio
 		return nil, errors.New("The node has't enabled this method")
 	}
 	t := toCoreTx(req)
-	receipt, err := as.tryTransaction(t)
+	blkHead, stateDB, err := as.pendingState()
+	if err != nil {
+		return nil, err
+	}
+	v := verifier.Executor{}
+	receipt, err := v.TryWithStateDiff(blkHead, stateDB, t, cverifier.TxExecTimeLimit)
 	if err != nil {
 		return nil, err
 	}
 	return toPbTxReceipt(receipt), nil
 }
 
+// TraceTransaction executes a transaction by the node without committing,
+// and returns the calls, storage accesses and logs recorded during the execution.
+func (as *APIService) TraceTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.TraceTransactionResponse, error) {
+	if !as.config.RPC.ExecTx {
+		return nil, errors.New("The node has't enabled this method")
+	}
+	t := toCoreTx(req)
+	blkHead, stateDB, err := as.pendingState()
+	if err != nil {
+		return nil, err
+	}
+	v := verifier.Executor{}
+	receipt, tracer, err := v.Trace(blkHead, stateDB, t, cverifier.TxExecTimeLimit)
+	if err != nil {
+		return nil, err
+	}
+	ret := &rpcpb.TraceTransactionResponse{
+		Receipt: toPbTxReceipt(receipt),
+	}
+	for _, f := range tracer.Frames {
+		ret.Frames = append(ret.Frames, toPbCallFrame(f))
+	}
+	return ret, nil
+}
+
+// Values of transaction estimation.
+const (
+	// estimateGasMargin is the percent of the gas used added to the suggested gas limit.
+	estimateGasMargin = 20
+	// gasRatioSampleBlocks is the number of recent blocks to recommend the gas ratio from.
+	gasRatioSampleBlocks = 10
+)
+
+// EstimateTransaction executes a transaction by the node without committing,
+// and returns the gas and ram it uses with the suggested gas limit and gas ratio.
+func (as *APIService) EstimateTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.EstimateTransactionResponse, error) {
+	if !as.config.RPC.ExecTx {
+		return nil, errors.New("The node has't enabled this method")
+	}
+	t := toCoreTx(req)
+	blkHead, stateDB, err := as.pendingState()
+	if err != nil {
+		return nil, err
+	}
+	v := verifier.Executor{}
+	receipt, gas, err := v.Estimate(blkHead, stateDB, t, cverifier.TxExecTimeLimit)
+	if err != nil {
+		return nil, err
+	}
+	ratio := as.recommendGasRatio()
+	limit := tx.ClampGasLimit(gas * (100 + estimateGasMargin) / 100 * ratio)
+	return &rpcpb.EstimateTransactionResponse{
+		Receipt:             toPbTxReceipt(receipt),
+		GasUsed:             float64(gas),
+		RamUsage:            receipt.RAMUsage,
+		SuggestedGasLimit:   float64(limit) / 100,
+		RecommendedGasRatio: float64(ratio) / 100,
+	}, nil
+}
+
+// recommendGasRatio returns the median gas ratio of the txs in recent blocks, which is at least 1.
+func (as *APIService) recommendGasRatio() int64 {
+	ratios := make([]int64, 0)
+	bcn := as.bc.Head()
+	for i := 0; i < gasRatioSampleBlocks && bcn != nil && bcn.Block != nil; i++ {
+		for _, t := range bcn.Block.Txs {
+			if t.Publisher != "base.iost" {
+				ratios = append(ratios, t.GasRatio)
+			}
+		}
+		bcn = bcn.GetParent()
+	}
+	if len(ratios) == 0 {
+		return 100
+	}
+	sort.Slice(ratios, func(i, j int) bool { return ratios[i] < ratios[j] })
+	if ratio := ratios[len(ratios)/2]; ratio > 100 {
+		return ratio
+	}
+	return 100
+}
+
 // Subscribe used for event.
 func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiService_SubscribeServer) error {
 	topics := make([]event.Topic, 0)
@@ -748,7 +1052,22 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 	if req.GetFilter() != nil {
 		filter = &event.Meta{
 			ContractID: req.GetFilter().GetContractId(),
+			ActionName: req.GetFilter().GetActionName(),
+			Recipient:  req.GetFilter().GetRecipient(),
+			Witness:    req.GetFilter().GetWitness(),
+			Publisher:  req.GetFilter().GetPublisher(),
 		}
+		for _, p := range req.GetFilter().GetPredicates() {
+			predicate, err := event.NewPredicate(p.GetPath(), event.PredicateOp(p.GetOp()), p.GetValue())
+			if err != nil {
+				return err
+			}
+			filter.Predicates = append(filter.Predicates, predicate)
+		}
+	}
+
+	if req.GetFromCursor() != nil {
+		return as.subscribeFromCursor(req, topics, filter, res)
 	}
 
 	ec := event.GetCollector()
@@ -780,6 +1099,66 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 	}
 }
 
+// subscribeFromCursor streams the stored events after the cursor, and waits for new ones when all are sent.
+func (as *APIService) subscribeFromCursor(req *rpcpb.SubscribeRequest, topics []event.Topic, filter *event.Meta, res rpcpb.ApiService_SubscribeServer) error {
+	if as.events == nil {
+		return errors.New("event store is disabled on this node")
+	}
+	topicSet := make(map[event.Topic]bool)
+	for _, t := range topics {
+		if !event.IsDurable(t) {
+			return fmt.Errorf("topic %v can't be subscribed from cursor", t)
+		}
+		topicSet[t] = true
+	}
+	cursor := &event.Cursor{
+		BlockNumber: req.GetFromCursor().GetBlockNumber(),
+		Index:       req.GetFromCursor().GetIndex(),
+	}
+
+	timeup := time.NewTimer(time.Hour)
+	defer timeup.Stop()
+	for {
+		// Get the channel before reading, so events appended during the reading are not missed.
+		wait := as.events.Wait()
+		evs, metas, err := as.events.Read(cursor, 100)
+		if err != nil {
+			return err
+		}
+		for i, ev := range evs {
+			cursor = ev.Cursor
+			if !topicSet[ev.Topic] || (filter != nil && !filter.Match(ev, metas[i])) {
+				continue
+			}
+			e := &rpcpb.Event{
+				Topic: rpcpb.Event_Topic(ev.Topic),
+				Data:  ev.Data,
+				Time:  ev.Time,
+				Cursor: &rpcpb.EventCursor{
+					BlockNumber: ev.Cursor.BlockNumber,
+					Index:       ev.Cursor.Index,
+				},
+			}
+			if err := res.Send(&rpcpb.SubscribeResponse{Event: e}); err != nil {
+				ilog.Errorf("stream send failed. err=%v", err)
+				return err
+			}
+		}
+		if len(evs) > 0 {
+			continue
+		}
+		select {
+		case <-timeup.C:
+			return nil
+		case <-as.quitCh:
+			return nil
+		case <-res.Context().Done():
+			return res.Context().Err()
+		case <-wait:
+		}
+	}
+}
+
 // GetVoterBonus returns the bonus a voter can claim.
 func (as *APIService) GetVoterBonus(ctx context.Context, req *rpcpb.GetAccountRequest) (*rpcpb.VoterBonus, error) {
 	err := checkIDValid(req.GetName())
@@ -789,7 +1168,7 @@ func (as *APIService) GetVoterBonus(ctx context.Context, req *rpcpb.GetAccountRe
 	ret := &rpcpb.VoterBonus{
 		Detail: make(map[string]float64),
 	}
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -856,7 +1235,7 @@ func (as *APIService) GetCandidateBonus(ctx context.Context, req *rpcpb.GetAccou
 		return nil, err
 	}
 	ret := &rpcpb.CandidateBonus{}
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -920,7 +1299,7 @@ func (as *APIService) GetCandidateBonus(ctx context.Context, req *rpcpb.GetAccou
 // GetTokenInfo returns the information of a given token.
 func (as *APIService) GetTokenInfo(ctx context.Context, req *rpcpb.GetTokenInfoRequest) (*rpcpb.TokenInfo, error) {
 	var token404 = errors.New("token not found")
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, bcn, err := as.getStateDBVisitorAt(req.ByLongestChain, req.BlockNumber, req.BlockHash)
 	if err != nil {
 		return nil, err
 	}
@@ -977,6 +1356,96 @@ func (as *APIService) GetTokenInfo(ctx context.Context, req *rpcpb.GetTokenInfoR
 	return ret, nil
 }
 
+// GetAccountTransactions returns the transactions published, signed or received by the account.
+func (as *APIService) GetAccountTransactions(ctx context.Context, req *rpcpb.GetAccountTransactionsRequest) (*rpcpb.GetAccountTransactionsResponse, error) {
+	if as.txIndex == nil {
+		return nil, errors.New("tx index is disabled on this node")
+	}
+	if req.GetAccount() == "" {
+		return nil, errors.New("account is required")
+	}
+	postings, next, err := as.txIndex.Transactions(req.GetAccount(), req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	ret := &rpcpb.GetAccountTransactionsResponse{
+		NextCursor: next,
+	}
+	for _, p := range postings {
+		ret.Transactions = append(ret.Transactions, &rpcpb.AccountTransaction{
+			Hash:        common.Base58Encode(p.TxHash),
+			BlockNumber: p.BlockNumber,
+			Index:       p.Index,
+			Publisher:   p.Role&txindex.RolePublisher != 0,
+			Signer:      p.Role&txindex.RoleSigner != 0,
+			Recipient:   p.Role&txindex.RoleRecipient != 0,
+		})
+	}
+	return ret, nil
+}
+
+// maxPendingListLimit is the max number of pending transactions returned by ListPendingTransactions.
+const maxPendingListLimit = 100
+
+// ListPendingTransactions lists the pending transactions in the tx pool.
+func (as *APIService) ListPendingTransactions(ctx context.Context, req *rpcpb.ListPendingTransactionsRequest) (*rpcpb.ListPendingTransactionsResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > maxPendingListLimit {
+		limit = maxPendingListLimit
+	}
+	if req.GetOffset() < 0 {
+		return nil, errors.New("offset should not be negative")
+	}
+	filter := func(t *tx.Tx) bool {
+		if req.GetPublisher() != "" && t.Publisher != req.GetPublisher() {
+			return false
+		}
+		if req.GetContract() == "" {
+			return true
+		}
+		for _, a := range t.Actions {
+			if a.Contract == req.GetContract() {
+				return true
+			}
+		}
+		return false
+	}
+	pending, _ := as.txpool.PendingTx()
+	txs, total := pending.List(filter, int(req.GetOffset()), limit)
+	ret := &rpcpb.ListPendingTransactionsResponse{
+		Total: int32(total),
+	}
+	for _, t := range txs {
+		ret.Transactions = append(ret.Transactions, toPbTx(t, nil))
+	}
+	return ret, nil
+}
+
+// GetTxPoolStats returns the statistics of the tx pool.
+func (as *APIService) GetTxPoolStats(context.Context, *rpcpb.EmptyRequest) (*rpcpb.TxPoolStatsResponse, error) {
+	stats := as.txpool.Stats()
+	ret := &rpcpb.TxPoolStatsResponse{
+		PendingCount:           int32(stats.Size),
+		PendingBytes:           int64(stats.Bytes),
+		PublisherCount:         int32(stats.Publishers),
+		DeferredCount:          int32(stats.DeferSize),
+		MaxPending:             int32(stats.MaxSize),
+		MaxPendingPerPublisher: int32(stats.MaxPerPublisher),
+	}
+	ratios := make([]int64, 0, len(stats.GasRatios))
+	for r := range stats.GasRatios {
+		ratios = append(ratios, r)
+	}
+	sort.Slice(ratios, func(i, j int) bool { return ratios[i] < ratios[j] })
+	for _, r := range ratios {
+		ret.GasRatios = append(ret.GasRatios, &rpcpb.TxPoolStatsResponse_GasRatioCount{
+			GasRatio: float64(r) / 100,
+			Count:    int32(stats.GasRatios[r]),
+		})
+	}
+	return ret, nil
+}
+
 func (as *APIService) getStateDBByBlock(bcn *blockcache.BlockCacheNode) (db db.MVCCDB, err error) {
 	db = as.stateDB.Fork()
 	ok := db.Checkout(string(bcn.HeadHash()))
@@ -1018,13 +1487,70 @@ func (as *APIService) getStateDBVisitor(longestChain bool) (*database.Visitor, *
 	return nil, nil, err
 }
 
-func (as *APIService) getUnfrozenToken(frozens []database.FreezeItemFixed, longestChain bool) (float64, []*rpcpb.FrozenBalance) {
-	var blockTime int64
-	if longestChain {
-		blockTime = as.bc.Head().Head.Time
+// getStateDBAt returns the state of the block with blockHash or blockNumber if any of them is given,
+// otherwise the state of the head block or the last irreversible block.
+func (as *APIService) getStateDBAt(longestChain bool, blockNumber int64, blockHash string) (db.MVCCDB, *blockcache.BlockCacheNode, error) {
+	if blockNumber == 0 && blockHash == "" {
+		b := as.bc.LinkedRoot()
+		if longestChain {
+			b = as.bc.Head()
+		}
+		stateDB, err := as.getStateDBByBlock(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		return stateDB, b, nil
+	}
+
+	var (
+		blk *block.Block
+		err error
+	)
+	if blockHash != "" {
+		if err := checkHashValid(blockHash); err != nil {
+			return nil, nil, err
+		}
+		hash := common.Base58Decode(blockHash)
+		blk, err = as.bc.GetBlockByHash(hash)
+		if err != nil {
+			blk, err = as.blockchain.GetBlockByHash(hash)
+		}
 	} else {
-		blockTime = as.bc.LinkedRoot().Head.Time
+		blk, err = as.bc.GetBlockByNumber(blockNumber)
+		if err != nil {
+			blk, err = as.blockchain.GetBlockByNumber(blockNumber)
+		}
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("block not found: %v", err)
+	}
+	b := blockcache.NewBCN(nil, blk)
+	stateDB, err := as.getStateDBByBlock(b)
+	if err == nil {
+		return stateDB, b, nil
+	}
+	if as.archive == nil {
+		return nil, nil, errors.New("state of irreversible blocks is only available in archive mode")
+	}
+	stateDB, err = as.archive.Checkout(string(blk.HeadHash()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("state of block %v is not archived: %v", blk.Head.Number, err)
 	}
+	return stateDB, b, nil
+}
+
+func (as *APIService) getStateDBVisitorAt(longestChain bool, blockNumber int64, blockHash string) (*database.Visitor, *blockcache.BlockCacheNode, error) {
+	if blockNumber == 0 && blockHash == "" {
+		return as.getStateDBVisitor(longestChain)
+	}
+	stateDB, b, err := as.getStateDBAt(longestChain, blockNumber, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return database.NewVisitor(0, stateDB, b.Head.Rules()), b, nil
+}
+
+func (as *APIService) getUnfrozenToken(frozens []database.FreezeItemFixed, blockTime int64) (float64, []*rpcpb.FrozenBalance) {
 	var unfrozen float64
 	var stillFrozen []*rpcpb.FrozenBalance
 	for _, f := range frozens {
@@ -8,6 +8,8 @@ import (
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/crypto"
 	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/vm/host"
+	"github.com/iost-official/go-iost/v3/vm/native"
 )
 
 func toPbAction(a *tx.Action) *rpcpb.Action {
@@ -36,6 +38,48 @@ func toPbTxReceipt(tr *tx.TxReceipt) *rpcpb.TxReceipt {
 			Content:  r.Content,
 		})
 	}
+	for _, c := range tr.StateDiff {
+		ret.StateDiff = append(ret.StateDiff, &rpcpb.TxReceipt_StateChange{
+			Contract: c.Contract,
+			Key:      c.Key,
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return ret
+}
+
+func toPbCallFrame(f *host.CallFrame) *rpcpb.CallFrame {
+	ret := &rpcpb.CallFrame{
+		Contract: f.Contract,
+		Abi:      f.ABI,
+		Args:     f.Args,
+		Caller:   f.Caller,
+		Depth:    int32(f.Depth),
+		GasUsed:  float64(f.GasUsed),
+		Returns:  f.Returns,
+		Error:    f.Error,
+		Stack:    f.Stack,
+	}
+	for _, s := range f.Storage {
+		ret.Storage = append(ret.Storage, &rpcpb.StorageAccess{
+			Op:       s.Op,
+			Key:      s.Key,
+			Field:    s.Field,
+			OldValue: s.OldValue,
+			NewValue: s.NewValue,
+		})
+	}
+	for _, l := range f.Logs {
+		ret.Logs = append(ret.Logs, &rpcpb.ContractLog{
+			Level:   l.Level,
+			Message: l.Message,
+		})
+	}
+	for _, c := range f.Calls {
+		ret.Calls = append(ret.Calls, toPbCallFrame(c))
+	}
 	return ret
 }
 
@@ -82,6 +126,9 @@ func toPbBlock(blk *block.Block, complete bool) *rpcpb.Block {
 		GasUsage:            float64(blk.Head.GasUsage) / 100,
 		TxCount:             int64(len(blk.Txs)),
 	}
+	if blk.Head.Version >= block.V2 {
+		ret.StateRoot = common.Base58Encode(blk.Head.StateRoot)
+	}
 	if complete {
 		for i, t := range blk.Txs {
 			ret.Transactions = append(ret.Transactions, toPbTx(t, blk.Receipts[i]))
@@ -152,9 +199,28 @@ func toPbContract(c *contract.Contract) *rpcpb.Contract {
 		}
 		ret.Abis = append(ret.Abis, pbABI)
 	}
+	for _, item := range storageSchema(c) {
+		ret.Storage = append(ret.Storage, &rpcpb.Contract_StorageItem{
+			Key:         item.Key,
+			Field:       item.Field,
+			Type:        item.Type,
+			Description: item.Description,
+		})
+	}
 	return ret
 }
 
+// storageSchema returns the storage schema declared by the abi, or built in for native contracts.
+func storageSchema(c *contract.Contract) []*contract.StorageItem {
+	if c == nil {
+		return nil
+	}
+	if c.Info.Lang == "native" {
+		return native.StorageSchema(c.ID)
+	}
+	return c.Info.Storage
+}
+
 func toCoreTx(t *rpcpb.TransactionRequest) *tx.Tx {
 	ret := &tx.Tx{
 		Time:       t.Time,
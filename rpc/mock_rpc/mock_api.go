@@ -34,6 +34,19 @@ func (m *MockApiServiceServer) EXPECT() *MockApiServiceServerMockRecorder {
 	return m.recorder
 }
 
+// EstimateTransaction mocks base method
+func (m *MockApiServiceServer) EstimateTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.EstimateTransactionResponse, error) {
+	ret := m.ctrl.Call(m, "EstimateTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*pb.EstimateTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateTransaction indicates an expected call of EstimateTransaction
+func (mr *MockApiServiceServerMockRecorder) EstimateTransaction(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateTransaction", reflect.TypeOf((*MockApiServiceServer)(nil).EstimateTransaction), arg0, arg1)
+}
+
 // ExecTransaction mocks base method
 func (m *MockApiServiceServer) ExecTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.TxReceipt, error) {
 	ret := m.ctrl.Call(m, "ExecTransaction", arg0, arg1)
@@ -60,6 +73,19 @@ func (mr *MockApiServiceServerMockRecorder) GetAccount(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccount), arg0, arg1)
 }
 
+// GetAccountTransactions mocks base method
+func (m *MockApiServiceServer) GetAccountTransactions(arg0 context.Context, arg1 *pb.GetAccountTransactionsRequest) (*pb.GetAccountTransactionsResponse, error) {
+	ret := m.ctrl.Call(m, "GetAccountTransactions", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetAccountTransactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTransactions indicates an expected call of GetAccountTransactions
+func (mr *MockApiServiceServerMockRecorder) GetAccountTransactions(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTransactions", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountTransactions), arg0, arg1)
+}
+
 // GetBatchContractStorage mocks base method
 func (m *MockApiServiceServer) GetBatchContractStorage(arg0 context.Context, arg1 *pb.GetBatchContractStorageRequest) (*pb.GetBatchContractStorageResponse, error) {
 	ret := m.ctrl.Call(m, "GetBatchContractStorage", arg0, arg1)
@@ -255,6 +281,32 @@ func (mr *MockApiServiceServerMockRecorder) GetRawBlockByNumber(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRawBlockByNumber", reflect.TypeOf((*MockApiServiceServer)(nil).GetRawBlockByNumber), arg0, arg1)
 }
 
+// GetReceiptInclusionProof mocks base method
+func (m *MockApiServiceServer) GetReceiptInclusionProof(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.ReceiptInclusionProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetReceiptInclusionProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ReceiptInclusionProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiptInclusionProof indicates an expected call of GetReceiptInclusionProof
+func (mr *MockApiServiceServerMockRecorder) GetReceiptInclusionProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptInclusionProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetReceiptInclusionProof), arg0, arg1)
+}
+
+// GetStateProof mocks base method
+func (m *MockApiServiceServer) GetStateProof(arg0 context.Context, arg1 *pb.GetStateProofRequest) (*pb.GetStateProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetStateProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetStateProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateProof indicates an expected call of GetStateProof
+func (mr *MockApiServiceServerMockRecorder) GetStateProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetStateProof), arg0, arg1)
+}
+
 // GetToken721Balance mocks base method
 func (m *MockApiServiceServer) GetToken721Balance(arg0 context.Context, arg1 *pb.GetTokenBalanceRequest) (*pb.GetToken721BalanceResponse, error) {
 	ret := m.ctrl.Call(m, "GetToken721Balance", arg0, arg1)
@@ -333,6 +385,19 @@ func (mr *MockApiServiceServerMockRecorder) GetTxByHash(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxByHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxByHash), arg0, arg1)
 }
 
+// GetTxInclusionProof mocks base method
+func (m *MockApiServiceServer) GetTxInclusionProof(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TxInclusionProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxInclusionProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TxInclusionProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxInclusionProof indicates an expected call of GetTxInclusionProof
+func (mr *MockApiServiceServerMockRecorder) GetTxInclusionProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxInclusionProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxInclusionProof), arg0, arg1)
+}
+
 // GetTxReceiptByTxHash mocks base method
 func (m *MockApiServiceServer) GetTxReceiptByTxHash(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TxReceipt, error) {
 	ret := m.ctrl.Call(m, "GetTxReceiptByTxHash", arg0, arg1)
@@ -346,6 +411,19 @@ func (mr *MockApiServiceServerMockRecorder) GetTxReceiptByTxHash(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceiptByTxHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxReceiptByTxHash), arg0, arg1)
 }
 
+// GetTxPoolStats mocks base method
+func (m *MockApiServiceServer) GetTxPoolStats(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.TxPoolStatsResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxPoolStats", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TxPoolStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxPoolStats indicates an expected call of GetTxPoolStats
+func (mr *MockApiServiceServerMockRecorder) GetTxPoolStats(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxPoolStats", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxPoolStats), arg0, arg1)
+}
+
 // GetVoterBonus mocks base method
 func (m *MockApiServiceServer) GetVoterBonus(arg0 context.Context, arg1 *pb.GetAccountRequest) (*pb.VoterBonus, error) {
 	ret := m.ctrl.Call(m, "GetVoterBonus", arg0, arg1)
@@ -372,6 +450,19 @@ func (mr *MockApiServiceServerMockRecorder) ListContractStorage(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContractStorage", reflect.TypeOf((*MockApiServiceServer)(nil).ListContractStorage), arg0, arg1)
 }
 
+// ListPendingTransactions mocks base method
+func (m *MockApiServiceServer) ListPendingTransactions(arg0 context.Context, arg1 *pb.ListPendingTransactionsRequest) (*pb.ListPendingTransactionsResponse, error) {
+	ret := m.ctrl.Call(m, "ListPendingTransactions", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ListPendingTransactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingTransactions indicates an expected call of ListPendingTransactions
+func (mr *MockApiServiceServerMockRecorder) ListPendingTransactions(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTransactions", reflect.TypeOf((*MockApiServiceServer)(nil).ListPendingTransactions), arg0, arg1)
+}
+
 // SendTransaction mocks base method
 func (m *MockApiServiceServer) SendTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.SendTransactionResponse, error) {
 	ret := m.ctrl.Call(m, "SendTransaction", arg0, arg1)
@@ -396,3 +487,16 @@ func (m *MockApiServiceServer) Subscribe(arg0 *pb.SubscribeRequest, arg1 pb.ApiS
 func (mr *MockApiServiceServerMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockApiServiceServer)(nil).Subscribe), arg0, arg1)
 }
+
+// TraceTransaction mocks base method
+func (m *MockApiServiceServer) TraceTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.TraceTransactionResponse, error) {
+	ret := m.ctrl.Call(m, "TraceTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TraceTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceTransaction indicates an expected call of TraceTransaction
+func (mr *MockApiServiceServerMockRecorder) TraceTransaction(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceTransaction", reflect.TypeOf((*MockApiServiceServer)(nil).TraceTransaction), arg0, arg1)
+}
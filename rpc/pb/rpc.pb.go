@@ -8,6 +8,8 @@ package rpcpb
 
 import (
 	pb "github.com/iost-official/go-iost/v3/core/block/pb"
+	pb2 "github.com/iost-official/go-iost/v3/core/tx/pb"
+	pb1 "github.com/iost-official/go-iost/v3/crypto/pb"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -162,6 +164,10 @@ const (
 	Signature_SECP256K1 Signature_Algorithm = 1
 	// ed25519
 	Signature_ED25519 Signature_Algorithm = 2
+	// secp256r1 (NIST P-256)
+	Signature_SECP256R1 Signature_Algorithm = 3
+	// bls12-381
+	Signature_BLS Signature_Algorithm = 4
 )
 
 // Enum value maps for Signature_Algorithm.
@@ -170,11 +176,15 @@ var (
 		0: "UNKNOWN",
 		1: "SECP256K1",
 		2: "ED25519",
+		3: "SECP256R1",
+		4: "BLS",
 	}
 	Signature_Algorithm_value = map[string]int32{
 		"UNKNOWN":   0,
 		"SECP256K1": 1,
 		"ED25519":   2,
+		"SECP256R1": 3,
+		"BLS":       4,
 	}
 )
 
@@ -348,7 +358,7 @@ func (x ListContractStorageRequest_StorageType) Number() protoreflect.EnumNumber
 
 // Deprecated: Use ListContractStorageRequest_StorageType.Descriptor instead.
 func (ListContractStorageRequest_StorageType) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{36, 0}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{40, 0}
 }
 
 type Event_Topic int32
@@ -358,6 +368,14 @@ const (
 	Event_CONTRACT_RECEIPT Event_Topic = 0
 	// contract event
 	Event_CONTRACT_EVENT Event_Topic = 1
+	// block linked to the block cache
+	Event_NEW_BLOCK Event_Topic = 2
+	// block becomes irreversible
+	Event_IRREVERSIBLE_BLOCK Event_Topic = 3
+	// head switched to another branch, with the abandoned and adopted blocks
+	Event_CHAIN_REORG Event_Topic = 4
+	// tx removed from the pending pool without being packed
+	Event_TX_DROPPED Event_Topic = 5
 )
 
 // Enum value maps for Event_Topic.
@@ -365,10 +383,18 @@ var (
 	Event_Topic_name = map[int32]string{
 		0: "CONTRACT_RECEIPT",
 		1: "CONTRACT_EVENT",
+		2: "NEW_BLOCK",
+		3: "IRREVERSIBLE_BLOCK",
+		4: "CHAIN_REORG",
+		5: "TX_DROPPED",
 	}
 	Event_Topic_value = map[string]int32{
-		"CONTRACT_RECEIPT": 0,
-		"CONTRACT_EVENT":   1,
+		"CONTRACT_RECEIPT":   0,
+		"CONTRACT_EVENT":     1,
+		"NEW_BLOCK":          2,
+		"IRREVERSIBLE_BLOCK": 3,
+		"CHAIN_REORG":        4,
+		"TX_DROPPED":         5,
 	}
 )
 
@@ -396,7 +422,70 @@ func (x Event_Topic) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Event_Topic.Descriptor instead.
 func (Event_Topic) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{45, 0}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{49, 0}
+}
+
+// The enumeration defines the comparison operators.
+type EventPredicate_Op int32
+
+const (
+	EventPredicate_EQ  EventPredicate_Op = 0
+	EventPredicate_NE  EventPredicate_Op = 1
+	EventPredicate_GT  EventPredicate_Op = 2
+	EventPredicate_GTE EventPredicate_Op = 3
+	EventPredicate_LT  EventPredicate_Op = 4
+	EventPredicate_LTE EventPredicate_Op = 5
+	// the path exists, value is ignored
+	EventPredicate_EXISTS EventPredicate_Op = 6
+)
+
+// Enum value maps for EventPredicate_Op.
+var (
+	EventPredicate_Op_name = map[int32]string{
+		0: "EQ",
+		1: "NE",
+		2: "GT",
+		3: "GTE",
+		4: "LT",
+		5: "LTE",
+		6: "EXISTS",
+	}
+	EventPredicate_Op_value = map[string]int32{
+		"EQ":     0,
+		"NE":     1,
+		"GT":     2,
+		"GTE":    3,
+		"LT":     4,
+		"LTE":    5,
+		"EXISTS": 6,
+	}
+)
+
+func (x EventPredicate_Op) Enum() *EventPredicate_Op {
+	p := new(EventPredicate_Op)
+	*p = x
+	return p
+}
+
+func (x EventPredicate_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventPredicate_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_pb_rpc_proto_enumTypes[7].Descriptor()
+}
+
+func (EventPredicate_Op) Type() protoreflect.EnumType {
+	return &file_rpc_pb_rpc_proto_enumTypes[7]
+}
+
+func (x EventPredicate_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventPredicate_Op.Descriptor instead.
+func (EventPredicate_Op) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{52, 0}
 }
 
 // The message defines an empty request.
@@ -820,6 +909,8 @@ type TxReceipt struct {
 	Returns []string `protobuf:"bytes,6,rep,name=returns,proto3" json:"returns,omitempty"`
 	// transaction receipts
 	Receipts []*TxReceipt_Receipt `protobuf:"bytes,7,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	// contract storage changes, only returned if the node records them
+	StateDiff []*TxReceipt_StateChange `protobuf:"bytes,8,rep,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
 }
 
 func (x *TxReceipt) Reset() {
@@ -903,6 +994,13 @@ func (x *TxReceipt) GetReceipts() []*TxReceipt_Receipt {
 	return nil
 }
 
+func (x *TxReceipt) GetStateDiff() []*TxReceipt_StateChange {
+	if x != nil {
+		return x.StateDiff
+	}
+	return nil
+}
+
 // The message defines transaction struct.
 type Transaction struct {
 	state         protoimpl.MessageState
@@ -1374,6 +1472,8 @@ type Block struct {
 	OrigInfo string `protobuf:"bytes,13,opt,name=orig_info,json=origInfo,proto3" json:"orig_info,omitempty"`
 	// block transactions
 	Transactions []*Transaction `protobuf:"bytes,12,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// state merkle tree root hash, empty before version 2
+	StateRoot string `protobuf:"bytes,14,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
 }
 
 func (x *Block) Reset() {
@@ -1499,6 +1599,13 @@ func (x *Block) GetTransactions() []*Transaction {
 	return nil
 }
 
+func (x *Block) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
 type BlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1857,6 +1964,176 @@ func (x *TxHashRequest) GetHash() string {
 	return ""
 }
 
+// The message defines the inclusion proof of a transaction.
+type TxInclusionProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// head of the block including the transaction
+	BlockHead *pb.BlockHead `protobuf:"bytes,1,opt,name=block_head,json=blockHead,proto3" json:"block_head,omitempty"`
+	// signature of the block head by the witness
+	BlockSign *pb1.Signature `protobuf:"bytes,2,opt,name=block_sign,json=blockSign,proto3" json:"block_sign,omitempty"`
+	// the transaction
+	Transaction *pb2.Tx `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// index of the transaction in the block
+	Index int32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// hashes along the merkle path from the transaction hash to the tx merkle hash
+	MerklePath []string `protobuf:"bytes,5,rep,name=merkle_path,json=merklePath,proto3" json:"merkle_path,omitempty"`
+}
+
+func (x *TxInclusionProofResponse) Reset() {
+	*x = TxInclusionProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxInclusionProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxInclusionProofResponse) ProtoMessage() {}
+
+func (x *TxInclusionProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxInclusionProofResponse.ProtoReflect.Descriptor instead.
+func (*TxInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TxInclusionProofResponse) GetBlockHead() *pb.BlockHead {
+	if x != nil {
+		return x.BlockHead
+	}
+	return nil
+}
+
+func (x *TxInclusionProofResponse) GetBlockSign() *pb1.Signature {
+	if x != nil {
+		return x.BlockSign
+	}
+	return nil
+}
+
+func (x *TxInclusionProofResponse) GetTransaction() *pb2.Tx {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TxInclusionProofResponse) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TxInclusionProofResponse) GetMerklePath() []string {
+	if x != nil {
+		return x.MerklePath
+	}
+	return nil
+}
+
+// The message defines the inclusion proof of a transaction receipt.
+type ReceiptInclusionProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// head of the block including the receipt
+	BlockHead *pb.BlockHead `protobuf:"bytes,1,opt,name=block_head,json=blockHead,proto3" json:"block_head,omitempty"`
+	// signature of the block head by the witness
+	BlockSign *pb1.Signature `protobuf:"bytes,2,opt,name=block_sign,json=blockSign,proto3" json:"block_sign,omitempty"`
+	// the transaction receipt
+	Receipt *pb2.TxReceipt `protobuf:"bytes,3,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// index of the receipt in the block
+	Index int32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// hashes along the merkle path from the receipt hash to the tx receipt merkle hash
+	MerklePath []string `protobuf:"bytes,5,rep,name=merkle_path,json=merklePath,proto3" json:"merkle_path,omitempty"`
+}
+
+func (x *ReceiptInclusionProofResponse) Reset() {
+	*x = ReceiptInclusionProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptInclusionProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptInclusionProofResponse) ProtoMessage() {}
+
+func (x *ReceiptInclusionProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptInclusionProofResponse.ProtoReflect.Descriptor instead.
+func (*ReceiptInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReceiptInclusionProofResponse) GetBlockHead() *pb.BlockHead {
+	if x != nil {
+		return x.BlockHead
+	}
+	return nil
+}
+
+func (x *ReceiptInclusionProofResponse) GetBlockSign() *pb1.Signature {
+	if x != nil {
+		return x.BlockSign
+	}
+	return nil
+}
+
+func (x *ReceiptInclusionProofResponse) GetReceipt() *pb2.TxReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *ReceiptInclusionProofResponse) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ReceiptInclusionProofResponse) GetMerklePath() []string {
+	if x != nil {
+		return x.MerklePath
+	}
+	return nil
+}
+
 // The request message containing the block's hash.
 type GetBlockByHashRequest struct {
 	state         protoimpl.MessageState
@@ -1872,7 +2149,7 @@ type GetBlockByHashRequest struct {
 func (x *GetBlockByHashRequest) Reset() {
 	*x = GetBlockByHashRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[17]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1885,7 +2162,7 @@ func (x *GetBlockByHashRequest) String() string {
 func (*GetBlockByHashRequest) ProtoMessage() {}
 
 func (x *GetBlockByHashRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[17]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1898,7 +2175,7 @@ func (x *GetBlockByHashRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockByHashRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{17}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetBlockByHashRequest) GetHash() string {
@@ -1930,7 +2207,7 @@ type GetBlockByNumberRequest struct {
 func (x *GetBlockByNumberRequest) Reset() {
 	*x = GetBlockByNumberRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[18]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1943,7 +2220,7 @@ func (x *GetBlockByNumberRequest) String() string {
 func (*GetBlockByNumberRequest) ProtoMessage() {}
 
 func (x *GetBlockByNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[18]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,7 +2233,7 @@ func (x *GetBlockByNumberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockByNumberRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockByNumberRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{18}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetBlockByNumberRequest) GetNumber() int64 {
@@ -1986,7 +2263,7 @@ type GetBlockHeaderByRangeRequest struct {
 func (x *GetBlockHeaderByRangeRequest) Reset() {
 	*x = GetBlockHeaderByRangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[19]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1999,7 +2276,7 @@ func (x *GetBlockHeaderByRangeRequest) String() string {
 func (*GetBlockHeaderByRangeRequest) ProtoMessage() {}
 
 func (x *GetBlockHeaderByRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[19]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,7 +2289,7 @@ func (x *GetBlockHeaderByRangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeaderByRangeRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeaderByRangeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{19}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetBlockHeaderByRangeRequest) GetStart() int64 {
@@ -2044,7 +2321,7 @@ type FrozenBalance struct {
 func (x *FrozenBalance) Reset() {
 	*x = FrozenBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[20]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2057,7 +2334,7 @@ func (x *FrozenBalance) String() string {
 func (*FrozenBalance) ProtoMessage() {}
 
 func (x *FrozenBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[20]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2070,7 +2347,7 @@ func (x *FrozenBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrozenBalance.ProtoReflect.Descriptor instead.
 func (*FrozenBalance) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{20}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *FrozenBalance) GetAmount() float64 {
@@ -2104,7 +2381,7 @@ type VoteInfo struct {
 func (x *VoteInfo) Reset() {
 	*x = VoteInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[21]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2117,7 +2394,7 @@ func (x *VoteInfo) String() string {
 func (*VoteInfo) ProtoMessage() {}
 
 func (x *VoteInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[21]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2130,7 +2407,7 @@ func (x *VoteInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoteInfo.ProtoReflect.Descriptor instead.
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{21}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *VoteInfo) GetOption() string {
@@ -2163,12 +2440,16 @@ type GetProducerVoteInfoRequest struct {
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	// get data by longest chain's head block or last irreversible block
 	ByLongestChain bool `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (x *GetProducerVoteInfoRequest) Reset() {
 	*x = GetProducerVoteInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[22]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2181,7 +2462,7 @@ func (x *GetProducerVoteInfoRequest) String() string {
 func (*GetProducerVoteInfoRequest) ProtoMessage() {}
 
 func (x *GetProducerVoteInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[22]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2194,7 +2475,7 @@ func (x *GetProducerVoteInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProducerVoteInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetProducerVoteInfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{22}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetProducerVoteInfoRequest) GetAccount() string {
@@ -2211,6 +2492,20 @@ func (x *GetProducerVoteInfoRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (x *GetProducerVoteInfoRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetProducerVoteInfoRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 type GetProducerVoteInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2237,7 +2532,7 @@ type GetProducerVoteInfoResponse struct {
 func (x *GetProducerVoteInfoResponse) Reset() {
 	*x = GetProducerVoteInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[23]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2250,7 +2545,7 @@ func (x *GetProducerVoteInfoResponse) String() string {
 func (*GetProducerVoteInfoResponse) ProtoMessage() {}
 
 func (x *GetProducerVoteInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[23]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2263,7 +2558,7 @@ func (x *GetProducerVoteInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProducerVoteInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetProducerVoteInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{23}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetProducerVoteInfoResponse) GetPubkey() string {
@@ -2336,7 +2631,7 @@ type GasRatioResponse struct {
 func (x *GasRatioResponse) Reset() {
 	*x = GasRatioResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[24]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2349,7 +2644,7 @@ func (x *GasRatioResponse) String() string {
 func (*GasRatioResponse) ProtoMessage() {}
 
 func (x *GasRatioResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[24]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2362,7 +2657,7 @@ func (x *GasRatioResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GasRatioResponse.ProtoReflect.Descriptor instead.
 func (*GasRatioResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{24}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GasRatioResponse) GetLowestGasRatio() float64 {
@@ -2406,7 +2701,7 @@ type Account struct {
 func (x *Account) Reset() {
 	*x = Account{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[25]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2419,7 +2714,7 @@ func (x *Account) String() string {
 func (*Account) ProtoMessage() {}
 
 func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[25]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2432,7 +2727,7 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Account.ProtoReflect.Descriptor instead.
 func (*Account) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{25}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Account) GetName() string {
@@ -2501,12 +2796,16 @@ type GetAccountRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// get account by longest chain's head block or last irreversible block
 	ByLongestChain bool `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (x *GetAccountRequest) Reset() {
 	*x = GetAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[26]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2519,7 +2818,7 @@ func (x *GetAccountRequest) String() string {
 func (*GetAccountRequest) ProtoMessage() {}
 
 func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[26]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,7 +2831,7 @@ func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
 func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{26}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetAccountRequest) GetName() string {
@@ -2549,6 +2848,20 @@ func (x *GetAccountRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (x *GetAccountRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetAccountRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 // The message defines the contract struct.
 type Contract struct {
 	state         protoimpl.MessageState
@@ -2567,12 +2880,14 @@ type Contract struct {
 	OrigCode string `protobuf:"bytes,6,opt,name=orig_code,json=origCode,proto3" json:"orig_code,omitempty"`
 	// contract abis
 	Abis []*Contract_ABI `protobuf:"bytes,5,rep,name=abis,proto3" json:"abis,omitempty"`
+	// storage schema, declared by the abi or built in for native contracts
+	Storage []*Contract_StorageItem `protobuf:"bytes,7,rep,name=storage,proto3" json:"storage,omitempty"`
 }
 
 func (x *Contract) Reset() {
 	*x = Contract{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[27]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2585,7 +2900,7 @@ func (x *Contract) String() string {
 func (*Contract) ProtoMessage() {}
 
 func (x *Contract) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[27]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2598,7 +2913,7 @@ func (x *Contract) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Contract.ProtoReflect.Descriptor instead.
 func (*Contract) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{27}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Contract) GetId() string {
@@ -2643,6 +2958,13 @@ func (x *Contract) GetAbis() []*Contract_ABI {
 	return nil
 }
 
+func (x *Contract) GetStorage() []*Contract_StorageItem {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
 // The message defines the contract vote info
 type ContractVote struct {
 	state         protoimpl.MessageState
@@ -2656,7 +2978,7 @@ type ContractVote struct {
 func (x *ContractVote) Reset() {
 	*x = ContractVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[28]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2669,7 +2991,7 @@ func (x *ContractVote) String() string {
 func (*ContractVote) ProtoMessage() {}
 
 func (x *ContractVote) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[28]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2682,7 +3004,7 @@ func (x *ContractVote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContractVote.ProtoReflect.Descriptor instead.
 func (*ContractVote) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{28}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ContractVote) GetVoteInfos() []*VoteInfo {
@@ -2702,12 +3024,16 @@ type GetContractRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// get data by longest chain's head block or last irreversible block
 	ByLongestChain bool `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (x *GetContractRequest) Reset() {
 	*x = GetContractRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[29]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2720,7 +3046,7 @@ func (x *GetContractRequest) String() string {
 func (*GetContractRequest) ProtoMessage() {}
 
 func (x *GetContractRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[29]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2733,7 +3059,7 @@ func (x *GetContractRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetContractRequest.ProtoReflect.Descriptor instead.
 func (*GetContractRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{29}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetContractRequest) GetId() string {
@@ -2750,6 +3076,20 @@ func (x *GetContractRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (x *GetContractRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetContractRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 // The message defines get contract storage request.
 type GetContractStorageRequest struct {
 	state         protoimpl.MessageState
@@ -2764,12 +3104,18 @@ type GetContractStorageRequest struct {
 	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
 	// get data by longest chain's head block or last irreversible block
 	ByLongestChain bool `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// decode the value by the storage schema of the contract, data is always json then
+	Decode bool `protobuf:"varint,7,opt,name=decode,proto3" json:"decode,omitempty"`
 }
 
 func (x *GetContractStorageRequest) Reset() {
 	*x = GetContractStorageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[30]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2782,7 +3128,7 @@ func (x *GetContractStorageRequest) String() string {
 func (*GetContractStorageRequest) ProtoMessage() {}
 
 func (x *GetContractStorageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[30]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2795,7 +3141,7 @@ func (x *GetContractStorageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetContractStorageRequest.ProtoReflect.Descriptor instead.
 func (*GetContractStorageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{30}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetContractStorageRequest) GetId() string {
@@ -2826,6 +3172,27 @@ func (x *GetContractStorageRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (x *GetContractStorageRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetContractStorageRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetContractStorageRequest) GetDecode() bool {
+	if x != nil {
+		return x.Decode
+	}
+	return false
+}
+
 // The message defines get contract storage response.
 type GetContractStorageResponse struct {
 	state         protoimpl.MessageState
@@ -2838,12 +3205,14 @@ type GetContractStorageResponse struct {
 	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	// block number
 	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// type of the value in the storage schema, empty if not decoded
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
 }
 
 func (x *GetContractStorageResponse) Reset() {
 	*x = GetContractStorageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[31]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2856,7 +3225,7 @@ func (x *GetContractStorageResponse) String() string {
 func (*GetContractStorageResponse) ProtoMessage() {}
 
 func (x *GetContractStorageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[31]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2869,7 +3238,7 @@ func (x *GetContractStorageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetContractStorageResponse.ProtoReflect.Descriptor instead.
 func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{31}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetContractStorageResponse) GetData() string {
@@ -2893,6 +3262,13 @@ func (x *GetContractStorageResponse) GetBlockNumber() int64 {
 	return 0
 }
 
+func (x *GetContractStorageResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
 // The message defines get batch contract storage request.
 type GetBatchContractStorageRequest struct {
 	state         protoimpl.MessageState
@@ -2905,12 +3281,16 @@ type GetBatchContractStorageRequest struct {
 	KeyFields []*GetBatchContractStorageRequest_KeyField `protobuf:"bytes,2,rep,name=key_fields,json=keyFields,proto3" json:"key_fields,omitempty"`
 	// get data by longest chain's head block or last irreversible block
 	ByLongestChain bool `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (x *GetBatchContractStorageRequest) Reset() {
 	*x = GetBatchContractStorageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[32]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2923,7 +3303,7 @@ func (x *GetBatchContractStorageRequest) String() string {
 func (*GetBatchContractStorageRequest) ProtoMessage() {}
 
 func (x *GetBatchContractStorageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[32]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2936,7 +3316,7 @@ func (x *GetBatchContractStorageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBatchContractStorageRequest.ProtoReflect.Descriptor instead.
 func (*GetBatchContractStorageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{32}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetBatchContractStorageRequest) GetId() string {
@@ -2960,6 +3340,20 @@ func (x *GetBatchContractStorageRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (x *GetBatchContractStorageRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetBatchContractStorageRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 // The message defines get batch contract storage response.
 type GetBatchContractStorageResponse struct {
 	state         protoimpl.MessageState
@@ -2977,7 +3371,7 @@ type GetBatchContractStorageResponse struct {
 func (x *GetBatchContractStorageResponse) Reset() {
 	*x = GetBatchContractStorageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[33]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2990,7 +3384,7 @@ func (x *GetBatchContractStorageResponse) String() string {
 func (*GetBatchContractStorageResponse) ProtoMessage() {}
 
 func (x *GetBatchContractStorageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[33]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3003,7 +3397,7 @@ func (x *GetBatchContractStorageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBatchContractStorageResponse.ProtoReflect.Descriptor instead.
 func (*GetBatchContractStorageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{33}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetBatchContractStorageResponse) GetDatas() []string {
@@ -3039,12 +3433,16 @@ type GetContractStorageFieldsRequest struct {
 	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	// get data by longest chain's head block or last irreversible block
 	ByLongestChain bool `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (x *GetContractStorageFieldsRequest) Reset() {
 	*x = GetContractStorageFieldsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[34]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3057,7 +3455,7 @@ func (x *GetContractStorageFieldsRequest) String() string {
 func (*GetContractStorageFieldsRequest) ProtoMessage() {}
 
 func (x *GetContractStorageFieldsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[34]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3070,7 +3468,7 @@ func (x *GetContractStorageFieldsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetContractStorageFieldsRequest.ProtoReflect.Descriptor instead.
 func (*GetContractStorageFieldsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{34}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetContractStorageFieldsRequest) GetId() string {
@@ -3094,6 +3492,20 @@ func (x *GetContractStorageFieldsRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (x *GetContractStorageFieldsRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetContractStorageFieldsRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 // The message defines get contract storage response.
 type GetContractStorageFieldsResponse struct {
 	state         protoimpl.MessageState
@@ -3111,7 +3523,7 @@ type GetContractStorageFieldsResponse struct {
 func (x *GetContractStorageFieldsResponse) Reset() {
 	*x = GetContractStorageFieldsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[35]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3124,7 +3536,7 @@ func (x *GetContractStorageFieldsResponse) String() string {
 func (*GetContractStorageFieldsResponse) ProtoMessage() {}
 
 func (x *GetContractStorageFieldsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[35]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3137,7 +3549,7 @@ func (x *GetContractStorageFieldsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetContractStorageFieldsResponse.ProtoReflect.Descriptor instead.
 func (*GetContractStorageFieldsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{35}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetContractStorageFieldsResponse) GetFields() []string {
@@ -3161,41 +3573,43 @@ func (x *GetContractStorageFieldsResponse) GetBlockNumber() int64 {
 	return 0
 }
 
-type ListContractStorageRequest struct {
+// The message defines get state proof request.
+type GetStateProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// contract id
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Optional. keys will be in [from, to) and prefixed with `prefix`
-	From        string                                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To          string                                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Prefix      string                                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	StorageType ListContractStorageRequest_StorageType `protobuf:"varint,5,opt,name=storageType,proto3,enum=rpcpb.ListContractStorageRequest_StorageType" json:"storageType,omitempty"`
-	// max count to return. up to 100
-	Limit int64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the key in the StateDB
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// field is needed if StateDB[key] is a map
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
 	// get data by longest chain's head block or last irreversible block
-	ByLongestChain bool `protobuf:"varint,7,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	ByLongestChain bool `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-func (x *ListContractStorageRequest) Reset() {
-	*x = ListContractStorageRequest{}
+func (x *GetStateProofRequest) Reset() {
+	*x = GetStateProofRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[36]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListContractStorageRequest) String() string {
+func (x *GetStateProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContractStorageRequest) ProtoMessage() {}
+func (*GetStateProofRequest) ProtoMessage() {}
 
-func (x *ListContractStorageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[36]
+func (x *GetStateProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,89 +3620,99 @@ func (x *ListContractStorageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContractStorageRequest.ProtoReflect.Descriptor instead.
-func (*ListContractStorageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetStateProofRequest.ProtoReflect.Descriptor instead.
+func (*GetStateProofRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListContractStorageRequest) GetId() string {
+func (x *GetStateProofRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ListContractStorageRequest) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
-}
-
-func (x *ListContractStorageRequest) GetTo() string {
+func (x *GetStateProofRequest) GetKey() string {
 	if x != nil {
-		return x.To
+		return x.Key
 	}
 	return ""
 }
 
-func (x *ListContractStorageRequest) GetPrefix() string {
+func (x *GetStateProofRequest) GetField() string {
 	if x != nil {
-		return x.Prefix
+		return x.Field
 	}
 	return ""
 }
 
-func (x *ListContractStorageRequest) GetStorageType() ListContractStorageRequest_StorageType {
+func (x *GetStateProofRequest) GetByLongestChain() bool {
 	if x != nil {
-		return x.StorageType
+		return x.ByLongestChain
 	}
-	return ListContractStorageRequest_KV
+	return false
 }
 
-func (x *ListContractStorageRequest) GetLimit() int64 {
+func (x *GetStateProofRequest) GetBlockNumber() int64 {
 	if x != nil {
-		return x.Limit
+		return x.BlockNumber
 	}
 	return 0
 }
 
-func (x *ListContractStorageRequest) GetByLongestChain() bool {
+func (x *GetStateProofRequest) GetBlockHash() string {
 	if x != nil {
-		return x.ByLongestChain
+		return x.BlockHash
 	}
-	return false
+	return ""
 }
 
-type ListContractStorageResponse struct {
+// The message defines get state proof response.
+type GetStateProofResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Datas []*ListContractStorageResponse_Data `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	// the key of the value in the state db, the merkle tree is indexed by its hash
+	StateKey string `protobuf:"bytes,1,opt,name=state_key,json=stateKey,proto3" json:"state_key,omitempty"`
+	// whether the key exists
+	Exist bool `protobuf:"varint,2,opt,name=exist,proto3" json:"exist,omitempty"`
+	// the value as it is stored, the merkle tree commits to its hash
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// the json string data
+	Data string `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	// block hash
-	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	// block number
-	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-}
-
-func (x *ListContractStorageResponse) Reset() {
-	*x = ListContractStorageResponse{}
+	BlockNumber int64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// state merkle tree root hash of the block
+	StateRoot string `protobuf:"bytes,7,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// hashes of the siblings along the path from the root to the key
+	Siblings []string `protobuf:"bytes,8,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// key hash of the leaf at the end of the path, empty if the path ends at an empty subtree.
+	// a leaf of another key proves that the key does not exist
+	LeafKey string `protobuf:"bytes,9,opt,name=leaf_key,json=leafKey,proto3" json:"leaf_key,omitempty"`
+	// value hash of the leaf at the end of the path
+	LeafValue string `protobuf:"bytes,10,opt,name=leaf_value,json=leafValue,proto3" json:"leaf_value,omitempty"`
+}
+
+func (x *GetStateProofResponse) Reset() {
+	*x = GetStateProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[37]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListContractStorageResponse) String() string {
+func (x *GetStateProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContractStorageResponse) ProtoMessage() {}
+func (*GetStateProofResponse) ProtoMessage() {}
 
-func (x *ListContractStorageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[37]
+func (x *GetStateProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3299,61 +3723,123 @@ func (x *ListContractStorageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContractStorageResponse.ProtoReflect.Descriptor instead.
-func (*ListContractStorageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetStateProofResponse.ProtoReflect.Descriptor instead.
+func (*GetStateProofResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListContractStorageResponse) GetDatas() []*ListContractStorageResponse_Data {
+func (x *GetStateProofResponse) GetStateKey() string {
 	if x != nil {
-		return x.Datas
+		return x.StateKey
 	}
-	return nil
+	return ""
 }
 
-func (x *ListContractStorageResponse) GetBlockHash() string {
+func (x *GetStateProofResponse) GetExist() bool {
+	if x != nil {
+		return x.Exist
+	}
+	return false
+}
+
+func (x *GetStateProofResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GetStateProofResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *GetStateProofResponse) GetBlockHash() string {
 	if x != nil {
 		return x.BlockHash
 	}
 	return ""
 }
 
-func (x *ListContractStorageResponse) GetBlockNumber() int64 {
+func (x *GetStateProofResponse) GetBlockNumber() int64 {
 	if x != nil {
 		return x.BlockNumber
 	}
 	return 0
 }
 
-// The message defines send transaction response.
-type SendTransactionResponse struct {
+func (x *GetStateProofResponse) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
+func (x *GetStateProofResponse) GetSiblings() []string {
+	if x != nil {
+		return x.Siblings
+	}
+	return nil
+}
+
+func (x *GetStateProofResponse) GetLeafKey() string {
+	if x != nil {
+		return x.LeafKey
+	}
+	return ""
+}
+
+func (x *GetStateProofResponse) GetLeafValue() string {
+	if x != nil {
+		return x.LeafValue
+	}
+	return ""
+}
+
+type ListContractStorageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the final transaction hash
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// the tx_receipt of execution in advance
-	PreTxReceipt *TxReceipt `protobuf:"bytes,2,opt,name=pre_tx_receipt,json=preTxReceipt,proto3" json:"pre_tx_receipt,omitempty"`
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. keys will be in [from, to) and prefixed with `prefix`
+	From        string                                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string                                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Prefix      string                                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	StorageType ListContractStorageRequest_StorageType `protobuf:"varint,5,opt,name=storageType,proto3,enum=rpcpb.ListContractStorageRequest_StorageType" json:"storageType,omitempty"`
+	// max count to return. up to 100
+	Limit int64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,7,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,8,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,9,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// decode the values by the storage schema of the contract. Keys of maps are split into key and field,
+	// and the lists of map fields are skipped.
+	Decode bool `protobuf:"varint,10,opt,name=decode,proto3" json:"decode,omitempty"`
 }
 
-func (x *SendTransactionResponse) Reset() {
-	*x = SendTransactionResponse{}
+func (x *ListContractStorageRequest) Reset() {
+	*x = ListContractStorageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[38]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendTransactionResponse) String() string {
+func (x *ListContractStorageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTransactionResponse) ProtoMessage() {}
+func (*ListContractStorageRequest) ProtoMessage() {}
 
-func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[38]
+func (x *ListContractStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3364,21 +3850,209 @@ func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTransactionResponse.ProtoReflect.Descriptor instead.
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListContractStorageRequest.ProtoReflect.Descriptor instead.
+func (*ListContractStorageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SendTransactionResponse) GetHash() string {
+func (x *ListContractStorageRequest) GetId() string {
 	if x != nil {
-		return x.Hash
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SendTransactionResponse) GetPreTxReceipt() *TxReceipt {
+func (x *ListContractStorageRequest) GetFrom() string {
 	if x != nil {
-		return x.PreTxReceipt
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListContractStorageRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListContractStorageRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListContractStorageRequest) GetStorageType() ListContractStorageRequest_StorageType {
+	if x != nil {
+		return x.StorageType
+	}
+	return ListContractStorageRequest_KV
+}
+
+func (x *ListContractStorageRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListContractStorageRequest) GetByLongestChain() bool {
+	if x != nil {
+		return x.ByLongestChain
+	}
+	return false
+}
+
+func (x *ListContractStorageRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ListContractStorageRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ListContractStorageRequest) GetDecode() bool {
+	if x != nil {
+		return x.Decode
+	}
+	return false
+}
+
+type ListContractStorageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Datas []*ListContractStorageResponse_Data `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	// block hash
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block number
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// pass it as from to list the next page, empty if there is no more
+	Next string `protobuf:"bytes,4,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *ListContractStorageResponse) Reset() {
+	*x = ListContractStorageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContractStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContractStorageResponse) ProtoMessage() {}
+
+func (x *ListContractStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContractStorageResponse.ProtoReflect.Descriptor instead.
+func (*ListContractStorageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListContractStorageResponse) GetDatas() []*ListContractStorageResponse_Data {
+	if x != nil {
+		return x.Datas
+	}
+	return nil
+}
+
+func (x *ListContractStorageResponse) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ListContractStorageResponse) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ListContractStorageResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+// The message defines send transaction response.
+type SendTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the final transaction hash
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// the tx_receipt of execution in advance
+	PreTxReceipt *TxReceipt `protobuf:"bytes,2,opt,name=pre_tx_receipt,json=preTxReceipt,proto3" json:"pre_tx_receipt,omitempty"`
+}
+
+func (x *SendTransactionResponse) Reset() {
+	*x = SendTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTransactionResponse) ProtoMessage() {}
+
+func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SendTransactionResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SendTransactionResponse) GetPreTxReceipt() *TxReceipt {
+	if x != nil {
+		return x.PreTxReceipt
 	}
 	return nil
 }
@@ -3398,7 +4072,7 @@ type GetTokenBalanceResponse struct {
 func (x *GetTokenBalanceResponse) Reset() {
 	*x = GetTokenBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[39]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3411,7 +4085,7 @@ func (x *GetTokenBalanceResponse) String() string {
 func (*GetTokenBalanceResponse) ProtoMessage() {}
 
 func (x *GetTokenBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[39]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3424,7 +4098,7 @@ func (x *GetTokenBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTokenBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{39}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetTokenBalanceResponse) GetBalance() float64 {
@@ -3453,12 +4127,16 @@ type GetTokenBalanceRequest struct {
 	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	// get data by longest chain's head block or last irreversible block
 	ByLongestChain bool `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (x *GetTokenBalanceRequest) Reset() {
 	*x = GetTokenBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[40]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3471,7 +4149,7 @@ func (x *GetTokenBalanceRequest) String() string {
 func (*GetTokenBalanceRequest) ProtoMessage() {}
 
 func (x *GetTokenBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[40]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3484,7 +4162,7 @@ func (x *GetTokenBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTokenBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{40}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetTokenBalanceRequest) GetAccount() string {
@@ -3508,6 +4186,20 @@ func (x *GetTokenBalanceRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (x *GetTokenBalanceRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetTokenBalanceRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 // The message defines get token721 balance response.
 type GetToken721BalanceResponse struct {
 	state         protoimpl.MessageState
@@ -3523,7 +4215,7 @@ type GetToken721BalanceResponse struct {
 func (x *GetToken721BalanceResponse) Reset() {
 	*x = GetToken721BalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[41]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3536,7 +4228,7 @@ func (x *GetToken721BalanceResponse) String() string {
 func (*GetToken721BalanceResponse) ProtoMessage() {}
 
 func (x *GetToken721BalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[41]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3549,7 +4241,7 @@ func (x *GetToken721BalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetToken721BalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetToken721BalanceResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{41}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetToken721BalanceResponse) GetBalance() int64 {
@@ -3578,12 +4270,16 @@ type GetToken721InfoRequest struct {
 	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 	// get data by longest chain's head block or last irreversible block
 	ByLongestChain bool `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (x *GetToken721InfoRequest) Reset() {
 	*x = GetToken721InfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[42]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3596,7 +4292,7 @@ func (x *GetToken721InfoRequest) String() string {
 func (*GetToken721InfoRequest) ProtoMessage() {}
 
 func (x *GetToken721InfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[42]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3609,7 +4305,7 @@ func (x *GetToken721InfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetToken721InfoRequest.ProtoReflect.Descriptor instead.
 func (*GetToken721InfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{42}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetToken721InfoRequest) GetToken() string {
@@ -3633,6 +4329,20 @@ func (x *GetToken721InfoRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (x *GetToken721InfoRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetToken721InfoRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 // The message defines get token721 metadata response.
 type GetToken721MetadataResponse struct {
 	state         protoimpl.MessageState
@@ -3646,7 +4356,7 @@ type GetToken721MetadataResponse struct {
 func (x *GetToken721MetadataResponse) Reset() {
 	*x = GetToken721MetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[43]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3659,7 +4369,7 @@ func (x *GetToken721MetadataResponse) String() string {
 func (*GetToken721MetadataResponse) ProtoMessage() {}
 
 func (x *GetToken721MetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[43]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3672,7 +4382,7 @@ func (x *GetToken721MetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetToken721MetadataResponse.ProtoReflect.Descriptor instead.
 func (*GetToken721MetadataResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{43}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetToken721MetadataResponse) GetMetadata() string {
@@ -3695,7 +4405,7 @@ type GetToken721OwnerResponse struct {
 func (x *GetToken721OwnerResponse) Reset() {
 	*x = GetToken721OwnerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[44]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3708,7 +4418,7 @@ func (x *GetToken721OwnerResponse) String() string {
 func (*GetToken721OwnerResponse) ProtoMessage() {}
 
 func (x *GetToken721OwnerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[44]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3721,7 +4431,7 @@ func (x *GetToken721OwnerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetToken721OwnerResponse.ProtoReflect.Descriptor instead.
 func (*GetToken721OwnerResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{44}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetToken721OwnerResponse) GetOwner() string {
@@ -3743,12 +4453,14 @@ type Event struct {
 	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	// event time
 	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	// position of the event in the event store, only set for replayed events
+	Cursor *EventCursor `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *Event) Reset() {
 	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[45]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3761,7 +4473,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[45]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3774,7 +4486,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{45}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *Event) GetTopic() Event_Topic {
@@ -3798,20 +4510,89 @@ func (x *Event) GetTime() int64 {
 	return 0
 }
 
-// The message defines subscribe request.
-type SubscribeRequest struct {
+func (x *Event) GetCursor() *EventCursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// The message defines the position of an event in the event store.
+type EventCursor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Topics []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
-	Filter *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// the number of the block the event belongs to
+	BlockNumber int64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// the index of the event in the block starting from 1, 0 means right before the first event of the block
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *EventCursor) Reset() {
+	*x = EventCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCursor) ProtoMessage() {}
+
+func (x *EventCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventCursor.ProtoReflect.Descriptor instead.
+func (*EventCursor) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EventCursor) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *EventCursor) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// The message defines subscribe request.
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
+	Filter *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// replay the stored events after the cursor, then keep streaming new stored events.
+	// only CONTRACT_RECEIPT, CONTRACT_EVENT and IRREVERSIBLE_BLOCK are stored, and the node must enable the event store.
+	// a cursor before the block the event store of the node starts from is refused
+	FromCursor *EventCursor `protobuf:"bytes,3,opt,name=from_cursor,json=fromCursor,proto3" json:"from_cursor,omitempty"`
 }
 
 func (x *SubscribeRequest) Reset() {
 	*x = SubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[46]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3824,7 +4605,7 @@ func (x *SubscribeRequest) String() string {
 func (*SubscribeRequest) ProtoMessage() {}
 
 func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[46]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3837,7 +4618,7 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{46}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *SubscribeRequest) GetTopics() []Event_Topic {
@@ -3854,6 +4635,80 @@ func (x *SubscribeRequest) GetFilter() *SubscribeRequest_Filter {
 	return nil
 }
 
+func (x *SubscribeRequest) GetFromCursor() *EventCursor {
+	if x != nil {
+		return x.FromCursor
+	}
+	return nil
+}
+
+// The message defines a predicate on the event data.
+type EventPredicate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// json path of the value to compare, such as $.amount or $[2]
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// comparison operator
+	Op EventPredicate_Op `protobuf:"varint,2,opt,name=op,proto3,enum=rpcpb.EventPredicate_Op" json:"op,omitempty"`
+	// value to compare with, compared as numbers if both sides are numbers, otherwise as strings
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EventPredicate) Reset() {
+	*x = EventPredicate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventPredicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPredicate) ProtoMessage() {}
+
+func (x *EventPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPredicate.ProtoReflect.Descriptor instead.
+func (*EventPredicate) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *EventPredicate) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EventPredicate) GetOp() EventPredicate_Op {
+	if x != nil {
+		return x.Op
+	}
+	return EventPredicate_EQ
+}
+
+func (x *EventPredicate) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 // The message defines subscribe response.
 type SubscribeResponse struct {
 	state         protoimpl.MessageState
@@ -3866,7 +4721,7 @@ type SubscribeResponse struct {
 func (x *SubscribeResponse) Reset() {
 	*x = SubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[47]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3879,7 +4734,7 @@ func (x *SubscribeResponse) String() string {
 func (*SubscribeResponse) ProtoMessage() {}
 
 func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[47]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3892,7 +4747,7 @@ func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{47}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *SubscribeResponse) GetEvent() *Event {
@@ -3917,7 +4772,7 @@ type VoterBonus struct {
 func (x *VoterBonus) Reset() {
 	*x = VoterBonus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[48]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3930,7 +4785,7 @@ func (x *VoterBonus) String() string {
 func (*VoterBonus) ProtoMessage() {}
 
 func (x *VoterBonus) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[48]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3943,7 +4798,7 @@ func (x *VoterBonus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoterBonus.ProtoReflect.Descriptor instead.
 func (*VoterBonus) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{48}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *VoterBonus) GetBonus() float64 {
@@ -3973,7 +4828,7 @@ type CandidateBonus struct {
 func (x *CandidateBonus) Reset() {
 	*x = CandidateBonus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[49]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3986,7 +4841,7 @@ func (x *CandidateBonus) String() string {
 func (*CandidateBonus) ProtoMessage() {}
 
 func (x *CandidateBonus) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[49]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3999,7 +4854,7 @@ func (x *CandidateBonus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CandidateBonus.ProtoReflect.Descriptor instead.
 func (*CandidateBonus) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{49}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *CandidateBonus) GetBonus() float64 {
@@ -4019,12 +4874,16 @@ type GetTokenInfoRequest struct {
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	// get tokeninfo by longest chain's head block or last irreversible block
 	ByLongestChain bool `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at this block number instead, irreversible blocks need an archive node. 0 means not specified
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at this block hash instead, it has priority over block_number
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (x *GetTokenInfoRequest) Reset() {
 	*x = GetTokenInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[50]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4037,7 +4896,7 @@ func (x *GetTokenInfoRequest) String() string {
 func (*GetTokenInfoRequest) ProtoMessage() {}
 
 func (x *GetTokenInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[50]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4050,7 +4909,7 @@ func (x *GetTokenInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTokenInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetTokenInfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{50}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetTokenInfoRequest) GetSymbol() string {
@@ -4067,6 +4926,20 @@ func (x *GetTokenInfoRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (x *GetTokenInfoRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetTokenInfoRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 // The message defines the token information.
 type TokenInfo struct {
 	state         protoimpl.MessageState
@@ -4098,7 +4971,7 @@ type TokenInfo struct {
 func (x *TokenInfo) Reset() {
 	*x = TokenInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[51]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4111,7 +4984,7 @@ func (x *TokenInfo) String() string {
 func (*TokenInfo) ProtoMessage() {}
 
 func (x *TokenInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[51]
+	mi := &file_rpc_pb_rpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4124,7 +4997,7 @@ func (x *TokenInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
 func (*TokenInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{51}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *TokenInfo) GetSymbol() string {
@@ -4197,35 +5070,37 @@ func (x *TokenInfo) GetCurrentSupplyFloat() float64 {
 	return 0
 }
 
-// The message defines transaction execution receipt.
-type TxReceipt_Receipt struct {
+// The message defines the request of the transactions of an account.
+type GetAccountTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// function name
-	FuncName string `protobuf:"bytes,1,opt,name=func_name,json=funcName,proto3" json:"func_name,omitempty"`
-	// content
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// account name
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// max number of transactions to return, at most 100, 0 means 100
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor returned by the previous query, empty means from the newest transaction
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *TxReceipt_Receipt) Reset() {
-	*x = TxReceipt_Receipt{}
+func (x *GetAccountTransactionsRequest) Reset() {
+	*x = GetAccountTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[53]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TxReceipt_Receipt) String() string {
+func (x *GetAccountTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxReceipt_Receipt) ProtoMessage() {}
+func (*GetAccountTransactionsRequest) ProtoMessage() {}
 
-func (x *TxReceipt_Receipt) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[53]
+func (x *GetAccountTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4236,56 +5111,69 @@ func (x *TxReceipt_Receipt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxReceipt_Receipt.ProtoReflect.Descriptor instead.
-func (*TxReceipt_Receipt) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{6, 1}
+// Deprecated: Use GetAccountTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *TxReceipt_Receipt) GetFuncName() string {
+func (x *GetAccountTransactionsRequest) GetAccount() string {
 	if x != nil {
-		return x.FuncName
+		return x.Account
 	}
 	return ""
 }
 
-func (x *TxReceipt_Receipt) GetContent() string {
+func (x *GetAccountTransactionsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Content
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAccountTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
 	return ""
 }
 
-// The message defines block extra information
-type Block_Info struct {
+// The message defines a transaction of an account.
+type AccountTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// pack mode
-	Mode int32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
-	// transaction execution thread number
-	Thread int32 `protobuf:"varint,2,opt,name=thread,proto3" json:"thread,omitempty"`
-	// transaction index of every batch execution
-	BatchIndex []int32 `protobuf:"varint,3,rep,packed,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty"`
-}
-
-func (x *Block_Info) Reset() {
-	*x = Block_Info{}
+	// tx hash
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// the number of the block containing the transaction
+	BlockNumber int64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// the index of the transaction in the block
+	Index int32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// whether the account is the publisher
+	Publisher bool `protobuf:"varint,4,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// whether the account is a signer
+	Signer bool `protobuf:"varint,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// whether the account received tokens in a token.iost transfer
+	Recipient bool `protobuf:"varint,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (x *AccountTransaction) Reset() {
+	*x = AccountTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[54]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Block_Info) String() string {
+func (x *AccountTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Block_Info) ProtoMessage() {}
+func (*AccountTransaction) ProtoMessage() {}
 
-func (x *Block_Info) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[54]
+func (x *AccountTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4296,61 +5184,82 @@ func (x *Block_Info) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Block_Info.ProtoReflect.Descriptor instead.
-func (*Block_Info) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use AccountTransaction.ProtoReflect.Descriptor instead.
+func (*AccountTransaction) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Block_Info) GetMode() int32 {
+func (x *AccountTransaction) GetHash() string {
 	if x != nil {
-		return x.Mode
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
 	}
 	return 0
 }
 
-func (x *Block_Info) GetThread() int32 {
+func (x *AccountTransaction) GetIndex() int32 {
 	if x != nil {
-		return x.Thread
+		return x.Index
 	}
 	return 0
 }
 
-func (x *Block_Info) GetBatchIndex() []int32 {
+func (x *AccountTransaction) GetPublisher() bool {
 	if x != nil {
-		return x.BatchIndex
+		return x.Publisher
 	}
-	return nil
+	return false
 }
 
-// The message defines account pledged coin information.
-type Account_PledgeInfo struct {
+func (x *AccountTransaction) GetSigner() bool {
+	if x != nil {
+		return x.Signer
+	}
+	return false
+}
+
+func (x *AccountTransaction) GetRecipient() bool {
+	if x != nil {
+		return x.Recipient
+	}
+	return false
+}
+
+// The message defines the response of the transactions of an account.
+type GetAccountTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the account who pledges
-	Pledger string `protobuf:"bytes,1,opt,name=pledger,proto3" json:"pledger,omitempty"`
-	// pledged amount
-	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// transactions, newest first
+	Transactions []*AccountTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// cursor of the next page, empty if there are no more transactions
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *Account_PledgeInfo) Reset() {
-	*x = Account_PledgeInfo{}
+func (x *GetAccountTransactionsResponse) Reset() {
+	*x = GetAccountTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[55]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Account_PledgeInfo) String() string {
+func (x *GetAccountTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account_PledgeInfo) ProtoMessage() {}
+func (*GetAccountTransactionsResponse) ProtoMessage() {}
 
-func (x *Account_PledgeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[55]
+func (x *GetAccountTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4361,60 +5270,58 @@ func (x *Account_PledgeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account_PledgeInfo.ProtoReflect.Descriptor instead.
-func (*Account_PledgeInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use GetAccountTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *Account_PledgeInfo) GetPledger() string {
+func (x *GetAccountTransactionsResponse) GetTransactions() []*AccountTransaction {
 	if x != nil {
-		return x.Pledger
+		return x.Transactions
 	}
-	return ""
+	return nil
 }
 
-func (x *Account_PledgeInfo) GetAmount() float64 {
+func (x *GetAccountTransactionsResponse) GetNextCursor() string {
 	if x != nil {
-		return x.Amount
+		return x.NextCursor
 	}
-	return 0
+	return ""
 }
 
-// The message defines account gas information.
-type Account_GasInfo struct {
+// The message defines the request of the pending transactions.
+type ListPendingTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// current total gas amount
-	CurrentTotal    float64 `protobuf:"fixed64,1,opt,name=current_total,json=currentTotal,proto3" json:"current_total,omitempty"`
-	TransferableGas float64 `protobuf:"fixed64,2,opt,name=transferable_gas,json=transferableGas,proto3" json:"transferable_gas,omitempty"`
-	PledgeGas       float64 `protobuf:"fixed64,3,opt,name=pledge_gas,json=pledgeGas,proto3" json:"pledge_gas,omitempty"`
-	// gas increase speed
-	IncreaseSpeed float64 `protobuf:"fixed64,4,opt,name=increase_speed,json=increaseSpeed,proto3" json:"increase_speed,omitempty"`
-	// gas limit
-	Limit float64 `protobuf:"fixed64,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	// pledge information
-	PledgedInfo []*Account_PledgeInfo `protobuf:"bytes,6,rep,name=pledged_info,json=pledgedInfo,proto3" json:"pledged_info,omitempty"`
+	// only list the transactions of the publisher if not empty
+	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// only list the transactions calling the contract if not empty
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// number of matched transactions to skip
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// max number of transactions to return, at most 100, 0 means 100
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *Account_GasInfo) Reset() {
-	*x = Account_GasInfo{}
+func (x *ListPendingTransactionsRequest) Reset() {
+	*x = ListPendingTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[56]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Account_GasInfo) String() string {
+func (x *ListPendingTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account_GasInfo) ProtoMessage() {}
+func (*ListPendingTransactionsRequest) ProtoMessage() {}
 
-func (x *Account_GasInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[56]
+func (x *ListPendingTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4425,84 +5332,68 @@ func (x *Account_GasInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account_GasInfo.ProtoReflect.Descriptor instead.
-func (*Account_GasInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{25, 1}
-}
-
-func (x *Account_GasInfo) GetCurrentTotal() float64 {
-	if x != nil {
-		return x.CurrentTotal
-	}
-	return 0
+// Deprecated: Use ListPendingTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *Account_GasInfo) GetTransferableGas() float64 {
+func (x *ListPendingTransactionsRequest) GetPublisher() string {
 	if x != nil {
-		return x.TransferableGas
+		return x.Publisher
 	}
-	return 0
+	return ""
 }
 
-func (x *Account_GasInfo) GetPledgeGas() float64 {
+func (x *ListPendingTransactionsRequest) GetContract() string {
 	if x != nil {
-		return x.PledgeGas
+		return x.Contract
 	}
-	return 0
+	return ""
 }
 
-func (x *Account_GasInfo) GetIncreaseSpeed() float64 {
+func (x *ListPendingTransactionsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.IncreaseSpeed
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *Account_GasInfo) GetLimit() float64 {
+func (x *ListPendingTransactionsRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *Account_GasInfo) GetPledgedInfo() []*Account_PledgeInfo {
-	if x != nil {
-		return x.PledgedInfo
-	}
-	return nil
-}
-
-// The message defines account ram information.
-type Account_RAMInfo struct {
+// The message defines the response of the pending transactions.
+type ListPendingTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// available ram bytes
-	Available int64 `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	// used ram bytes
-	Used int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
-	// total ram bytes
-	Total int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// pending transactions, ordered by priority
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// number of all the matched transactions
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *Account_RAMInfo) Reset() {
-	*x = Account_RAMInfo{}
+func (x *ListPendingTransactionsResponse) Reset() {
+	*x = ListPendingTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[57]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Account_RAMInfo) String() string {
+func (x *ListPendingTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account_RAMInfo) ProtoMessage() {}
+func (*ListPendingTransactionsResponse) ProtoMessage() {}
 
-func (x *Account_RAMInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[57]
+func (x *ListPendingTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4513,65 +5404,64 @@ func (x *Account_RAMInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account_RAMInfo.ProtoReflect.Descriptor instead.
-func (*Account_RAMInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{25, 2}
-}
-
-func (x *Account_RAMInfo) GetAvailable() int64 {
-	if x != nil {
-		return x.Available
-	}
-	return 0
+// Deprecated: Use ListPendingTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *Account_RAMInfo) GetUsed() int64 {
+func (x *ListPendingTransactionsResponse) GetTransactions() []*Transaction {
 	if x != nil {
-		return x.Used
+		return x.Transactions
 	}
-	return 0
+	return nil
 }
 
-func (x *Account_RAMInfo) GetTotal() int64 {
+func (x *ListPendingTransactionsResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-// The message defines permission item.
-type Account_Item struct {
+// The message defines the statistics of the tx pool.
+type TxPoolStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// permission name or key pair id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// whether it's a key pair
-	IsKeyPair bool `protobuf:"varint,2,opt,name=is_key_pair,json=isKeyPair,proto3" json:"is_key_pair,omitempty"`
-	// permission weight
-	Weight int64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
-	// permission
-	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
-}
-
-func (x *Account_Item) Reset() {
-	*x = Account_Item{}
+	// number of pending transactions
+	PendingCount int32 `protobuf:"varint,1,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	// total size of pending transactions in bytes
+	PendingBytes int64 `protobuf:"varint,2,opt,name=pending_bytes,json=pendingBytes,proto3" json:"pending_bytes,omitempty"`
+	// number of publishers of pending transactions
+	PublisherCount int32 `protobuf:"varint,3,opt,name=publisher_count,json=publisherCount,proto3" json:"publisher_count,omitempty"`
+	// number of delay transactions waiting to be executed
+	DeferredCount int32 `protobuf:"varint,4,opt,name=deferred_count,json=deferredCount,proto3" json:"deferred_count,omitempty"`
+	// number of pending transactions by gas ratio, ordered by gas ratio
+	GasRatios []*TxPoolStatsResponse_GasRatioCount `protobuf:"bytes,5,rep,name=gas_ratios,json=gasRatios,proto3" json:"gas_ratios,omitempty"`
+	// max number of pending transactions
+	MaxPending int32 `protobuf:"varint,6,opt,name=max_pending,json=maxPending,proto3" json:"max_pending,omitempty"`
+	// max number of pending transactions of a publisher
+	MaxPendingPerPublisher int32 `protobuf:"varint,7,opt,name=max_pending_per_publisher,json=maxPendingPerPublisher,proto3" json:"max_pending_per_publisher,omitempty"`
+}
+
+func (x *TxPoolStatsResponse) Reset() {
+	*x = TxPoolStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[58]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Account_Item) String() string {
+func (x *TxPoolStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account_Item) ProtoMessage() {}
+func (*TxPoolStatsResponse) ProtoMessage() {}
 
-func (x *Account_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[58]
+func (x *TxPoolStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4582,68 +5472,95 @@ func (x *Account_Item) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account_Item.ProtoReflect.Descriptor instead.
-func (*Account_Item) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{25, 3}
+// Deprecated: Use TxPoolStatsResponse.ProtoReflect.Descriptor instead.
+func (*TxPoolStatsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Account_Item) GetId() string {
+func (x *TxPoolStatsResponse) GetPendingCount() int32 {
 	if x != nil {
-		return x.Id
+		return x.PendingCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Account_Item) GetIsKeyPair() bool {
+func (x *TxPoolStatsResponse) GetPendingBytes() int64 {
 	if x != nil {
-		return x.IsKeyPair
+		return x.PendingBytes
 	}
-	return false
+	return 0
 }
 
-func (x *Account_Item) GetWeight() int64 {
+func (x *TxPoolStatsResponse) GetPublisherCount() int32 {
 	if x != nil {
-		return x.Weight
+		return x.PublisherCount
 	}
 	return 0
 }
 
-func (x *Account_Item) GetPermission() string {
+func (x *TxPoolStatsResponse) GetDeferredCount() int32 {
 	if x != nil {
-		return x.Permission
+		return x.DeferredCount
 	}
-	return ""
+	return 0
 }
 
-// The message defines a permission group.
-type Account_Group struct {
+func (x *TxPoolStatsResponse) GetGasRatios() []*TxPoolStatsResponse_GasRatioCount {
+	if x != nil {
+		return x.GasRatios
+	}
+	return nil
+}
+
+func (x *TxPoolStatsResponse) GetMaxPending() int32 {
+	if x != nil {
+		return x.MaxPending
+	}
+	return 0
+}
+
+func (x *TxPoolStatsResponse) GetMaxPendingPerPublisher() int32 {
+	if x != nil {
+		return x.MaxPendingPerPublisher
+	}
+	return 0
+}
+
+// The message defines the estimation of a transaction.
+type EstimateTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// group name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// permission items
-	Items []*Account_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// receipt of the execution, with the gas and ram usage filled
+	Receipt *TxReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// gas used by the transaction at gas ratio 1
+	GasUsed float64 `protobuf:"fixed64,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// ram in bytes charged to each account, negative means released
+	RamUsage map[string]int64 `protobuf:"bytes,3,rep,name=ram_usage,json=ramUsage,proto3" json:"ram_usage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// suggested gas limit at the recommended gas ratio, with a safety margin
+	SuggestedGasLimit float64 `protobuf:"fixed64,4,opt,name=suggested_gas_limit,json=suggestedGasLimit,proto3" json:"suggested_gas_limit,omitempty"`
+	// recommended gas ratio according to the transactions of recent blocks
+	RecommendedGasRatio float64 `protobuf:"fixed64,5,opt,name=recommended_gas_ratio,json=recommendedGasRatio,proto3" json:"recommended_gas_ratio,omitempty"`
 }
 
-func (x *Account_Group) Reset() {
-	*x = Account_Group{}
+func (x *EstimateTransactionResponse) Reset() {
+	*x = EstimateTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[59]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Account_Group) String() string {
+func (x *EstimateTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account_Group) ProtoMessage() {}
+func (*EstimateTransactionResponse) ProtoMessage() {}
 
-func (x *Account_Group) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[59]
+func (x *EstimateTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4654,58 +5571,81 @@ func (x *Account_Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account_Group.ProtoReflect.Descriptor instead.
-func (*Account_Group) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{25, 4}
+// Deprecated: Use EstimateTransactionResponse.ProtoReflect.Descriptor instead.
+func (*EstimateTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *Account_Group) GetName() string {
+func (x *EstimateTransactionResponse) GetReceipt() *TxReceipt {
 	if x != nil {
-		return x.Name
+		return x.Receipt
 	}
-	return ""
+	return nil
 }
 
-func (x *Account_Group) GetItems() []*Account_Item {
+func (x *EstimateTransactionResponse) GetGasUsed() float64 {
 	if x != nil {
-		return x.Items
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *EstimateTransactionResponse) GetRamUsage() map[string]int64 {
+	if x != nil {
+		return x.RamUsage
 	}
 	return nil
 }
 
-// The message defines the permission struct.
-type Account_Permission struct {
+func (x *EstimateTransactionResponse) GetSuggestedGasLimit() float64 {
+	if x != nil {
+		return x.SuggestedGasLimit
+	}
+	return 0
+}
+
+func (x *EstimateTransactionResponse) GetRecommendedGasRatio() float64 {
+	if x != nil {
+		return x.RecommendedGasRatio
+	}
+	return 0
+}
+
+// The message defines a storage read or write in a traced call.
+type StorageAccess struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// permission name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// permission groups
-	GroupNames []string `protobuf:"bytes,2,rep,name=group_names,json=groupNames,proto3" json:"group_names,omitempty"`
-	// permission items
-	Items []*Account_Item `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	// permission threshold
-	Threshold int64 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// operation, such as get, put, del, map_get, map_put, map_del, global_get and global_map_get
+	Op string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	// storage key prefixed by the contract id
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// map field
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// value before the access, which is the value read for reads
+	OldValue string `protobuf:"bytes,4,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// value written
+	NewValue string `protobuf:"bytes,5,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
 }
 
-func (x *Account_Permission) Reset() {
-	*x = Account_Permission{}
+func (x *StorageAccess) Reset() {
+	*x = StorageAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[60]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Account_Permission) String() string {
+func (x *StorageAccess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account_Permission) ProtoMessage() {}
+func (*StorageAccess) ProtoMessage() {}
 
-func (x *Account_Permission) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[60]
+func (x *StorageAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4716,70 +5656,75 @@ func (x *Account_Permission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account_Permission.ProtoReflect.Descriptor instead.
-func (*Account_Permission) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{25, 5}
+// Deprecated: Use StorageAccess.ProtoReflect.Descriptor instead.
+func (*StorageAccess) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Account_Permission) GetName() string {
+func (x *StorageAccess) GetOp() string {
 	if x != nil {
-		return x.Name
+		return x.Op
 	}
 	return ""
 }
 
-func (x *Account_Permission) GetGroupNames() []string {
+func (x *StorageAccess) GetKey() string {
 	if x != nil {
-		return x.GroupNames
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *Account_Permission) GetItems() []*Account_Item {
+func (x *StorageAccess) GetField() string {
 	if x != nil {
-		return x.Items
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-func (x *Account_Permission) GetThreshold() int64 {
+func (x *StorageAccess) GetOldValue() string {
 	if x != nil {
-		return x.Threshold
+		return x.OldValue
 	}
-	return 0
+	return ""
 }
 
-// The message defines the ABI struct.
-type Contract_ABI struct {
+func (x *StorageAccess) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// The message defines a console log written by a contract.
+type ContractLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// abi name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// abi arguments
-	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
-	// abi amount limt
-	AmountLimit []*AmountLimit `protobuf:"bytes,3,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+	// log level
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// log message
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Contract_ABI) Reset() {
-	*x = Contract_ABI{}
+func (x *ContractLog) Reset() {
+	*x = ContractLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[63]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Contract_ABI) String() string {
+func (x *ContractLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Contract_ABI) ProtoMessage() {}
+func (*ContractLog) ProtoMessage() {}
 
-func (x *Contract_ABI) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[63]
+func (x *ContractLog) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4790,61 +5735,74 @@ func (x *Contract_ABI) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Contract_ABI.ProtoReflect.Descriptor instead.
-func (*Contract_ABI) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{27, 0}
+// Deprecated: Use ContractLog.ProtoReflect.Descriptor instead.
+func (*ContractLog) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Contract_ABI) GetName() string {
+func (x *ContractLog) GetLevel() string {
 	if x != nil {
-		return x.Name
+		return x.Level
 	}
 	return ""
 }
 
-func (x *Contract_ABI) GetArgs() []string {
-	if x != nil {
-		return x.Args
-	}
-	return nil
-}
-
-func (x *Contract_ABI) GetAmountLimit() []*AmountLimit {
+func (x *ContractLog) GetMessage() string {
 	if x != nil {
-		return x.AmountLimit
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// The message defines GetContractStorage request params.
-type GetBatchContractStorageRequest_KeyField struct {
+// The message defines a contract call in a transaction trace.
+type CallFrame struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the key in StateDB
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// get the value from StateDB, fields is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
-	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
-}
-
-func (x *GetBatchContractStorageRequest_KeyField) Reset() {
-	*x = GetBatchContractStorageRequest_KeyField{}
+	// contract name
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// abi name
+	Abi string `protobuf:"bytes,2,opt,name=abi,proto3" json:"abi,omitempty"`
+	// arguments in json
+	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	// caller account or contract
+	Caller string `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	// depth of the call, 0 for actions
+	Depth int32 `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	// gas used by the call and its nested calls at gas ratio 1
+	GasUsed float64 `protobuf:"fixed64,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// return values in json
+	Returns string `protobuf:"bytes,7,opt,name=returns,proto3" json:"returns,omitempty"`
+	// error message if the call failed
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// javascript stack if the call threw
+	Stack string `protobuf:"bytes,9,opt,name=stack,proto3" json:"stack,omitempty"`
+	// storage accesses of the call
+	Storage []*StorageAccess `protobuf:"bytes,10,rep,name=storage,proto3" json:"storage,omitempty"`
+	// console logs of the call
+	Logs []*ContractLog `protobuf:"bytes,11,rep,name=logs,proto3" json:"logs,omitempty"`
+	// nested calls
+	Calls []*CallFrame `protobuf:"bytes,12,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *CallFrame) Reset() {
+	*x = CallFrame{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[64]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBatchContractStorageRequest_KeyField) String() string {
+func (x *CallFrame) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBatchContractStorageRequest_KeyField) ProtoMessage() {}
+func (*CallFrame) ProtoMessage() {}
 
-func (x *GetBatchContractStorageRequest_KeyField) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[64]
+func (x *CallFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4855,9 +5813,975 @@ func (x *GetBatchContractStorageRequest_KeyField) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBatchContractStorageRequest_KeyField.ProtoReflect.Descriptor instead.
+// Deprecated: Use CallFrame.ProtoReflect.Descriptor instead.
+func (*CallFrame) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CallFrame) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *CallFrame) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+func (x *CallFrame) GetArgs() string {
+	if x != nil {
+		return x.Args
+	}
+	return ""
+}
+
+func (x *CallFrame) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *CallFrame) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *CallFrame) GetGasUsed() float64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *CallFrame) GetReturns() string {
+	if x != nil {
+		return x.Returns
+	}
+	return ""
+}
+
+func (x *CallFrame) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CallFrame) GetStack() string {
+	if x != nil {
+		return x.Stack
+	}
+	return ""
+}
+
+func (x *CallFrame) GetStorage() []*StorageAccess {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *CallFrame) GetLogs() []*ContractLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *CallFrame) GetCalls() []*CallFrame {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+// The message defines the trace of a transaction.
+type TraceTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// receipt of the execution
+	Receipt *TxReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// call frames, one for each executed action
+	Frames []*CallFrame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
+}
+
+func (x *TraceTransactionResponse) Reset() {
+	*x = TraceTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceTransactionResponse) ProtoMessage() {}
+
+func (x *TraceTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceTransactionResponse.ProtoReflect.Descriptor instead.
+func (*TraceTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TraceTransactionResponse) GetReceipt() *TxReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *TraceTransactionResponse) GetFrames() []*CallFrame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+// The message defines transaction execution receipt.
+type TxReceipt_Receipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// function name
+	FuncName string `protobuf:"bytes,1,opt,name=func_name,json=funcName,proto3" json:"func_name,omitempty"`
+	// content
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *TxReceipt_Receipt) Reset() {
+	*x = TxReceipt_Receipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxReceipt_Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxReceipt_Receipt) ProtoMessage() {}
+
+func (x *TxReceipt_Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxReceipt_Receipt.ProtoReflect.Descriptor instead.
+func (*TxReceipt_Receipt) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *TxReceipt_Receipt) GetFuncName() string {
+	if x != nil {
+		return x.FuncName
+	}
+	return ""
+}
+
+func (x *TxReceipt_Receipt) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// The message defines a change of contract storage.
+type TxReceipt_StateChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract name
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// storage key
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// map field, empty for basic keys
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// value before the transaction, empty if absent
+	OldValue string `protobuf:"bytes,4,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// value after the transaction, empty if deleted
+	NewValue string `protobuf:"bytes,5,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (x *TxReceipt_StateChange) Reset() {
+	*x = TxReceipt_StateChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxReceipt_StateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxReceipt_StateChange) ProtoMessage() {}
+
+func (x *TxReceipt_StateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxReceipt_StateChange.ProtoReflect.Descriptor instead.
+func (*TxReceipt_StateChange) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *TxReceipt_StateChange) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *TxReceipt_StateChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TxReceipt_StateChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TxReceipt_StateChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *TxReceipt_StateChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// The message defines block extra information
+type Block_Info struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pack mode
+	Mode int32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// transaction execution thread number
+	Thread int32 `protobuf:"varint,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	// transaction index of every batch execution
+	BatchIndex []int32 `protobuf:"varint,3,rep,packed,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty"`
+}
+
+func (x *Block_Info) Reset() {
+	*x = Block_Info{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Block_Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block_Info) ProtoMessage() {}
+
+func (x *Block_Info) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block_Info.ProtoReflect.Descriptor instead.
+func (*Block_Info) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *Block_Info) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *Block_Info) GetThread() int32 {
+	if x != nil {
+		return x.Thread
+	}
+	return 0
+}
+
+func (x *Block_Info) GetBatchIndex() []int32 {
+	if x != nil {
+		return x.BatchIndex
+	}
+	return nil
+}
+
+// The message defines account pledged coin information.
+type Account_PledgeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the account who pledges
+	Pledger string `protobuf:"bytes,1,opt,name=pledger,proto3" json:"pledger,omitempty"`
+	// pledged amount
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Account_PledgeInfo) Reset() {
+	*x = Account_PledgeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account_PledgeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account_PledgeInfo) ProtoMessage() {}
+
+func (x *Account_PledgeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account_PledgeInfo.ProtoReflect.Descriptor instead.
+func (*Account_PledgeInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *Account_PledgeInfo) GetPledger() string {
+	if x != nil {
+		return x.Pledger
+	}
+	return ""
+}
+
+func (x *Account_PledgeInfo) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// The message defines account gas information.
+type Account_GasInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// current total gas amount
+	CurrentTotal    float64 `protobuf:"fixed64,1,opt,name=current_total,json=currentTotal,proto3" json:"current_total,omitempty"`
+	TransferableGas float64 `protobuf:"fixed64,2,opt,name=transferable_gas,json=transferableGas,proto3" json:"transferable_gas,omitempty"`
+	PledgeGas       float64 `protobuf:"fixed64,3,opt,name=pledge_gas,json=pledgeGas,proto3" json:"pledge_gas,omitempty"`
+	// gas increase speed
+	IncreaseSpeed float64 `protobuf:"fixed64,4,opt,name=increase_speed,json=increaseSpeed,proto3" json:"increase_speed,omitempty"`
+	// gas limit
+	Limit float64 `protobuf:"fixed64,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// pledge information
+	PledgedInfo []*Account_PledgeInfo `protobuf:"bytes,6,rep,name=pledged_info,json=pledgedInfo,proto3" json:"pledged_info,omitempty"`
+}
+
+func (x *Account_GasInfo) Reset() {
+	*x = Account_GasInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account_GasInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account_GasInfo) ProtoMessage() {}
+
+func (x *Account_GasInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account_GasInfo.ProtoReflect.Descriptor instead.
+func (*Account_GasInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{27, 1}
+}
+
+func (x *Account_GasInfo) GetCurrentTotal() float64 {
+	if x != nil {
+		return x.CurrentTotal
+	}
+	return 0
+}
+
+func (x *Account_GasInfo) GetTransferableGas() float64 {
+	if x != nil {
+		return x.TransferableGas
+	}
+	return 0
+}
+
+func (x *Account_GasInfo) GetPledgeGas() float64 {
+	if x != nil {
+		return x.PledgeGas
+	}
+	return 0
+}
+
+func (x *Account_GasInfo) GetIncreaseSpeed() float64 {
+	if x != nil {
+		return x.IncreaseSpeed
+	}
+	return 0
+}
+
+func (x *Account_GasInfo) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Account_GasInfo) GetPledgedInfo() []*Account_PledgeInfo {
+	if x != nil {
+		return x.PledgedInfo
+	}
+	return nil
+}
+
+// The message defines account ram information.
+type Account_RAMInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// available ram bytes
+	Available int64 `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	// used ram bytes
+	Used int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// total ram bytes
+	Total int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *Account_RAMInfo) Reset() {
+	*x = Account_RAMInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account_RAMInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account_RAMInfo) ProtoMessage() {}
+
+func (x *Account_RAMInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account_RAMInfo.ProtoReflect.Descriptor instead.
+func (*Account_RAMInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{27, 2}
+}
+
+func (x *Account_RAMInfo) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *Account_RAMInfo) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *Account_RAMInfo) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// The message defines permission item.
+type Account_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// permission name or key pair id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// whether it's a key pair
+	IsKeyPair bool `protobuf:"varint,2,opt,name=is_key_pair,json=isKeyPair,proto3" json:"is_key_pair,omitempty"`
+	// permission weight
+	Weight int64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// permission
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (x *Account_Item) Reset() {
+	*x = Account_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account_Item) ProtoMessage() {}
+
+func (x *Account_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account_Item.ProtoReflect.Descriptor instead.
+func (*Account_Item) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{27, 3}
+}
+
+func (x *Account_Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account_Item) GetIsKeyPair() bool {
+	if x != nil {
+		return x.IsKeyPair
+	}
+	return false
+}
+
+func (x *Account_Item) GetWeight() int64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Account_Item) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+// The message defines a permission group.
+type Account_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// permission items
+	Items []*Account_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Account_Group) Reset() {
+	*x = Account_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account_Group) ProtoMessage() {}
+
+func (x *Account_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account_Group.ProtoReflect.Descriptor instead.
+func (*Account_Group) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{27, 4}
+}
+
+func (x *Account_Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account_Group) GetItems() []*Account_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// The message defines the permission struct.
+type Account_Permission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// permission name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// permission groups
+	GroupNames []string `protobuf:"bytes,2,rep,name=group_names,json=groupNames,proto3" json:"group_names,omitempty"`
+	// permission items
+	Items []*Account_Item `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// permission threshold
+	Threshold int64 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *Account_Permission) Reset() {
+	*x = Account_Permission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account_Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account_Permission) ProtoMessage() {}
+
+func (x *Account_Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account_Permission.ProtoReflect.Descriptor instead.
+func (*Account_Permission) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{27, 5}
+}
+
+func (x *Account_Permission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account_Permission) GetGroupNames() []string {
+	if x != nil {
+		return x.GroupNames
+	}
+	return nil
+}
+
+func (x *Account_Permission) GetItems() []*Account_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Account_Permission) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// The message defines the ABI struct.
+type Contract_ABI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// abi name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// abi arguments
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// abi amount limt
+	AmountLimit []*AmountLimit `protobuf:"bytes,3,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+}
+
+func (x *Contract_ABI) Reset() {
+	*x = Contract_ABI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contract_ABI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contract_ABI) ProtoMessage() {}
+
+func (x *Contract_ABI) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contract_ABI.ProtoReflect.Descriptor instead.
+func (*Contract_ABI) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *Contract_ABI) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contract_ABI) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Contract_ABI) GetAmountLimit() []*AmountLimit {
+	if x != nil {
+		return x.AmountLimit
+	}
+	return nil
+}
+
+// The message defines the values stored under a key pattern.
+type Contract_StorageItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key pattern, "{name}" matches any text
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// field pattern of map storage, empty for kv storage
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// value type, one of string, number, bool and json
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// description
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Contract_StorageItem) Reset() {
+	*x = Contract_StorageItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contract_StorageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contract_StorageItem) ProtoMessage() {}
+
+func (x *Contract_StorageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contract_StorageItem.ProtoReflect.Descriptor instead.
+func (*Contract_StorageItem) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *Contract_StorageItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Contract_StorageItem) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Contract_StorageItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Contract_StorageItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// The message defines GetContractStorage request params.
+type GetBatchContractStorageRequest_KeyField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the key in StateDB
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// get the value from StateDB, fields is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *GetBatchContractStorageRequest_KeyField) Reset() {
+	*x = GetBatchContractStorageRequest_KeyField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchContractStorageRequest_KeyField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchContractStorageRequest_KeyField) ProtoMessage() {}
+
+func (x *GetBatchContractStorageRequest_KeyField) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchContractStorageRequest_KeyField.ProtoReflect.Descriptor instead.
 func (*GetBatchContractStorageRequest_KeyField) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{32, 0}
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *GetBatchContractStorageRequest_KeyField) GetKey() string {
@@ -4883,12 +6807,18 @@ type ListContractStorageResponse_Data struct {
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	// the value from StateDB
 	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// the map field, set if decoded
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// type of the value in the storage schema, empty if not decoded
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// params matched by the patterns of the storage schema
+	Params map[string]string `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ListContractStorageResponse_Data) Reset() {
 	*x = ListContractStorageResponse_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[65]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4898,65 +6828,182 @@ func (x *ListContractStorageResponse_Data) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContractStorageResponse_Data) ProtoMessage() {}
-
-func (x *ListContractStorageResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[65]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (*ListContractStorageResponse_Data) ProtoMessage() {}
+
+func (x *ListContractStorageResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContractStorageResponse_Data.ProtoReflect.Descriptor instead.
+func (*ListContractStorageResponse_Data) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *ListContractStorageResponse_Data) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListContractStorageResponse_Data) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ListContractStorageResponse_Data) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ListContractStorageResponse_Data) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListContractStorageResponse_Data) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type SubscribeRequest_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract id
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// block witness, for block events
+	Witness string `protobuf:"bytes,2,opt,name=witness,proto3" json:"witness,omitempty"`
+	// tx publisher, for contract and tx events
+	Publisher string `protobuf:"bytes,3,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// contract action name, for contract events
+	ActionName string `protobuf:"bytes,4,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	// recipient of token.iost transfers, for contract events
+	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// predicates on the event data as json, all of them must be satisfied
+	Predicates []*EventPredicate `protobuf:"bytes,6,rep,name=predicates,proto3" json:"predicates,omitempty"`
+}
+
+func (x *SubscribeRequest_Filter) Reset() {
+	*x = SubscribeRequest_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pb_rpc_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest_Filter) ProtoMessage() {}
+
+func (x *SubscribeRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest_Filter.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *SubscribeRequest_Filter) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+func (x *SubscribeRequest_Filter) GetWitness() string {
+	if x != nil {
+		return x.Witness
+	}
+	return ""
+}
+
+func (x *SubscribeRequest_Filter) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListContractStorageResponse_Data.ProtoReflect.Descriptor instead.
-func (*ListContractStorageResponse_Data) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{37, 0}
+func (x *SubscribeRequest_Filter) GetActionName() string {
+	if x != nil {
+		return x.ActionName
+	}
+	return ""
 }
 
-func (x *ListContractStorageResponse_Data) GetKey() string {
+func (x *SubscribeRequest_Filter) GetRecipient() string {
 	if x != nil {
-		return x.Key
+		return x.Recipient
 	}
 	return ""
 }
 
-func (x *ListContractStorageResponse_Data) GetValue() string {
+func (x *SubscribeRequest_Filter) GetPredicates() []*EventPredicate {
 	if x != nil {
-		return x.Value
+		return x.Predicates
 	}
-	return ""
+	return nil
 }
 
-type SubscribeRequest_Filter struct {
+// The message defines the number of pending transactions with a gas ratio.
+type TxPoolStatsResponse_GasRatioCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// contract id
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// gas ratio
+	GasRatio float64 `protobuf:"fixed64,1,opt,name=gas_ratio,json=gasRatio,proto3" json:"gas_ratio,omitempty"`
+	// number of transactions
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *SubscribeRequest_Filter) Reset() {
-	*x = SubscribeRequest_Filter{}
+func (x *TxPoolStatsResponse_GasRatioCount) Reset() {
+	*x = TxPoolStatsResponse_GasRatioCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_pb_rpc_proto_msgTypes[66]
+		mi := &file_rpc_pb_rpc_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeRequest_Filter) String() string {
+func (x *TxPoolStatsResponse_GasRatioCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeRequest_Filter) ProtoMessage() {}
+func (*TxPoolStatsResponse_GasRatioCount) ProtoMessage() {}
 
-func (x *SubscribeRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_pb_rpc_proto_msgTypes[66]
+func (x *TxPoolStatsResponse_GasRatioCount) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pb_rpc_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4967,16 +7014,23 @@ func (x *SubscribeRequest_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeRequest_Filter.ProtoReflect.Descriptor instead.
-func (*SubscribeRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{46, 0}
+// Deprecated: Use TxPoolStatsResponse_GasRatioCount.ProtoReflect.Descriptor instead.
+func (*TxPoolStatsResponse_GasRatioCount) Descriptor() ([]byte, []int) {
+	return file_rpc_pb_rpc_proto_rawDescGZIP(), []int{63, 0}
 }
 
-func (x *SubscribeRequest_Filter) GetContractId() string {
+func (x *TxPoolStatsResponse_GasRatioCount) GetGasRatio() float64 {
 	if x != nil {
-		return x.ContractId
+		return x.GasRatio
 	}
-	return ""
+	return 0
+}
+
+func (x *TxPoolStatsResponse_GasRatioCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
 var File_rpc_pb_rpc_proto protoreflect.FileDescriptor
@@ -4987,6 +7041,9 @@ var file_rpc_pb_rpc_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c,
 	0x6f, 0x63, 0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x78, 0x2f, 0x70, 0x62, 0x2f, 0x74,
+	0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f,
+	0x70, 0x62, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x22, 0x3c, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66,
 	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
@@ -5025,7 +7082,7 @@ var file_rpc_pb_rpc_proto_rawDesc = []byte{
 	0x61, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdf, 0x04, 0x0a, 0x09, 0x54, 0x78, 0x52,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaa, 0x06, 0x0a, 0x09, 0x54, 0x78, 0x52,
 	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
 	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
 	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -5044,399 +7101,482 @@ var file_rpc_pb_rpc_proto_rawDesc = []byte{
 	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
 	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x2e,
 	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x61, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40,
-	0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e,
-	0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75,
-	0x6e, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x22, 0xb7, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
-	0x47, 0x41, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a,
-	0x12, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x4f,
-	0x55, 0x47, 0x48, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x50,
-	0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x55,
-	0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x0b, 0x0a,
-	0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x52,
-	0x4f, 0x4e, 0x47, 0x5f, 0x54, 0x58, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x06, 0x12,
-	0x16, 0x0a, 0x12, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x54,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x08, 0x22, 0xaa, 0x03, 0x0a, 0x0b, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
-	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c,
-	0x61, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a,
-	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x1f,
-	0x0a, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x12,
-	0x35, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x09, 0x74, 0x78,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41,
-	0x43, 0x4b, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x52, 0x52, 0x45, 0x56, 0x45,
-	0x52, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x34, 0x0a,
-	0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x32,
-	0x35, 0x36, 0x4b, 0x31, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31,
-	0x39, 0x10, 0x02, 0x22, 0xb6, 0x03, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x67,
-	0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
-	0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x19,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0b, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72,
-	0x5f, 0x73, 0x69, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0d, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x53, 0x69, 0x67, 0x73, 0x22, 0x80, 0x04, 0x0a,
-	0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x78, 0x5f, 0x6d, 0x65, 0x72, 0x6b,
-	0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
-	0x78, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a, 0x16, 0x74,
-	0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x78, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x74, 0x6e,
-	0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x69, 0x74, 0x6e, 0x65,
-	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x67, 0x61, 0x73, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
-	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x53, 0x0a, 0x04, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
-	0x91, 0x01, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x27, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
-	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x52, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x49, 0x42, 0x4c,
-	0x45, 0x10, 0x01, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x27, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x10, 0x0a,
-	0x0c, 0x49, 0x52, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x22,
-	0x4b, 0x0a, 0x1a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
-	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x52, 0x61, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x07, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x8b, 0x01,
+	0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x0a,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x41, 0x53, 0x5f, 0x52,
+	0x55, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x4c, 0x41,
+	0x4e, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x4f, 0x55, 0x47, 0x48, 0x10, 0x02,
+	0x12, 0x13, 0x0a, 0x0f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45,
+	0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x49, 0x4d, 0x45,
+	0x4f, 0x55, 0x54, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x54,
+	0x58, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x55,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x08, 0x22, 0xaa, 0x03, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x67,
+	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x09, 0x74, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x33,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x52, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x49, 0x42, 0x4c,
+	0x45, 0x10, 0x02, 0x22, 0xd0, 0x01, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x4c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36, 0x4b, 0x31, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36, 0x52, 0x31, 0x10, 0x03, 0x12, 0x07, 0x0a,
+	0x03, 0x42, 0x4c, 0x53, 0x10, 0x04, 0x22, 0xb6, 0x03, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1b,
+	0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x07,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x53, 0x69, 0x67, 0x73, 0x22,
+	0x9f, 0x04, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x78, 0x5f, 0x6d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x78, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33,
+	0x0a, 0x16, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x74, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73,
+	0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x67, 0x61,
+	0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x69,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x1a, 0x53, 0x0a, 0x04,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x27, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x52, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x49,
+	0x42, 0x4c, 0x45, 0x10, 0x01, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xcb, 0x03, 0x0a,
-	0x11, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
-	0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x65, 0x61,
-	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69,
-	0x62, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c,
-	0x69, 0x62, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x62, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6c, 0x69, 0x62, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a,
-	0x0c, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x28, 0x0a, 0x10, 0x6c, 0x69, 0x62, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69, 0x62, 0x57,
-	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f,
-	0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x62, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x69,
-	0x62, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x54, 0x78,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22,
-	0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x4d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22,
-	0x3b, 0x0a, 0x0d, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x08,
-	0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65,
-	0x64, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63,
-	0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73,
-	0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62,
-	0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xd7, 0x01,
-	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c,
-	0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x10, 0x47, 0x61, 0x73, 0x52, 0x61,
-	0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c,
-	0x6f, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73,
-	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f,
-	0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0e, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22,
-	0xfd, 0x09, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x67, 0x61, 0x73,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x47, 0x61, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x31, 0x0a, 0x08,
-	0x72, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52,
-	0x41, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x41, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
-	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x3e, 0x0a, 0x0a, 0x50, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xf3, 0x01, 0x0a, 0x07, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x61,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x47, 0x61, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61,
-	0x73, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3c, 0x0a,
-	0x0c, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x2e, 0x50, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
-	0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x51, 0x0a, 0x07, 0x52,
-	0x41, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x6e,
-	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x6b, 0x65, 0x79,
-	0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4b,
-	0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x46,
-	0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63,
+	0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x27, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x10, 0x0a, 0x0c, 0x49, 0x52, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10,
+	0x01, 0x22, 0x4b, 0x0a, 0x1a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xcb,
+	0x03, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68,
+	0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x69, 0x62, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6c, 0x69, 0x62, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x62,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x69, 0x62, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x69, 0x62, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69,
+	0x62, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x62, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x6c, 0x69, 0x62, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x23, 0x0a, 0x0d,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0xe1, 0x01, 0x0a, 0x18, 0x54, 0x78, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69,
+	0x67, 0x6e, 0x12, 0x2a, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x74, 0x78, 0x70, 0x62, 0x2e, 0x54,
+	0x78, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0xe5, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x73, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x78, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x47, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x4d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x3b, 0x0a,
+	0x0d, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x08, 0x56, 0x6f,
+	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x5f,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79,
+	0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0xd7,
+	0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f,
+	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x10, 0x47, 0x61, 0x73, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x47, 0x61,
+	0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x22, 0xfd, 0x09, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x67, 0x61,
+	0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x47, 0x61, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x31, 0x0a,
+	0x08, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e,
+	0x52, 0x41, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x41, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x7a, 0x65,
+	0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x76, 0x6f, 0x74,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x3e, 0x0a, 0x0a, 0x50, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xf3, 0x01, 0x0a, 0x07, 0x47, 0x61, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x47,
+	0x61, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x47, 0x61,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x72, 0x65,
+	0x61, 0x73, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3c,
+	0x0a, 0x0c, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x51, 0x0a, 0x07,
+	0x52, 0x41, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a,
+	0x6e, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a,
+	0x46, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x8a, 0x01, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63,
 	0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x8a, 0x01, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x1a, 0x59, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4f,
-	0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x51, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c,
-	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x22, 0x90, 0x02, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69,
-	0x67, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72,
-	0x69, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x62, 0x69, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x41, 0x42, 0x49, 0x52, 0x04, 0x61, 0x62, 0x69, 0x73, 0x1a,
-	0x64, 0x0a, 0x03, 0x41, 0x42, 0x49, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x35,
-	0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3e, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x4e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x62,
-	0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x7d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79,
-	0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x22, 0x72, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xdd, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x0a, 0x6b,
-	0x65, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
-	0x09, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79,
-	0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x1a, 0x59, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x4f, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x93, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79,
+	0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x1a, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x79, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb4, 0x03, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x62,
+	0x69, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x41, 0x42, 0x49, 0x52, 0x04, 0x61,
+	0x62, 0x69, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x64, 0x0a, 0x03, 0x41, 0x42,
+	0x49, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x1a, 0x6b, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2e, 0x0a,
+	0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x90, 0x01,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0xd7, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
 	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x22, 0x6d, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c,
-	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x22, 0x7c, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x1a, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x79, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0xaf, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x7c, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
 	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1d,
@@ -5444,118 +7584,216 @@ var file_rpc_pb_rpc_proto_rawDesc = []byte{
 	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a,
 	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x22, 0x99, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x4f, 0x0a, 0x0b, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x2d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74,
-	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79,
-	0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x1e, 0x0a, 0x0b,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4b,
-	0x56, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41, 0x50, 0x10, 0x01, 0x22, 0xce, 0x01, 0x0a,
-	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x70,
+	0x22, 0xba, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c,
+	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0xab, 0x02,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x65, 0x61, 0x66, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf3, 0x02, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x4f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x72, 0x70,
 	0x63, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x2e, 0x0a,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x65, 0x0a,
-	0x17, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x36, 0x0a, 0x0e,
-	0x70, 0x72, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x54, 0x78, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x22, 0x72, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x66, 0x72, 0x6f,
-	0x7a, 0x65, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65,
-	0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74,
-	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79,
-	0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x52, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x73,
-	0x22, 0x73, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x49,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x1e, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x06, 0x0a, 0x02, 0x4b, 0x56, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41, 0x50, 0x10,
+	0x01, 0x22, 0x95, 0x03, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x1a, 0xe0, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x4b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x65, 0x0a, 0x17, 0x53, 0x65, 0x6e,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x36, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x5f,
+	0x74, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x22, 0x72, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x28, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x52, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x73, 0x22,
+	0xb5, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x49,
 	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x62,
 	0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x39, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x37, 0x32, 0x31, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x30, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
-	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x31,
-	0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x54, 0x52,
-	0x41, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10,
-	0x01, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x29, 0x0a, 0x06, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x94,
-	0x01, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x62, 0x6f,
-	0x6e, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65,
-	0x72, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x39, 0x0a, 0x0b, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x26, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x22, 0x57, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x10,
-	0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73,
-	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xf8, 0x02, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x39, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x30, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32,
+	0x31, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x22, 0x80, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x79, 0x0a, 0x05,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43,
+	0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43,
+	0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x4e, 0x45, 0x57, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x16,
+	0x0a, 0x12, 0x49, 0x52, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
+	0x52, 0x45, 0x4f, 0x52, 0x47, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x58, 0x5f, 0x44, 0x52,
+	0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x05, 0x22, 0x46, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x85, 0x03, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0xd7, 0x01,
+	0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28,
+	0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x2e, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x42,
+	0x0a, 0x02, 0x4f, 0x70, 0x12, 0x06, 0x0a, 0x02, 0x45, 0x51, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
+	0x4e, 0x45, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x47, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
+	0x47, 0x54, 0x45, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x54, 0x10, 0x04, 0x12, 0x07, 0x0a,
+	0x03, 0x4c, 0x54, 0x45, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53,
+	0x10, 0x06, 0x22, 0x37, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0a,
+	0x56, 0x6f, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f,
+	0x6e, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73,
+	0x12, 0x35, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x42, 0x6f,
+	0x6e, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x39, 0x0a, 0x0b, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x26, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x6f, 0x6e, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79,
+	0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0xf8, 0x02, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
 	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -5579,216 +7817,405 @@ var file_rpc_pb_rpc_proto_rawDesc = []byte{
 	0x30, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c,
 	0x79, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x63,
 	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x32, 0xd9, 0x19, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x51, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x54, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x67, 0x65, 0x74,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4e, 0x0a, 0x0a, 0x47, 0x65, 0x74,
-	0x52, 0x41, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x41, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x67,
-	0x65, 0x74, 0x52, 0x41, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x54, 0x78, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x15, 0x12, 0x13, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x78, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68,
-	0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x64, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x78,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
-	0x1c, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x79,
-	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x6f, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x67, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x2f, 0x7b, 0x68, 0x61,
-	0x73, 0x68, 0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x7d, 0x12, 0x77,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
-	0x12, 0x25, 0x2f, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x2f, 0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52,
-	0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a,
-	0x12, 0x28, 0x2f, 0x67, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2f, 0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f,
-	0x7b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12,
-	0x65, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e,
-	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12,
-	0x25, 0x2f, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x37, 0x12, 0x35, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x7b, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73,
-	0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37,
-	0x32, 0x31, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x67, 0x65, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x7b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d,
-	0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x37, 0x32, 0x31, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x37, 0x32, 0x31, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x7b, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x7d, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x74, 0x22, 0x67, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xb5, 0x01, 0x0a, 0x12, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x6f, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x98, 0x03, 0x0a, 0x13, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x09, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a,
+	0x19, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x16, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x1a, 0x42, 0x0a, 0x0d, 0x47, 0x61, 0x73, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x67, 0x61,
+	0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd4, 0x02, 0x0a,
+	0x1b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x09, 0x72, 0x61, 0x6d, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x61, 0x6d, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x47, 0x61,
+	0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x61, 0x6d, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65,
+	0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdc, 0x02, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
+	0x62, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x07, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x26, 0x0a,
+	0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x70, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x28, 0x0a,
+	0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52,
+	0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x32, 0x81, 0x21, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x67, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x54, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
+	0x12, 0x0d, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x4e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x41, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x41, 0x4d, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x67, 0x65, 0x74, 0x52, 0x41, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x78, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x78,
+	0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x64, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x79, 0x54,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x24, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x2f, 0x7b, 0x68, 0x61,
+	0x73, 0x68, 0x7d, 0x12, 0x71, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x78, 0x49, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x67, 0x65, 0x74, 0x54,
+	0x78, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x2f,
+	0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x67, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x6f, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x2f,
+	0x7b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x7d, 0x12, 0x77, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x67,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2f,
+	0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x67,
+	0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x2f, 0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x22, 0x16, 0x2f, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x67, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
 	0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x7d, 0x12, 0x93, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37,
-	0x32, 0x31, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x6e, 0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f,
+	0x67, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f,
+	0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x37, 0x32, 0x31, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x37, 0x32, 0x31, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x2f, 0x7b, 0x62, 0x79,
+	0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12,
+	0x9c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
 	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12,
-	0x37, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73,
-	0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x51, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47,
-	0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f,
-	0x67, 0x65, 0x74, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x97, 0x01, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x33, 0x12, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
-	0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x67, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f,
-	0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x73,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74,
-	0x65, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74,
-	0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x67, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x7d, 0x12, 0x79, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x8d,
-	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x22, 0x18, 0x2f, 0x67, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7d,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d,
+	0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f,
+	0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x93,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x67, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x37, 0x32, 0x31, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x2f, 0x7b,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x7d, 0x12, 0x51, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x61, 0x73, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x67, 0x65, 0x74, 0x47,
+	0x61, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x97, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31,
+	0x2f, 0x67, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x7b,
+	0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x7d, 0x12, 0x67, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x73, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x19, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x30, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x62, 0x79,
+	0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12,
+	0x79, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x22, 0x13, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x8d, 0x01, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f,
+	0x67, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x13, 0x4c, 0x69,
 	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x91, 0x01,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x3a, 0x01,
-	0x2a, 0x12, 0x60, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x22, 0x07, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x78,
-	0x3a, 0x01, 0x2a, 0x12, 0x52, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x22, 0x07, 0x2f, 0x65, 0x78,
-	0x65, 0x63, 0x54, 0x78, 0x3a, 0x01, 0x2a, 0x12, 0x57, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22,
-	0x0a, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x3a, 0x01, 0x2a, 0x30, 0x01,
-	0x12, 0x6e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x75,
-	0x73, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x22, 0x30,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x67, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65,
-	0x72, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x62,
-	0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d,
-	0x12, 0x7a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c,
-	0x2f, 0x67, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e,
-	0x75, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f,
-	0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x6f, 0x0a, 0x0c,
-	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2b, 0x12, 0x29, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x2f, 0x7b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c,
-	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x42, 0x2f, 0x5a,
-	0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x73, 0x74,
-	0x2d, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x2f, 0x67, 0x6f, 0x2d, 0x69, 0x6f, 0x73,
-	0x74, 0x2f, 0x76, 0x33, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x22, 0x14, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x91, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22,
+	0x19, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x22, 0x0e, 0x2f, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x3a, 0x01, 0x2a, 0x12, 0x60, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x22, 0x07, 0x2f, 0x73, 0x65, 0x6e,
+	0x64, 0x54, 0x78, 0x3a, 0x01, 0x2a, 0x12, 0x52, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x22, 0x07,
+	0x2f, 0x65, 0x78, 0x65, 0x63, 0x54, 0x78, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x13, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22, 0x0b, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x54, 0x78, 0x3a, 0x01, 0x2a, 0x12, 0x63, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d,
+	0x22, 0x08, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x3a, 0x01, 0x2a, 0x12, 0x57, 0x0a,
+	0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x6e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x72, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x42,
+	0x6f, 0x6e, 0x75, 0x73, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x67,
+	0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x7a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x22, 0x34, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x7d, 0x12, 0x6f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2f, 0x7b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x7d,
+	0x2f, 0x7b, 0x62, 0x79, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x8d, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x22, 0x18, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x5a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54,
+	0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x67, 0x65, 0x74,
+	0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x73, 0x74, 0x2d, 0x6f,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x2f, 0x67, 0x6f, 0x2d, 0x69, 0x6f, 0x73, 0x74, 0x2f,
+	0x76, 0x33, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5803,8 +8230,8 @@ func file_rpc_pb_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_pb_rpc_proto_rawDescData
 }
 
-var file_rpc_pb_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_rpc_pb_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_rpc_pb_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_rpc_pb_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_rpc_pb_rpc_proto_goTypes = []interface{}{
 	(TxReceipt_StatusCode)(0),                       // 0: rpcpb.TxReceipt.StatusCode
 	(TransactionResponse_Status)(0),                 // 1: rpcpb.TransactionResponse.Status
@@ -5813,183 +8240,249 @@ var file_rpc_pb_rpc_proto_goTypes = []interface{}{
 	(RawBlockResponse_Status)(0),                    // 4: rpcpb.RawBlockResponse.Status
 	(ListContractStorageRequest_StorageType)(0),     // 5: rpcpb.ListContractStorageRequest.StorageType
 	(Event_Topic)(0),                                // 6: rpcpb.Event.Topic
-	(*EmptyRequest)(nil),                            // 7: rpcpb.EmptyRequest
-	(*NetworkInfo)(nil),                             // 8: rpcpb.NetworkInfo
-	(*RAMInfoResponse)(nil),                         // 9: rpcpb.RAMInfoResponse
-	(*NodeInfoResponse)(nil),                        // 10: rpcpb.NodeInfoResponse
-	(*AmountLimit)(nil),                             // 11: rpcpb.AmountLimit
-	(*Action)(nil),                                  // 12: rpcpb.Action
-	(*TxReceipt)(nil),                               // 13: rpcpb.TxReceipt
-	(*Transaction)(nil),                             // 14: rpcpb.Transaction
-	(*TransactionResponse)(nil),                     // 15: rpcpb.TransactionResponse
-	(*Signature)(nil),                               // 16: rpcpb.Signature
-	(*TransactionRequest)(nil),                      // 17: rpcpb.TransactionRequest
-	(*Block)(nil),                                   // 18: rpcpb.Block
-	(*BlockResponse)(nil),                           // 19: rpcpb.BlockResponse
-	(*RawBlockResponse)(nil),                        // 20: rpcpb.RawBlockResponse
-	(*BlockHeaderByRangeResponse)(nil),              // 21: rpcpb.BlockHeaderByRangeResponse
-	(*ChainInfoResponse)(nil),                       // 22: rpcpb.ChainInfoResponse
-	(*TxHashRequest)(nil),                           // 23: rpcpb.TxHashRequest
-	(*GetBlockByHashRequest)(nil),                   // 24: rpcpb.GetBlockByHashRequest
-	(*GetBlockByNumberRequest)(nil),                 // 25: rpcpb.GetBlockByNumberRequest
-	(*GetBlockHeaderByRangeRequest)(nil),            // 26: rpcpb.GetBlockHeaderByRangeRequest
-	(*FrozenBalance)(nil),                           // 27: rpcpb.FrozenBalance
-	(*VoteInfo)(nil),                                // 28: rpcpb.VoteInfo
-	(*GetProducerVoteInfoRequest)(nil),              // 29: rpcpb.GetProducerVoteInfoRequest
-	(*GetProducerVoteInfoResponse)(nil),             // 30: rpcpb.GetProducerVoteInfoResponse
-	(*GasRatioResponse)(nil),                        // 31: rpcpb.GasRatioResponse
-	(*Account)(nil),                                 // 32: rpcpb.Account
-	(*GetAccountRequest)(nil),                       // 33: rpcpb.GetAccountRequest
-	(*Contract)(nil),                                // 34: rpcpb.Contract
-	(*ContractVote)(nil),                            // 35: rpcpb.ContractVote
-	(*GetContractRequest)(nil),                      // 36: rpcpb.GetContractRequest
-	(*GetContractStorageRequest)(nil),               // 37: rpcpb.GetContractStorageRequest
-	(*GetContractStorageResponse)(nil),              // 38: rpcpb.GetContractStorageResponse
-	(*GetBatchContractStorageRequest)(nil),          // 39: rpcpb.GetBatchContractStorageRequest
-	(*GetBatchContractStorageResponse)(nil),         // 40: rpcpb.GetBatchContractStorageResponse
-	(*GetContractStorageFieldsRequest)(nil),         // 41: rpcpb.GetContractStorageFieldsRequest
-	(*GetContractStorageFieldsResponse)(nil),        // 42: rpcpb.GetContractStorageFieldsResponse
-	(*ListContractStorageRequest)(nil),              // 43: rpcpb.ListContractStorageRequest
-	(*ListContractStorageResponse)(nil),             // 44: rpcpb.ListContractStorageResponse
-	(*SendTransactionResponse)(nil),                 // 45: rpcpb.SendTransactionResponse
-	(*GetTokenBalanceResponse)(nil),                 // 46: rpcpb.GetTokenBalanceResponse
-	(*GetTokenBalanceRequest)(nil),                  // 47: rpcpb.GetTokenBalanceRequest
-	(*GetToken721BalanceResponse)(nil),              // 48: rpcpb.GetToken721BalanceResponse
-	(*GetToken721InfoRequest)(nil),                  // 49: rpcpb.GetToken721InfoRequest
-	(*GetToken721MetadataResponse)(nil),             // 50: rpcpb.GetToken721MetadataResponse
-	(*GetToken721OwnerResponse)(nil),                // 51: rpcpb.GetToken721OwnerResponse
-	(*Event)(nil),                                   // 52: rpcpb.Event
-	(*SubscribeRequest)(nil),                        // 53: rpcpb.SubscribeRequest
-	(*SubscribeResponse)(nil),                       // 54: rpcpb.SubscribeResponse
-	(*VoterBonus)(nil),                              // 55: rpcpb.VoterBonus
-	(*CandidateBonus)(nil),                          // 56: rpcpb.CandidateBonus
-	(*GetTokenInfoRequest)(nil),                     // 57: rpcpb.GetTokenInfoRequest
-	(*TokenInfo)(nil),                               // 58: rpcpb.TokenInfo
-	nil,                                             // 59: rpcpb.TxReceipt.RamUsageEntry
-	(*TxReceipt_Receipt)(nil),                       // 60: rpcpb.TxReceipt.Receipt
-	(*Block_Info)(nil),                              // 61: rpcpb.Block.Info
-	(*Account_PledgeInfo)(nil),                      // 62: rpcpb.Account.PledgeInfo
-	(*Account_GasInfo)(nil),                         // 63: rpcpb.Account.GasInfo
-	(*Account_RAMInfo)(nil),                         // 64: rpcpb.Account.RAMInfo
-	(*Account_Item)(nil),                            // 65: rpcpb.Account.Item
-	(*Account_Group)(nil),                           // 66: rpcpb.Account.Group
-	(*Account_Permission)(nil),                      // 67: rpcpb.Account.Permission
-	nil,                                             // 68: rpcpb.Account.PermissionsEntry
-	nil,                                             // 69: rpcpb.Account.GroupsEntry
-	(*Contract_ABI)(nil),                            // 70: rpcpb.Contract.ABI
-	(*GetBatchContractStorageRequest_KeyField)(nil), // 71: rpcpb.GetBatchContractStorageRequest.KeyField
-	(*ListContractStorageResponse_Data)(nil),        // 72: rpcpb.ListContractStorageResponse.Data
-	(*SubscribeRequest_Filter)(nil),                 // 73: rpcpb.SubscribeRequest.Filter
-	nil,                                             // 74: rpcpb.VoterBonus.DetailEntry
-	(*pb.Block)(nil),                                // 75: blockpb.Block
+	(EventPredicate_Op)(0),                          // 7: rpcpb.EventPredicate.Op
+	(*EmptyRequest)(nil),                            // 8: rpcpb.EmptyRequest
+	(*NetworkInfo)(nil),                             // 9: rpcpb.NetworkInfo
+	(*RAMInfoResponse)(nil),                         // 10: rpcpb.RAMInfoResponse
+	(*NodeInfoResponse)(nil),                        // 11: rpcpb.NodeInfoResponse
+	(*AmountLimit)(nil),                             // 12: rpcpb.AmountLimit
+	(*Action)(nil),                                  // 13: rpcpb.Action
+	(*TxReceipt)(nil),                               // 14: rpcpb.TxReceipt
+	(*Transaction)(nil),                             // 15: rpcpb.Transaction
+	(*TransactionResponse)(nil),                     // 16: rpcpb.TransactionResponse
+	(*Signature)(nil),                               // 17: rpcpb.Signature
+	(*TransactionRequest)(nil),                      // 18: rpcpb.TransactionRequest
+	(*Block)(nil),                                   // 19: rpcpb.Block
+	(*BlockResponse)(nil),                           // 20: rpcpb.BlockResponse
+	(*RawBlockResponse)(nil),                        // 21: rpcpb.RawBlockResponse
+	(*BlockHeaderByRangeResponse)(nil),              // 22: rpcpb.BlockHeaderByRangeResponse
+	(*ChainInfoResponse)(nil),                       // 23: rpcpb.ChainInfoResponse
+	(*TxHashRequest)(nil),                           // 24: rpcpb.TxHashRequest
+	(*TxInclusionProofResponse)(nil),                // 25: rpcpb.TxInclusionProofResponse
+	(*ReceiptInclusionProofResponse)(nil),           // 26: rpcpb.ReceiptInclusionProofResponse
+	(*GetBlockByHashRequest)(nil),                   // 27: rpcpb.GetBlockByHashRequest
+	(*GetBlockByNumberRequest)(nil),                 // 28: rpcpb.GetBlockByNumberRequest
+	(*GetBlockHeaderByRangeRequest)(nil),            // 29: rpcpb.GetBlockHeaderByRangeRequest
+	(*FrozenBalance)(nil),                           // 30: rpcpb.FrozenBalance
+	(*VoteInfo)(nil),                                // 31: rpcpb.VoteInfo
+	(*GetProducerVoteInfoRequest)(nil),              // 32: rpcpb.GetProducerVoteInfoRequest
+	(*GetProducerVoteInfoResponse)(nil),             // 33: rpcpb.GetProducerVoteInfoResponse
+	(*GasRatioResponse)(nil),                        // 34: rpcpb.GasRatioResponse
+	(*Account)(nil),                                 // 35: rpcpb.Account
+	(*GetAccountRequest)(nil),                       // 36: rpcpb.GetAccountRequest
+	(*Contract)(nil),                                // 37: rpcpb.Contract
+	(*ContractVote)(nil),                            // 38: rpcpb.ContractVote
+	(*GetContractRequest)(nil),                      // 39: rpcpb.GetContractRequest
+	(*GetContractStorageRequest)(nil),               // 40: rpcpb.GetContractStorageRequest
+	(*GetContractStorageResponse)(nil),              // 41: rpcpb.GetContractStorageResponse
+	(*GetBatchContractStorageRequest)(nil),          // 42: rpcpb.GetBatchContractStorageRequest
+	(*GetBatchContractStorageResponse)(nil),         // 43: rpcpb.GetBatchContractStorageResponse
+	(*GetContractStorageFieldsRequest)(nil),         // 44: rpcpb.GetContractStorageFieldsRequest
+	(*GetContractStorageFieldsResponse)(nil),        // 45: rpcpb.GetContractStorageFieldsResponse
+	(*GetStateProofRequest)(nil),                    // 46: rpcpb.GetStateProofRequest
+	(*GetStateProofResponse)(nil),                   // 47: rpcpb.GetStateProofResponse
+	(*ListContractStorageRequest)(nil),              // 48: rpcpb.ListContractStorageRequest
+	(*ListContractStorageResponse)(nil),             // 49: rpcpb.ListContractStorageResponse
+	(*SendTransactionResponse)(nil),                 // 50: rpcpb.SendTransactionResponse
+	(*GetTokenBalanceResponse)(nil),                 // 51: rpcpb.GetTokenBalanceResponse
+	(*GetTokenBalanceRequest)(nil),                  // 52: rpcpb.GetTokenBalanceRequest
+	(*GetToken721BalanceResponse)(nil),              // 53: rpcpb.GetToken721BalanceResponse
+	(*GetToken721InfoRequest)(nil),                  // 54: rpcpb.GetToken721InfoRequest
+	(*GetToken721MetadataResponse)(nil),             // 55: rpcpb.GetToken721MetadataResponse
+	(*GetToken721OwnerResponse)(nil),                // 56: rpcpb.GetToken721OwnerResponse
+	(*Event)(nil),                                   // 57: rpcpb.Event
+	(*EventCursor)(nil),                             // 58: rpcpb.EventCursor
+	(*SubscribeRequest)(nil),                        // 59: rpcpb.SubscribeRequest
+	(*EventPredicate)(nil),                          // 60: rpcpb.EventPredicate
+	(*SubscribeResponse)(nil),                       // 61: rpcpb.SubscribeResponse
+	(*VoterBonus)(nil),                              // 62: rpcpb.VoterBonus
+	(*CandidateBonus)(nil),                          // 63: rpcpb.CandidateBonus
+	(*GetTokenInfoRequest)(nil),                     // 64: rpcpb.GetTokenInfoRequest
+	(*TokenInfo)(nil),                               // 65: rpcpb.TokenInfo
+	(*GetAccountTransactionsRequest)(nil),           // 66: rpcpb.GetAccountTransactionsRequest
+	(*AccountTransaction)(nil),                      // 67: rpcpb.AccountTransaction
+	(*GetAccountTransactionsResponse)(nil),          // 68: rpcpb.GetAccountTransactionsResponse
+	(*ListPendingTransactionsRequest)(nil),          // 69: rpcpb.ListPendingTransactionsRequest
+	(*ListPendingTransactionsResponse)(nil),         // 70: rpcpb.ListPendingTransactionsResponse
+	(*TxPoolStatsResponse)(nil),                     // 71: rpcpb.TxPoolStatsResponse
+	(*EstimateTransactionResponse)(nil),             // 72: rpcpb.EstimateTransactionResponse
+	(*StorageAccess)(nil),                           // 73: rpcpb.StorageAccess
+	(*ContractLog)(nil),                             // 74: rpcpb.ContractLog
+	(*CallFrame)(nil),                               // 75: rpcpb.CallFrame
+	(*TraceTransactionResponse)(nil),                // 76: rpcpb.TraceTransactionResponse
+	nil,                                             // 77: rpcpb.TxReceipt.RamUsageEntry
+	(*TxReceipt_Receipt)(nil),                       // 78: rpcpb.TxReceipt.Receipt
+	(*TxReceipt_StateChange)(nil),                   // 79: rpcpb.TxReceipt.StateChange
+	(*Block_Info)(nil),                              // 80: rpcpb.Block.Info
+	(*Account_PledgeInfo)(nil),                      // 81: rpcpb.Account.PledgeInfo
+	(*Account_GasInfo)(nil),                         // 82: rpcpb.Account.GasInfo
+	(*Account_RAMInfo)(nil),                         // 83: rpcpb.Account.RAMInfo
+	(*Account_Item)(nil),                            // 84: rpcpb.Account.Item
+	(*Account_Group)(nil),                           // 85: rpcpb.Account.Group
+	(*Account_Permission)(nil),                      // 86: rpcpb.Account.Permission
+	nil,                                             // 87: rpcpb.Account.PermissionsEntry
+	nil,                                             // 88: rpcpb.Account.GroupsEntry
+	(*Contract_ABI)(nil),                            // 89: rpcpb.Contract.ABI
+	(*Contract_StorageItem)(nil),                    // 90: rpcpb.Contract.StorageItem
+	(*GetBatchContractStorageRequest_KeyField)(nil), // 91: rpcpb.GetBatchContractStorageRequest.KeyField
+	(*ListContractStorageResponse_Data)(nil),        // 92: rpcpb.ListContractStorageResponse.Data
+	nil,                                             // 93: rpcpb.ListContractStorageResponse.Data.ParamsEntry
+	(*SubscribeRequest_Filter)(nil),                 // 94: rpcpb.SubscribeRequest.Filter
+	nil,                                             // 95: rpcpb.VoterBonus.DetailEntry
+	(*TxPoolStatsResponse_GasRatioCount)(nil),       // 96: rpcpb.TxPoolStatsResponse.GasRatioCount
+	nil,                   // 97: rpcpb.EstimateTransactionResponse.RamUsageEntry
+	(*pb.Block)(nil),      // 98: blockpb.Block
+	(*pb.BlockHead)(nil),  // 99: blockpb.BlockHead
+	(*pb1.Signature)(nil), // 100: sigpb.Signature
+	(*pb2.Tx)(nil),        // 101: txpb.Tx
+	(*pb2.TxReceipt)(nil), // 102: txpb.TxReceipt
 }
 var file_rpc_pb_rpc_proto_depIdxs = []int32{
-	8,  // 0: rpcpb.NodeInfoResponse.network:type_name -> rpcpb.NetworkInfo
-	59, // 1: rpcpb.TxReceipt.ram_usage:type_name -> rpcpb.TxReceipt.RamUsageEntry
-	0,  // 2: rpcpb.TxReceipt.status_code:type_name -> rpcpb.TxReceipt.StatusCode
-	60, // 3: rpcpb.TxReceipt.receipts:type_name -> rpcpb.TxReceipt.Receipt
-	12, // 4: rpcpb.Transaction.actions:type_name -> rpcpb.Action
-	11, // 5: rpcpb.Transaction.amount_limit:type_name -> rpcpb.AmountLimit
-	13, // 6: rpcpb.Transaction.tx_receipt:type_name -> rpcpb.TxReceipt
-	1,  // 7: rpcpb.TransactionResponse.status:type_name -> rpcpb.TransactionResponse.Status
-	14, // 8: rpcpb.TransactionResponse.transaction:type_name -> rpcpb.Transaction
-	2,  // 9: rpcpb.Signature.algorithm:type_name -> rpcpb.Signature.Algorithm
-	12, // 10: rpcpb.TransactionRequest.actions:type_name -> rpcpb.Action
-	11, // 11: rpcpb.TransactionRequest.amount_limit:type_name -> rpcpb.AmountLimit
-	16, // 12: rpcpb.TransactionRequest.signatures:type_name -> rpcpb.Signature
-	16, // 13: rpcpb.TransactionRequest.publisher_sigs:type_name -> rpcpb.Signature
-	61, // 14: rpcpb.Block.info:type_name -> rpcpb.Block.Info
-	14, // 15: rpcpb.Block.transactions:type_name -> rpcpb.Transaction
-	3,  // 16: rpcpb.BlockResponse.status:type_name -> rpcpb.BlockResponse.Status
-	18, // 17: rpcpb.BlockResponse.block:type_name -> rpcpb.Block
-	4,  // 18: rpcpb.RawBlockResponse.status:type_name -> rpcpb.RawBlockResponse.Status
-	75, // 19: rpcpb.RawBlockResponse.block:type_name -> blockpb.Block
-	75, // 20: rpcpb.BlockHeaderByRangeResponse.block_list:type_name -> blockpb.Block
-	63, // 21: rpcpb.Account.gas_info:type_name -> rpcpb.Account.GasInfo
-	64, // 22: rpcpb.Account.ram_info:type_name -> rpcpb.Account.RAMInfo
-	68, // 23: rpcpb.Account.permissions:type_name -> rpcpb.Account.PermissionsEntry
-	69, // 24: rpcpb.Account.groups:type_name -> rpcpb.Account.GroupsEntry
-	27, // 25: rpcpb.Account.frozen_balances:type_name -> rpcpb.FrozenBalance
-	28, // 26: rpcpb.Account.vote_infos:type_name -> rpcpb.VoteInfo
-	70, // 27: rpcpb.Contract.abis:type_name -> rpcpb.Contract.ABI
-	28, // 28: rpcpb.ContractVote.vote_infos:type_name -> rpcpb.VoteInfo
-	71, // 29: rpcpb.GetBatchContractStorageRequest.key_fields:type_name -> rpcpb.GetBatchContractStorageRequest.KeyField
-	5,  // 30: rpcpb.ListContractStorageRequest.storageType:type_name -> rpcpb.ListContractStorageRequest.StorageType
-	72, // 31: rpcpb.ListContractStorageResponse.datas:type_name -> rpcpb.ListContractStorageResponse.Data
-	13, // 32: rpcpb.SendTransactionResponse.pre_tx_receipt:type_name -> rpcpb.TxReceipt
-	27, // 33: rpcpb.GetTokenBalanceResponse.frozen_balances:type_name -> rpcpb.FrozenBalance
-	6,  // 34: rpcpb.Event.topic:type_name -> rpcpb.Event.Topic
-	6,  // 35: rpcpb.SubscribeRequest.topics:type_name -> rpcpb.Event.Topic
-	73, // 36: rpcpb.SubscribeRequest.filter:type_name -> rpcpb.SubscribeRequest.Filter
-	52, // 37: rpcpb.SubscribeResponse.event:type_name -> rpcpb.Event
-	74, // 38: rpcpb.VoterBonus.detail:type_name -> rpcpb.VoterBonus.DetailEntry
-	62, // 39: rpcpb.Account.GasInfo.pledged_info:type_name -> rpcpb.Account.PledgeInfo
-	65, // 40: rpcpb.Account.Group.items:type_name -> rpcpb.Account.Item
-	65, // 41: rpcpb.Account.Permission.items:type_name -> rpcpb.Account.Item
-	67, // 42: rpcpb.Account.PermissionsEntry.value:type_name -> rpcpb.Account.Permission
-	66, // 43: rpcpb.Account.GroupsEntry.value:type_name -> rpcpb.Account.Group
-	11, // 44: rpcpb.Contract.ABI.amount_limit:type_name -> rpcpb.AmountLimit
-	7,  // 45: rpcpb.ApiService.GetNodeInfo:input_type -> rpcpb.EmptyRequest
-	7,  // 46: rpcpb.ApiService.GetChainInfo:input_type -> rpcpb.EmptyRequest
-	7,  // 47: rpcpb.ApiService.GetRAMInfo:input_type -> rpcpb.EmptyRequest
-	23, // 48: rpcpb.ApiService.GetTxByHash:input_type -> rpcpb.TxHashRequest
-	23, // 49: rpcpb.ApiService.GetTxReceiptByTxHash:input_type -> rpcpb.TxHashRequest
-	24, // 50: rpcpb.ApiService.GetBlockByHash:input_type -> rpcpb.GetBlockByHashRequest
-	25, // 51: rpcpb.ApiService.GetBlockByNumber:input_type -> rpcpb.GetBlockByNumberRequest
-	25, // 52: rpcpb.ApiService.GetRawBlockByNumber:input_type -> rpcpb.GetBlockByNumberRequest
-	26, // 53: rpcpb.ApiService.GetBlockHeaderByRange:input_type -> rpcpb.GetBlockHeaderByRangeRequest
-	33, // 54: rpcpb.ApiService.GetAccount:input_type -> rpcpb.GetAccountRequest
-	47, // 55: rpcpb.ApiService.GetTokenBalance:input_type -> rpcpb.GetTokenBalanceRequest
-	47, // 56: rpcpb.ApiService.GetToken721Balance:input_type -> rpcpb.GetTokenBalanceRequest
-	49, // 57: rpcpb.ApiService.GetToken721Metadata:input_type -> rpcpb.GetToken721InfoRequest
-	49, // 58: rpcpb.ApiService.GetToken721Owner:input_type -> rpcpb.GetToken721InfoRequest
-	7,  // 59: rpcpb.ApiService.GetGasRatio:input_type -> rpcpb.EmptyRequest
-	29, // 60: rpcpb.ApiService.GetProducerVoteInfo:input_type -> rpcpb.GetProducerVoteInfoRequest
-	36, // 61: rpcpb.ApiService.GetContract:input_type -> rpcpb.GetContractRequest
-	36, // 62: rpcpb.ApiService.GetContractVote:input_type -> rpcpb.GetContractRequest
-	37, // 63: rpcpb.ApiService.GetContractStorage:input_type -> rpcpb.GetContractStorageRequest
-	39, // 64: rpcpb.ApiService.GetBatchContractStorage:input_type -> rpcpb.GetBatchContractStorageRequest
-	43, // 65: rpcpb.ApiService.ListContractStorage:input_type -> rpcpb.ListContractStorageRequest
-	41, // 66: rpcpb.ApiService.GetContractStorageFields:input_type -> rpcpb.GetContractStorageFieldsRequest
-	17, // 67: rpcpb.ApiService.SendTransaction:input_type -> rpcpb.TransactionRequest
-	17, // 68: rpcpb.ApiService.ExecTransaction:input_type -> rpcpb.TransactionRequest
-	53, // 69: rpcpb.ApiService.Subscribe:input_type -> rpcpb.SubscribeRequest
-	33, // 70: rpcpb.ApiService.GetVoterBonus:input_type -> rpcpb.GetAccountRequest
-	33, // 71: rpcpb.ApiService.GetCandidateBonus:input_type -> rpcpb.GetAccountRequest
-	57, // 72: rpcpb.ApiService.GetTokenInfo:input_type -> rpcpb.GetTokenInfoRequest
-	10, // 73: rpcpb.ApiService.GetNodeInfo:output_type -> rpcpb.NodeInfoResponse
-	22, // 74: rpcpb.ApiService.GetChainInfo:output_type -> rpcpb.ChainInfoResponse
-	9,  // 75: rpcpb.ApiService.GetRAMInfo:output_type -> rpcpb.RAMInfoResponse
-	15, // 76: rpcpb.ApiService.GetTxByHash:output_type -> rpcpb.TransactionResponse
-	13, // 77: rpcpb.ApiService.GetTxReceiptByTxHash:output_type -> rpcpb.TxReceipt
-	19, // 78: rpcpb.ApiService.GetBlockByHash:output_type -> rpcpb.BlockResponse
-	19, // 79: rpcpb.ApiService.GetBlockByNumber:output_type -> rpcpb.BlockResponse
-	20, // 80: rpcpb.ApiService.GetRawBlockByNumber:output_type -> rpcpb.RawBlockResponse
-	21, // 81: rpcpb.ApiService.GetBlockHeaderByRange:output_type -> rpcpb.BlockHeaderByRangeResponse
-	32, // 82: rpcpb.ApiService.GetAccount:output_type -> rpcpb.Account
-	46, // 83: rpcpb.ApiService.GetTokenBalance:output_type -> rpcpb.GetTokenBalanceResponse
-	48, // 84: rpcpb.ApiService.GetToken721Balance:output_type -> rpcpb.GetToken721BalanceResponse
-	50, // 85: rpcpb.ApiService.GetToken721Metadata:output_type -> rpcpb.GetToken721MetadataResponse
-	51, // 86: rpcpb.ApiService.GetToken721Owner:output_type -> rpcpb.GetToken721OwnerResponse
-	31, // 87: rpcpb.ApiService.GetGasRatio:output_type -> rpcpb.GasRatioResponse
-	30, // 88: rpcpb.ApiService.GetProducerVoteInfo:output_type -> rpcpb.GetProducerVoteInfoResponse
-	34, // 89: rpcpb.ApiService.GetContract:output_type -> rpcpb.Contract
-	35, // 90: rpcpb.ApiService.GetContractVote:output_type -> rpcpb.ContractVote
-	38, // 91: rpcpb.ApiService.GetContractStorage:output_type -> rpcpb.GetContractStorageResponse
-	40, // 92: rpcpb.ApiService.GetBatchContractStorage:output_type -> rpcpb.GetBatchContractStorageResponse
-	44, // 93: rpcpb.ApiService.ListContractStorage:output_type -> rpcpb.ListContractStorageResponse
-	42, // 94: rpcpb.ApiService.GetContractStorageFields:output_type -> rpcpb.GetContractStorageFieldsResponse
-	45, // 95: rpcpb.ApiService.SendTransaction:output_type -> rpcpb.SendTransactionResponse
-	13, // 96: rpcpb.ApiService.ExecTransaction:output_type -> rpcpb.TxReceipt
-	54, // 97: rpcpb.ApiService.Subscribe:output_type -> rpcpb.SubscribeResponse
-	55, // 98: rpcpb.ApiService.GetVoterBonus:output_type -> rpcpb.VoterBonus
-	56, // 99: rpcpb.ApiService.GetCandidateBonus:output_type -> rpcpb.CandidateBonus
-	58, // 100: rpcpb.ApiService.GetTokenInfo:output_type -> rpcpb.TokenInfo
-	73, // [73:101] is the sub-list for method output_type
-	45, // [45:73] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+	9,   // 0: rpcpb.NodeInfoResponse.network:type_name -> rpcpb.NetworkInfo
+	77,  // 1: rpcpb.TxReceipt.ram_usage:type_name -> rpcpb.TxReceipt.RamUsageEntry
+	0,   // 2: rpcpb.TxReceipt.status_code:type_name -> rpcpb.TxReceipt.StatusCode
+	78,  // 3: rpcpb.TxReceipt.receipts:type_name -> rpcpb.TxReceipt.Receipt
+	79,  // 4: rpcpb.TxReceipt.state_diff:type_name -> rpcpb.TxReceipt.StateChange
+	13,  // 5: rpcpb.Transaction.actions:type_name -> rpcpb.Action
+	12,  // 6: rpcpb.Transaction.amount_limit:type_name -> rpcpb.AmountLimit
+	14,  // 7: rpcpb.Transaction.tx_receipt:type_name -> rpcpb.TxReceipt
+	1,   // 8: rpcpb.TransactionResponse.status:type_name -> rpcpb.TransactionResponse.Status
+	15,  // 9: rpcpb.TransactionResponse.transaction:type_name -> rpcpb.Transaction
+	2,   // 10: rpcpb.Signature.algorithm:type_name -> rpcpb.Signature.Algorithm
+	13,  // 11: rpcpb.TransactionRequest.actions:type_name -> rpcpb.Action
+	12,  // 12: rpcpb.TransactionRequest.amount_limit:type_name -> rpcpb.AmountLimit
+	17,  // 13: rpcpb.TransactionRequest.signatures:type_name -> rpcpb.Signature
+	17,  // 14: rpcpb.TransactionRequest.publisher_sigs:type_name -> rpcpb.Signature
+	80,  // 15: rpcpb.Block.info:type_name -> rpcpb.Block.Info
+	15,  // 16: rpcpb.Block.transactions:type_name -> rpcpb.Transaction
+	3,   // 17: rpcpb.BlockResponse.status:type_name -> rpcpb.BlockResponse.Status
+	19,  // 18: rpcpb.BlockResponse.block:type_name -> rpcpb.Block
+	4,   // 19: rpcpb.RawBlockResponse.status:type_name -> rpcpb.RawBlockResponse.Status
+	98,  // 20: rpcpb.RawBlockResponse.block:type_name -> blockpb.Block
+	98,  // 21: rpcpb.BlockHeaderByRangeResponse.block_list:type_name -> blockpb.Block
+	99,  // 22: rpcpb.TxInclusionProofResponse.block_head:type_name -> blockpb.BlockHead
+	100, // 23: rpcpb.TxInclusionProofResponse.block_sign:type_name -> sigpb.Signature
+	101, // 24: rpcpb.TxInclusionProofResponse.transaction:type_name -> txpb.Tx
+	99,  // 25: rpcpb.ReceiptInclusionProofResponse.block_head:type_name -> blockpb.BlockHead
+	100, // 26: rpcpb.ReceiptInclusionProofResponse.block_sign:type_name -> sigpb.Signature
+	102, // 27: rpcpb.ReceiptInclusionProofResponse.receipt:type_name -> txpb.TxReceipt
+	82,  // 28: rpcpb.Account.gas_info:type_name -> rpcpb.Account.GasInfo
+	83,  // 29: rpcpb.Account.ram_info:type_name -> rpcpb.Account.RAMInfo
+	87,  // 30: rpcpb.Account.permissions:type_name -> rpcpb.Account.PermissionsEntry
+	88,  // 31: rpcpb.Account.groups:type_name -> rpcpb.Account.GroupsEntry
+	30,  // 32: rpcpb.Account.frozen_balances:type_name -> rpcpb.FrozenBalance
+	31,  // 33: rpcpb.Account.vote_infos:type_name -> rpcpb.VoteInfo
+	89,  // 34: rpcpb.Contract.abis:type_name -> rpcpb.Contract.ABI
+	90,  // 35: rpcpb.Contract.storage:type_name -> rpcpb.Contract.StorageItem
+	31,  // 36: rpcpb.ContractVote.vote_infos:type_name -> rpcpb.VoteInfo
+	91,  // 37: rpcpb.GetBatchContractStorageRequest.key_fields:type_name -> rpcpb.GetBatchContractStorageRequest.KeyField
+	5,   // 38: rpcpb.ListContractStorageRequest.storageType:type_name -> rpcpb.ListContractStorageRequest.StorageType
+	92,  // 39: rpcpb.ListContractStorageResponse.datas:type_name -> rpcpb.ListContractStorageResponse.Data
+	14,  // 40: rpcpb.SendTransactionResponse.pre_tx_receipt:type_name -> rpcpb.TxReceipt
+	30,  // 41: rpcpb.GetTokenBalanceResponse.frozen_balances:type_name -> rpcpb.FrozenBalance
+	6,   // 42: rpcpb.Event.topic:type_name -> rpcpb.Event.Topic
+	58,  // 43: rpcpb.Event.cursor:type_name -> rpcpb.EventCursor
+	6,   // 44: rpcpb.SubscribeRequest.topics:type_name -> rpcpb.Event.Topic
+	94,  // 45: rpcpb.SubscribeRequest.filter:type_name -> rpcpb.SubscribeRequest.Filter
+	58,  // 46: rpcpb.SubscribeRequest.from_cursor:type_name -> rpcpb.EventCursor
+	7,   // 47: rpcpb.EventPredicate.op:type_name -> rpcpb.EventPredicate.Op
+	57,  // 48: rpcpb.SubscribeResponse.event:type_name -> rpcpb.Event
+	95,  // 49: rpcpb.VoterBonus.detail:type_name -> rpcpb.VoterBonus.DetailEntry
+	67,  // 50: rpcpb.GetAccountTransactionsResponse.transactions:type_name -> rpcpb.AccountTransaction
+	15,  // 51: rpcpb.ListPendingTransactionsResponse.transactions:type_name -> rpcpb.Transaction
+	96,  // 52: rpcpb.TxPoolStatsResponse.gas_ratios:type_name -> rpcpb.TxPoolStatsResponse.GasRatioCount
+	14,  // 53: rpcpb.EstimateTransactionResponse.receipt:type_name -> rpcpb.TxReceipt
+	97,  // 54: rpcpb.EstimateTransactionResponse.ram_usage:type_name -> rpcpb.EstimateTransactionResponse.RamUsageEntry
+	73,  // 55: rpcpb.CallFrame.storage:type_name -> rpcpb.StorageAccess
+	74,  // 56: rpcpb.CallFrame.logs:type_name -> rpcpb.ContractLog
+	75,  // 57: rpcpb.CallFrame.calls:type_name -> rpcpb.CallFrame
+	14,  // 58: rpcpb.TraceTransactionResponse.receipt:type_name -> rpcpb.TxReceipt
+	75,  // 59: rpcpb.TraceTransactionResponse.frames:type_name -> rpcpb.CallFrame
+	81,  // 60: rpcpb.Account.GasInfo.pledged_info:type_name -> rpcpb.Account.PledgeInfo
+	84,  // 61: rpcpb.Account.Group.items:type_name -> rpcpb.Account.Item
+	84,  // 62: rpcpb.Account.Permission.items:type_name -> rpcpb.Account.Item
+	86,  // 63: rpcpb.Account.PermissionsEntry.value:type_name -> rpcpb.Account.Permission
+	85,  // 64: rpcpb.Account.GroupsEntry.value:type_name -> rpcpb.Account.Group
+	12,  // 65: rpcpb.Contract.ABI.amount_limit:type_name -> rpcpb.AmountLimit
+	93,  // 66: rpcpb.ListContractStorageResponse.Data.params:type_name -> rpcpb.ListContractStorageResponse.Data.ParamsEntry
+	60,  // 67: rpcpb.SubscribeRequest.Filter.predicates:type_name -> rpcpb.EventPredicate
+	8,   // 68: rpcpb.ApiService.GetNodeInfo:input_type -> rpcpb.EmptyRequest
+	8,   // 69: rpcpb.ApiService.GetChainInfo:input_type -> rpcpb.EmptyRequest
+	8,   // 70: rpcpb.ApiService.GetRAMInfo:input_type -> rpcpb.EmptyRequest
+	24,  // 71: rpcpb.ApiService.GetTxByHash:input_type -> rpcpb.TxHashRequest
+	24,  // 72: rpcpb.ApiService.GetTxReceiptByTxHash:input_type -> rpcpb.TxHashRequest
+	24,  // 73: rpcpb.ApiService.GetTxInclusionProof:input_type -> rpcpb.TxHashRequest
+	24,  // 74: rpcpb.ApiService.GetReceiptInclusionProof:input_type -> rpcpb.TxHashRequest
+	27,  // 75: rpcpb.ApiService.GetBlockByHash:input_type -> rpcpb.GetBlockByHashRequest
+	28,  // 76: rpcpb.ApiService.GetBlockByNumber:input_type -> rpcpb.GetBlockByNumberRequest
+	28,  // 77: rpcpb.ApiService.GetRawBlockByNumber:input_type -> rpcpb.GetBlockByNumberRequest
+	29,  // 78: rpcpb.ApiService.GetBlockHeaderByRange:input_type -> rpcpb.GetBlockHeaderByRangeRequest
+	36,  // 79: rpcpb.ApiService.GetAccount:input_type -> rpcpb.GetAccountRequest
+	52,  // 80: rpcpb.ApiService.GetTokenBalance:input_type -> rpcpb.GetTokenBalanceRequest
+	52,  // 81: rpcpb.ApiService.GetToken721Balance:input_type -> rpcpb.GetTokenBalanceRequest
+	54,  // 82: rpcpb.ApiService.GetToken721Metadata:input_type -> rpcpb.GetToken721InfoRequest
+	54,  // 83: rpcpb.ApiService.GetToken721Owner:input_type -> rpcpb.GetToken721InfoRequest
+	8,   // 84: rpcpb.ApiService.GetGasRatio:input_type -> rpcpb.EmptyRequest
+	32,  // 85: rpcpb.ApiService.GetProducerVoteInfo:input_type -> rpcpb.GetProducerVoteInfoRequest
+	39,  // 86: rpcpb.ApiService.GetContract:input_type -> rpcpb.GetContractRequest
+	39,  // 87: rpcpb.ApiService.GetContractVote:input_type -> rpcpb.GetContractRequest
+	40,  // 88: rpcpb.ApiService.GetContractStorage:input_type -> rpcpb.GetContractStorageRequest
+	42,  // 89: rpcpb.ApiService.GetBatchContractStorage:input_type -> rpcpb.GetBatchContractStorageRequest
+	48,  // 90: rpcpb.ApiService.ListContractStorage:input_type -> rpcpb.ListContractStorageRequest
+	44,  // 91: rpcpb.ApiService.GetContractStorageFields:input_type -> rpcpb.GetContractStorageFieldsRequest
+	46,  // 92: rpcpb.ApiService.GetStateProof:input_type -> rpcpb.GetStateProofRequest
+	18,  // 93: rpcpb.ApiService.SendTransaction:input_type -> rpcpb.TransactionRequest
+	18,  // 94: rpcpb.ApiService.ExecTransaction:input_type -> rpcpb.TransactionRequest
+	18,  // 95: rpcpb.ApiService.EstimateTransaction:input_type -> rpcpb.TransactionRequest
+	18,  // 96: rpcpb.ApiService.TraceTransaction:input_type -> rpcpb.TransactionRequest
+	59,  // 97: rpcpb.ApiService.Subscribe:input_type -> rpcpb.SubscribeRequest
+	36,  // 98: rpcpb.ApiService.GetVoterBonus:input_type -> rpcpb.GetAccountRequest
+	36,  // 99: rpcpb.ApiService.GetCandidateBonus:input_type -> rpcpb.GetAccountRequest
+	64,  // 100: rpcpb.ApiService.GetTokenInfo:input_type -> rpcpb.GetTokenInfoRequest
+	66,  // 101: rpcpb.ApiService.GetAccountTransactions:input_type -> rpcpb.GetAccountTransactionsRequest
+	69,  // 102: rpcpb.ApiService.ListPendingTransactions:input_type -> rpcpb.ListPendingTransactionsRequest
+	8,   // 103: rpcpb.ApiService.GetTxPoolStats:input_type -> rpcpb.EmptyRequest
+	11,  // 104: rpcpb.ApiService.GetNodeInfo:output_type -> rpcpb.NodeInfoResponse
+	23,  // 105: rpcpb.ApiService.GetChainInfo:output_type -> rpcpb.ChainInfoResponse
+	10,  // 106: rpcpb.ApiService.GetRAMInfo:output_type -> rpcpb.RAMInfoResponse
+	16,  // 107: rpcpb.ApiService.GetTxByHash:output_type -> rpcpb.TransactionResponse
+	14,  // 108: rpcpb.ApiService.GetTxReceiptByTxHash:output_type -> rpcpb.TxReceipt
+	25,  // 109: rpcpb.ApiService.GetTxInclusionProof:output_type -> rpcpb.TxInclusionProofResponse
+	26,  // 110: rpcpb.ApiService.GetReceiptInclusionProof:output_type -> rpcpb.ReceiptInclusionProofResponse
+	20,  // 111: rpcpb.ApiService.GetBlockByHash:output_type -> rpcpb.BlockResponse
+	20,  // 112: rpcpb.ApiService.GetBlockByNumber:output_type -> rpcpb.BlockResponse
+	21,  // 113: rpcpb.ApiService.GetRawBlockByNumber:output_type -> rpcpb.RawBlockResponse
+	22,  // 114: rpcpb.ApiService.GetBlockHeaderByRange:output_type -> rpcpb.BlockHeaderByRangeResponse
+	35,  // 115: rpcpb.ApiService.GetAccount:output_type -> rpcpb.Account
+	51,  // 116: rpcpb.ApiService.GetTokenBalance:output_type -> rpcpb.GetTokenBalanceResponse
+	53,  // 117: rpcpb.ApiService.GetToken721Balance:output_type -> rpcpb.GetToken721BalanceResponse
+	55,  // 118: rpcpb.ApiService.GetToken721Metadata:output_type -> rpcpb.GetToken721MetadataResponse
+	56,  // 119: rpcpb.ApiService.GetToken721Owner:output_type -> rpcpb.GetToken721OwnerResponse
+	34,  // 120: rpcpb.ApiService.GetGasRatio:output_type -> rpcpb.GasRatioResponse
+	33,  // 121: rpcpb.ApiService.GetProducerVoteInfo:output_type -> rpcpb.GetProducerVoteInfoResponse
+	37,  // 122: rpcpb.ApiService.GetContract:output_type -> rpcpb.Contract
+	38,  // 123: rpcpb.ApiService.GetContractVote:output_type -> rpcpb.ContractVote
+	41,  // 124: rpcpb.ApiService.GetContractStorage:output_type -> rpcpb.GetContractStorageResponse
+	43,  // 125: rpcpb.ApiService.GetBatchContractStorage:output_type -> rpcpb.GetBatchContractStorageResponse
+	49,  // 126: rpcpb.ApiService.ListContractStorage:output_type -> rpcpb.ListContractStorageResponse
+	45,  // 127: rpcpb.ApiService.GetContractStorageFields:output_type -> rpcpb.GetContractStorageFieldsResponse
+	47,  // 128: rpcpb.ApiService.GetStateProof:output_type -> rpcpb.GetStateProofResponse
+	50,  // 129: rpcpb.ApiService.SendTransaction:output_type -> rpcpb.SendTransactionResponse
+	14,  // 130: rpcpb.ApiService.ExecTransaction:output_type -> rpcpb.TxReceipt
+	72,  // 131: rpcpb.ApiService.EstimateTransaction:output_type -> rpcpb.EstimateTransactionResponse
+	76,  // 132: rpcpb.ApiService.TraceTransaction:output_type -> rpcpb.TraceTransactionResponse
+	61,  // 133: rpcpb.ApiService.Subscribe:output_type -> rpcpb.SubscribeResponse
+	62,  // 134: rpcpb.ApiService.GetVoterBonus:output_type -> rpcpb.VoterBonus
+	63,  // 135: rpcpb.ApiService.GetCandidateBonus:output_type -> rpcpb.CandidateBonus
+	65,  // 136: rpcpb.ApiService.GetTokenInfo:output_type -> rpcpb.TokenInfo
+	68,  // 137: rpcpb.ApiService.GetAccountTransactions:output_type -> rpcpb.GetAccountTransactionsResponse
+	70,  // 138: rpcpb.ApiService.ListPendingTransactions:output_type -> rpcpb.ListPendingTransactionsResponse
+	71,  // 139: rpcpb.ApiService.GetTxPoolStats:output_type -> rpcpb.TxPoolStatsResponse
+	104, // [104:140] is the sub-list for method output_type
+	68,  // [68:104] is the sub-list for method input_type
+	68,  // [68:68] is the sub-list for extension type_name
+	68,  // [68:68] is the sub-list for extension extendee
+	0,   // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_rpc_pb_rpc_proto_init() }
@@ -6010,8 +8503,188 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkInfo); i {
+		file_rpc_pb_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RAMInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmountLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Action); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Signature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Block); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderByRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxHashRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6022,8 +8695,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RAMInfoResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxInclusionProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6034,8 +8707,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeInfoResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptInclusionProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6046,8 +8719,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AmountLimit); i {
+		file_rpc_pb_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByHashRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6058,8 +8731,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Action); i {
+		file_rpc_pb_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByNumberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6070,8 +8743,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxReceipt); i {
+		file_rpc_pb_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockHeaderByRangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6082,8 +8755,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Transaction); i {
+		file_rpc_pb_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrozenBalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6094,8 +8767,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6106,8 +8779,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Signature); i {
+		file_rpc_pb_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProducerVoteInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6118,8 +8791,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProducerVoteInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6130,8 +8803,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Block); i {
+		file_rpc_pb_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasRatioResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6142,8 +8815,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6154,8 +8827,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawBlockResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6166,8 +8839,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeaderByRangeResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contract); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6178,8 +8851,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainInfoResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6190,8 +8863,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxHashRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContractRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6202,8 +8875,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByHashRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContractStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6214,8 +8887,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByNumberRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContractStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6226,8 +8899,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockHeaderByRangeRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchContractStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6238,8 +8911,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrozenBalance); i {
+		file_rpc_pb_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchContractStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6250,8 +8923,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteInfo); i {
+		file_rpc_pb_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContractStorageFieldsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6262,8 +8935,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProducerVoteInfoRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContractStorageFieldsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6274,8 +8947,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProducerVoteInfoResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6286,8 +8959,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GasRatioResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6298,8 +8971,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Account); i {
+		file_rpc_pb_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContractStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6310,8 +8983,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContractStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6322,8 +8995,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Contract); i {
+		file_rpc_pb_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6334,8 +9007,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractVote); i {
+		file_rpc_pb_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6346,8 +9019,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContractRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenBalanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6358,8 +9031,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContractStorageRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetToken721BalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6370,8 +9043,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContractStorageResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetToken721InfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6382,8 +9055,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBatchContractStorageRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetToken721MetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6394,8 +9067,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBatchContractStorageResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetToken721OwnerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6406,8 +9079,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContractStorageFieldsRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6418,8 +9091,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContractStorageFieldsResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventCursor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6430,8 +9103,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListContractStorageRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6442,8 +9115,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListContractStorageResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventPredicate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6454,8 +9127,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTransactionResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6466,8 +9139,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenBalanceResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoterBonus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6478,8 +9151,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenBalanceRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CandidateBonus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6490,8 +9163,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetToken721BalanceResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6502,8 +9175,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetToken721InfoRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6514,8 +9187,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetToken721MetadataResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6526,8 +9199,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetToken721OwnerResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6538,8 +9211,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+		file_rpc_pb_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6550,8 +9223,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6562,8 +9235,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeResponse); i {
+		file_rpc_pb_rpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6574,8 +9247,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoterBonus); i {
+		file_rpc_pb_rpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxPoolStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6586,8 +9259,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CandidateBonus); i {
+		file_rpc_pb_rpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6598,8 +9271,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenInfoRequest); i {
+		file_rpc_pb_rpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6610,8 +9283,8 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenInfo); i {
+		file_rpc_pb_rpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6622,7 +9295,31 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxReceipt_Receipt); i {
 			case 0:
 				return &v.state
@@ -6634,7 +9331,19 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxReceipt_StateChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Block_Info); i {
 			case 0:
 				return &v.state
@@ -6646,7 +9355,7 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Account_PledgeInfo); i {
 			case 0:
 				return &v.state
@@ -6658,7 +9367,7 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Account_GasInfo); i {
 			case 0:
 				return &v.state
@@ -6670,7 +9379,7 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Account_RAMInfo); i {
 			case 0:
 				return &v.state
@@ -6682,7 +9391,7 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Account_Item); i {
 			case 0:
 				return &v.state
@@ -6694,7 +9403,7 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Account_Group); i {
 			case 0:
 				return &v.state
@@ -6706,7 +9415,7 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Account_Permission); i {
 			case 0:
 				return &v.state
@@ -6718,7 +9427,7 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Contract_ABI); i {
 			case 0:
 				return &v.state
@@ -6730,7 +9439,19 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contract_StorageItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pb_rpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBatchContractStorageRequest_KeyField); i {
 			case 0:
 				return &v.state
@@ -6742,7 +9463,7 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListContractStorageResponse_Data); i {
 			case 0:
 				return &v.state
@@ -6754,7 +9475,7 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_pb_rpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_pb_rpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeRequest_Filter); i {
 			case 0:
 				return &v.state
@@ -6766,14 +9487,26 @@ func file_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
+		file_rpc_pb_rpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxPoolStatsResponse_GasRatioCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_pb_rpc_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   68,
+			NumEnums:      8,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,6 +28,10 @@ type ApiServiceClient interface {
 	GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// get the merkle path of an irreversible transaction to the tx merkle hash of the block with the signed block head
+	GetTxInclusionProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxInclusionProofResponse, error)
+	// get the merkle path of the receipt of an irreversible transaction to the tx receipt merkle hash of the block with the signed block head
+	GetReceiptInclusionProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*ReceiptInclusionProofResponse, error)
 	// get block by hash
 	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// get block by number
@@ -61,15 +65,27 @@ type ApiServiceClient interface {
 	ListContractStorage(ctx context.Context, in *ListContractStorageRequest, opts ...grpc.CallOption) (*ListContractStorageResponse, error)
 	// get contract fields storage
 	GetContractStorageFields(ctx context.Context, in *GetContractStorageFieldsRequest, opts ...grpc.CallOption) (*GetContractStorageFieldsResponse, error)
+	// get a value of contract storage with the merkle proof of it against the state root of a block
+	GetStateProof(ctx context.Context, in *GetStateProofRequest, opts ...grpc.CallOption) (*GetStateProofResponse, error)
 	// send transaction
 	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// estimate the gas, ram and gas ratio of a transaction by executing it without committing
+	EstimateTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateTransactionResponse, error)
+	// execute transaction and return the calls, storage accesses and logs of the execution
+	TraceTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error)
 	// subscribe an event
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
 	GetVoterBonus(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*VoterBonus, error)
 	GetCandidateBonus(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*CandidateBonus, error)
 	GetTokenInfo(ctx context.Context, in *GetTokenInfoRequest, opts ...grpc.CallOption) (*TokenInfo, error)
+	// get transactions published, signed or received by an account, newest first
+	GetAccountTransactions(ctx context.Context, in *GetAccountTransactionsRequest, opts ...grpc.CallOption) (*GetAccountTransactionsResponse, error)
+	// list the pending transactions in the tx pool, ordered by priority
+	ListPendingTransactions(ctx context.Context, in *ListPendingTransactionsRequest, opts ...grpc.CallOption) (*ListPendingTransactionsResponse, error)
+	// get the statistics of the tx pool
+	GetTxPoolStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*TxPoolStatsResponse, error)
 }
 
 type apiServiceClient struct {
@@ -125,6 +141,24 @@ func (c *apiServiceClient) GetTxReceiptByTxHash(ctx context.Context, in *TxHashR
 	return out, nil
 }
 
+func (c *apiServiceClient) GetTxInclusionProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxInclusionProofResponse, error) {
+	out := new(TxInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetReceiptInclusionProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*ReceiptInclusionProofResponse, error) {
+	out := new(ReceiptInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetReceiptInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
 	out := new(BlockResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, opts...)
@@ -278,6 +312,15 @@ func (c *apiServiceClient) GetContractStorageFields(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *apiServiceClient) GetStateProof(ctx context.Context, in *GetStateProofRequest, opts ...grpc.CallOption) (*GetStateProofResponse, error) {
+	out := new(GetStateProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
 	out := new(SendTransactionResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, opts...)
@@ -296,6 +339,24 @@ func (c *apiServiceClient) ExecTransaction(ctx context.Context, in *TransactionR
 	return out, nil
 }
 
+func (c *apiServiceClient) EstimateTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateTransactionResponse, error) {
+	out := new(EstimateTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/EstimateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) TraceTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error) {
+	out := new(TraceTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/TraceTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
 	stream, err := c.cc.NewStream(ctx, &ApiService_ServiceDesc.Streams[0], "/rpcpb.ApiService/Subscribe", opts...)
 	if err != nil {
@@ -355,6 +416,33 @@ func (c *apiServiceClient) GetTokenInfo(ctx context.Context, in *GetTokenInfoReq
 	return out, nil
 }
 
+func (c *apiServiceClient) GetAccountTransactions(ctx context.Context, in *GetAccountTransactionsRequest, opts ...grpc.CallOption) (*GetAccountTransactionsResponse, error) {
+	out := new(GetAccountTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetAccountTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ListPendingTransactions(ctx context.Context, in *ListPendingTransactionsRequest, opts ...grpc.CallOption) (*ListPendingTransactionsResponse, error) {
+	out := new(ListPendingTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/ListPendingTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxPoolStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*TxPoolStatsResponse, error) {
+	out := new(TxPoolStatsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxPoolStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiServiceServer is the server API for ApiService service.
 // All implementations should embed UnimplementedApiServiceServer
 // for forward compatibility
@@ -369,6 +457,10 @@ type ApiServiceServer interface {
 	GetTxByHash(context.Context, *TxHashRequest) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(context.Context, *TxHashRequest) (*TxReceipt, error)
+	// get the merkle path of an irreversible transaction to the tx merkle hash of the block with the signed block head
+	GetTxInclusionProof(context.Context, *TxHashRequest) (*TxInclusionProofResponse, error)
+	// get the merkle path of the receipt of an irreversible transaction to the tx receipt merkle hash of the block with the signed block head
+	GetReceiptInclusionProof(context.Context, *TxHashRequest) (*ReceiptInclusionProofResponse, error)
 	// get block by hash
 	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*BlockResponse, error)
 	// get block by number
@@ -402,15 +494,27 @@ type ApiServiceServer interface {
 	ListContractStorage(context.Context, *ListContractStorageRequest) (*ListContractStorageResponse, error)
 	// get contract fields storage
 	GetContractStorageFields(context.Context, *GetContractStorageFieldsRequest) (*GetContractStorageFieldsResponse, error)
+	// get a value of contract storage with the merkle proof of it against the state root of a block
+	GetStateProof(context.Context, *GetStateProofRequest) (*GetStateProofResponse, error)
 	// send transaction
 	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(context.Context, *TransactionRequest) (*TxReceipt, error)
+	// estimate the gas, ram and gas ratio of a transaction by executing it without committing
+	EstimateTransaction(context.Context, *TransactionRequest) (*EstimateTransactionResponse, error)
+	// execute transaction and return the calls, storage accesses and logs of the execution
+	TraceTransaction(context.Context, *TransactionRequest) (*TraceTransactionResponse, error)
 	// subscribe an event
 	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
 	GetVoterBonus(context.Context, *GetAccountRequest) (*VoterBonus, error)
 	GetCandidateBonus(context.Context, *GetAccountRequest) (*CandidateBonus, error)
 	GetTokenInfo(context.Context, *GetTokenInfoRequest) (*TokenInfo, error)
+	// get transactions published, signed or received by an account, newest first
+	GetAccountTransactions(context.Context, *GetAccountTransactionsRequest) (*GetAccountTransactionsResponse, error)
+	// list the pending transactions in the tx pool, ordered by priority
+	ListPendingTransactions(context.Context, *ListPendingTransactionsRequest) (*ListPendingTransactionsResponse, error)
+	// get the statistics of the tx pool
+	GetTxPoolStats(context.Context, *EmptyRequest) (*TxPoolStatsResponse, error)
 }
 
 // UnimplementedApiServiceServer should be embedded to have forward compatible implementations.
@@ -432,6 +536,12 @@ func (UnimplementedApiServiceServer) GetTxByHash(context.Context, *TxHashRequest
 func (UnimplementedApiServiceServer) GetTxReceiptByTxHash(context.Context, *TxHashRequest) (*TxReceipt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxReceiptByTxHash not implemented")
 }
+func (UnimplementedApiServiceServer) GetTxInclusionProof(context.Context, *TxHashRequest) (*TxInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxInclusionProof not implemented")
+}
+func (UnimplementedApiServiceServer) GetReceiptInclusionProof(context.Context, *TxHashRequest) (*ReceiptInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptInclusionProof not implemented")
+}
 func (UnimplementedApiServiceServer) GetBlockByHash(context.Context, *GetBlockByHashRequest) (*BlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
 }
@@ -483,12 +593,21 @@ func (UnimplementedApiServiceServer) ListContractStorage(context.Context, *ListC
 func (UnimplementedApiServiceServer) GetContractStorageFields(context.Context, *GetContractStorageFieldsRequest) (*GetContractStorageFieldsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContractStorageFields not implemented")
 }
+func (UnimplementedApiServiceServer) GetStateProof(context.Context, *GetStateProofRequest) (*GetStateProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateProof not implemented")
+}
 func (UnimplementedApiServiceServer) SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTransaction not implemented")
 }
 func (UnimplementedApiServiceServer) ExecTransaction(context.Context, *TransactionRequest) (*TxReceipt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecTransaction not implemented")
 }
+func (UnimplementedApiServiceServer) EstimateTransaction(context.Context, *TransactionRequest) (*EstimateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTransaction not implemented")
+}
+func (UnimplementedApiServiceServer) TraceTransaction(context.Context, *TransactionRequest) (*TraceTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceTransaction not implemented")
+}
 func (UnimplementedApiServiceServer) Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
@@ -501,6 +620,15 @@ func (UnimplementedApiServiceServer) GetCandidateBonus(context.Context, *GetAcco
 func (UnimplementedApiServiceServer) GetTokenInfo(context.Context, *GetTokenInfoRequest) (*TokenInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTokenInfo not implemented")
 }
+func (UnimplementedApiServiceServer) GetAccountTransactions(context.Context, *GetAccountTransactionsRequest) (*GetAccountTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTransactions not implemented")
+}
+func (UnimplementedApiServiceServer) ListPendingTransactions(context.Context, *ListPendingTransactionsRequest) (*ListPendingTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingTransactions not implemented")
+}
+func (UnimplementedApiServiceServer) GetTxPoolStats(context.Context, *EmptyRequest) (*TxPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxPoolStats not implemented")
+}
 
 // UnsafeApiServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiServiceServer will
@@ -603,6 +731,42 @@ func _ApiService_GetTxReceiptByTxHash_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetTxInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxInclusionProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetReceiptInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetReceiptInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetReceiptInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetReceiptInclusionProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockByHashRequest)
 	if err := dec(in); err != nil {
@@ -909,6 +1073,24 @@ func _ApiService_GetContractStorageFields_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetStateProof(ctx, req.(*GetStateProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
@@ -945,6 +1127,42 @@ func _ApiService_ExecTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_EstimateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).EstimateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/EstimateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).EstimateTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_TraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).TraceTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/TraceTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).TraceTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1020,6 +1238,60 @@ func _ApiService_GetTokenInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetAccountTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountTransactions(ctx, req.(*GetAccountTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_ListPendingTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ListPendingTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/ListPendingTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ListPendingTransactions(ctx, req.(*ListPendingTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxPoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxPoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxPoolStats(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiService_ServiceDesc is the grpc.ServiceDesc for ApiService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1047,6 +1319,14 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxReceiptByTxHash",
 			Handler:    _ApiService_GetTxReceiptByTxHash_Handler,
 		},
+		{
+			MethodName: "GetTxInclusionProof",
+			Handler:    _ApiService_GetTxInclusionProof_Handler,
+		},
+		{
+			MethodName: "GetReceiptInclusionProof",
+			Handler:    _ApiService_GetReceiptInclusionProof_Handler,
+		},
 		{
 			MethodName: "GetBlockByHash",
 			Handler:    _ApiService_GetBlockByHash_Handler,
@@ -1115,6 +1395,10 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetContractStorageFields",
 			Handler:    _ApiService_GetContractStorageFields_Handler,
 		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _ApiService_GetStateProof_Handler,
+		},
 		{
 			MethodName: "SendTransaction",
 			Handler:    _ApiService_SendTransaction_Handler,
@@ -1123,6 +1407,14 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecTransaction",
 			Handler:    _ApiService_ExecTransaction_Handler,
 		},
+		{
+			MethodName: "EstimateTransaction",
+			Handler:    _ApiService_EstimateTransaction_Handler,
+		},
+		{
+			MethodName: "TraceTransaction",
+			Handler:    _ApiService_TraceTransaction_Handler,
+		},
 		{
 			MethodName: "GetVoterBonus",
 			Handler:    _ApiService_GetVoterBonus_Handler,
@@ -1135,6 +1427,18 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTokenInfo",
 			Handler:    _ApiService_GetTokenInfo_Handler,
 		},
+		{
+			MethodName: "GetAccountTransactions",
+			Handler:    _ApiService_GetAccountTransactions_Handler,
+		},
+		{
+			MethodName: "ListPendingTransactions",
+			Handler:    _ApiService_ListPendingTransactions_Handler,
+		},
+		{
+			MethodName: "GetTxPoolStats",
+			Handler:    _ApiService_GetTxPoolStats_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
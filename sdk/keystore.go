@@ -13,6 +13,8 @@ import (
 
 	"github.com/iost-official/go-iost/v3/account"
 	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/crypto"
+	"github.com/iost-official/go-iost/v3/crypto/hd"
 	"golang.org/x/crypto/scrypt"
 )
 
@@ -25,6 +27,7 @@ type KeyPairInfo struct {
 	Salt          string `json:"salt,omitempty"`
 	EncryptedKey  string `json:"encrypted_key,omitempty"`
 	Mac           string `json:"mac,omitempty"`
+	HDPath        string `json:"hd_path,omitempty"`
 }
 
 // NewKeyPairInfo ...
@@ -32,10 +35,14 @@ func NewKeyPairInfo(rawKey string, keyType string) (*KeyPairInfo, error) {
 	if rawKey == "" {
 		return nil, fmt.Errorf("empty key")
 	}
+	algo, err := crypto.ParseAlgorithm(keyType)
+	if err != nil {
+		return nil, err
+	}
 	kp := &KeyPairInfo{}
 	kp.RawKey = rawKey
 	kp.KeyType = keyType
-	key, err := account.NewKeyPair(common.Base58Decode(kp.RawKey), GetSignAlgoByName(kp.KeyType))
+	key, err := account.NewKeyPair(common.Base58Decode(kp.RawKey), algo)
 	if err != nil {
 		return nil, err
 	}
@@ -43,11 +50,33 @@ func NewKeyPairInfo(rawKey string, keyType string) (*KeyPairInfo, error) {
 	return kp, nil
 }
 
+// NewKeyPairInfoFromSeed derives the key at the hd path from seed.
+func NewKeyPairInfoFromSeed(seed []byte, path string, keyType string) (*KeyPairInfo, error) {
+	algo, err := crypto.ParseAlgorithm(keyType)
+	if err != nil {
+		return nil, err
+	}
+	seckey, err := hd.DeriveSeckey(algo, seed, path)
+	if err != nil {
+		return nil, err
+	}
+	kp, err := NewKeyPairInfo(common.Base58Encode(seckey), keyType)
+	if err != nil {
+		return nil, err
+	}
+	kp.HDPath = path
+	return kp, nil
+}
+
 func (k *KeyPairInfo) ToKeyPair() (*account.KeyPair, error) {
 	if k.RawKey == "" {
 		return nil, fmt.Errorf("empty keypair, is it encrypted?")
 	}
-	return account.NewKeyPair(common.Base58Decode(k.RawKey), GetSignAlgoByName(k.KeyType))
+	algo, err := GetSignAlgoByName(k.KeyType)
+	if err != nil {
+		return nil, err
+	}
+	return account.NewKeyPair(common.Base58Decode(k.RawKey), algo)
 }
 
 func (k *KeyPairInfo) IsEncrypted() bool {
@@ -125,6 +154,21 @@ func NewAccountInfo() *AccountInfo {
 	return &AccountInfo{Name: "", Keypairs: make(map[string]*KeyPairInfo)}
 }
 
+// NewAccountInfoFromSeed derives the keys of the hd account index from seed,
+// the owner key at key index 0 and the active key at key index 1.
+func NewAccountInfoFromSeed(name string, seed []byte, index uint32, keyType string) (*AccountInfo, error) {
+	a := NewAccountInfo()
+	a.Name = name
+	for i, perm := range []string{"owner", "active"} {
+		kp, err := NewKeyPairInfoFromSeed(seed, hd.Path(index, uint32(i)), keyType)
+		if err != nil {
+			return nil, err
+		}
+		a.Keypairs[perm] = kp
+	}
+	return a, nil
+}
+
 func (a *AccountInfo) GetKeyPair(perm string) (*account.KeyPair, error) {
 	kp, ok := a.Keypairs[perm]
 	if !ok {
@@ -0,0 +1,300 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/iost-official/go-iost/v3/account"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	jsonpb "google.golang.org/protobuf/encoding/protojson"
+)
+
+// MultiSigTxVersion is the version of the multi-signature envelope format.
+const MultiSigTxVersion = 1
+
+// errors
+var (
+	ErrInvalidSignature    = errors.New("signature does not match the transaction")
+	ErrPublisherSigned     = errors.New("the transaction already has publisher signatures, signer signatures must be collected before them")
+	ErrTransactionMismatch = errors.New("envelopes contain different transactions")
+)
+
+// MultiSigTx is an envelope of a partially signed transaction passed around the key holders of
+// multi-signature accounts, like a PSBT of bitcoin. Signatures are collected in the transaction itself,
+// so the transaction can be sent as it is once the thresholds are reached.
+type MultiSigTx struct {
+	Version int
+	Tx      *rpcpb.TransactionRequest
+}
+
+type multiSigTxJSON struct {
+	Version int             `json:"version"`
+	Tx      json.RawMessage `json:"tx"`
+}
+
+// NewMultiSigTx wraps a transaction published by publisher in an envelope.
+func NewMultiSigTx(t *rpcpb.TransactionRequest, publisher string) *MultiSigTx {
+	t.Publisher = publisher
+	return &MultiSigTx{
+		Version: MultiSigTxVersion,
+		Tx:      t,
+	}
+}
+
+// MarshalJSON ...
+func (m *MultiSigTx) MarshalJSON() ([]byte, error) {
+	t, err := (&jsonpb.MarshalOptions{EmitUnpopulated: true}).Marshal(m.Tx)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&multiSigTxJSON{Version: m.Version, Tx: t})
+}
+
+// UnmarshalJSON ...
+func (m *MultiSigTx) UnmarshalJSON(b []byte) error {
+	mj := &multiSigTxJSON{}
+	err := json.Unmarshal(b, mj)
+	if err != nil {
+		return err
+	}
+	if mj.Version != MultiSigTxVersion {
+		return fmt.Errorf("unsupported envelope version %v", mj.Version)
+	}
+	t := &rpcpb.TransactionRequest{}
+	err = jsonpb.Unmarshal(mj.Tx, t)
+	if err != nil {
+		return err
+	}
+	m.Version = mj.Version
+	m.Tx = t
+	return nil
+}
+
+// SaveMultiSigTx ...
+func SaveMultiSigTx(m *MultiSigTx, fileName string) error {
+	b, err := json.MarshalIndent(m, "", "    ")
+	if err != nil {
+		return err
+	}
+	return writeFile(fileName, b)
+}
+
+// LoadMultiSigTx ...
+func LoadMultiSigTx(fileName string) (*MultiSigTx, error) {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %v: %v", fileName, err)
+	}
+	m := &MultiSigTx{}
+	err = json.Unmarshal(b, m)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file %v: %v", fileName, err)
+	}
+	return m, nil
+}
+
+func indexOfPubkey(sigs []*rpcpb.Signature, pubkey []byte) int {
+	for i, s := range sigs {
+		if bytes.Equal(s.PublicKey, pubkey) {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddSignature adds a signer signature or a publisher signature to the envelope.
+// A signature of the same public key is replaced.
+func (m *MultiSigTx) AddSignature(sig *rpcpb.Signature, asPublisher bool) error {
+	if !VerifySigForTx(m.Tx, sig, asPublisher) {
+		return ErrInvalidSignature
+	}
+	if asPublisher {
+		if i := indexOfPubkey(m.Tx.PublisherSigs, sig.PublicKey); i >= 0 {
+			m.Tx.PublisherSigs[i] = sig
+		} else {
+			m.Tx.PublisherSigs = append(m.Tx.PublisherSigs, sig)
+		}
+		return nil
+	}
+	if i := indexOfPubkey(m.Tx.Signatures, sig.PublicKey); i >= 0 {
+		return nil
+	}
+	// publisher signatures cover the signer signatures
+	if len(m.Tx.PublisherSigs) > 0 {
+		return ErrPublisherSigned
+	}
+	m.Tx.Signatures = append(m.Tx.Signatures, sig)
+	return nil
+}
+
+// Combine merges the signatures of another envelope of the same transaction.
+func (m *MultiSigTx) Combine(o *MultiSigTx) error {
+	if m.Tx.Publisher != o.Tx.Publisher || !bytes.Equal(txToBytes(m.Tx, false), txToBytes(o.Tx, false)) {
+		return ErrTransactionMismatch
+	}
+	for _, sig := range o.Tx.Signatures {
+		if indexOfPubkey(m.Tx.Signatures, sig.PublicKey) >= 0 {
+			continue
+		}
+		if !VerifySigForTx(m.Tx, sig, false) {
+			return ErrInvalidSignature
+		}
+		m.Tx.Signatures = append(m.Tx.Signatures, sig)
+	}
+	for _, sig := range o.Tx.PublisherSigs {
+		if indexOfPubkey(m.Tx.PublisherSigs, sig.PublicKey) >= 0 {
+			continue
+		}
+		m.Tx.PublisherSigs = append(m.Tx.PublisherSigs, sig)
+	}
+	// publisher signatures made before all signer signatures were merged are no longer valid
+	for _, sig := range m.Tx.PublisherSigs {
+		if !VerifySigForTx(m.Tx, sig, true) {
+			return fmt.Errorf("%w, publisher %v", ErrPublisherSigned, account.EncodePubkey(sig.PublicKey))
+		}
+	}
+	return nil
+}
+
+// ItemStatus is a permission item and whether it has signed.
+// Permission is set for items referring to the permission of another account.
+type ItemStatus struct {
+	ID         string
+	IsKeyPair  bool
+	Weight     int64
+	Signed     bool
+	Permission *PermissionStatus
+}
+
+// PermissionStatus is the signing progress of a permission of an account, as checked on chain.
+// Fallback is the permission checked in turn when this one is not satisfied, which is owner for active.
+type PermissionStatus struct {
+	Account    string
+	Permission string
+	Threshold  int64
+	Weight     int64
+	Satisfied  bool
+	Items      []*ItemStatus
+	Fallback   *PermissionStatus
+}
+
+// Required returns the permissions the transaction of the envelope needs, the signers
+// followed by the active permission of the publisher.
+func (m *MultiSigTx) Required() []string {
+	ret := append([]string{}, m.Tx.Signers...)
+	return append(ret, m.Tx.Publisher+"@active")
+}
+
+// CheckPermissions checks the permissions the transaction needs against the accounts given by getAccount.
+// Signatures which do not match the transaction are not counted.
+func (m *MultiSigTx) CheckPermissions(getAccount func(name string) (*rpcpb.Account, error)) ([]*PermissionStatus, error) {
+	signerKeys := make(map[string]bool)
+	publisherKeys := make(map[string]bool)
+	for _, sig := range m.Tx.Signatures {
+		if VerifySigForTx(m.Tx, sig, false) {
+			signerKeys[account.EncodePubkey(sig.PublicKey)] = true
+		}
+	}
+	for _, sig := range m.Tx.PublisherSigs {
+		if !VerifySigForTx(m.Tx, sig, true) {
+			continue
+		}
+		signerKeys[account.EncodePubkey(sig.PublicKey)] = true
+		publisherKeys[account.EncodePubkey(sig.PublicKey)] = true
+	}
+	accounts := make(map[string]*rpcpb.Account)
+	getAccountCached := func(name string) (*rpcpb.Account, error) {
+		if a, ok := accounts[name]; ok {
+			return a, nil
+		}
+		a, err := getAccount(name)
+		if err != nil {
+			return nil, err
+		}
+		accounts[name] = a
+		return a, nil
+	}
+
+	ret := make([]*PermissionStatus, 0)
+	for i, r := range m.Required() {
+		ss := strings.Split(r, "@")
+		if len(ss) != 2 {
+			return nil, fmt.Errorf("illegal signer: %v", r)
+		}
+		keys := signerKeys
+		if i == len(m.Tx.Signers) {
+			keys = publisherKeys
+		}
+		s, err := checkPermission(getAccountCached, ss[0], ss[1], keys, make(map[string]bool))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, s)
+	}
+	return ret, nil
+}
+
+// checkPermission follows the auth check of the chain in vm/host/authority.go.
+func checkPermission(getAccount func(string) (*rpcpb.Account, error), id, perm string, keys map[string]bool, reenter map[string]bool) (*PermissionStatus, error) {
+	s := &PermissionStatus{Account: id, Permission: perm}
+	if reenter[id+"@"+perm] {
+		return s, nil
+	}
+	reenter[id+"@"+perm] = true
+
+	a, err := getAccount(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %v: %v", id, err)
+	}
+	p, ok := a.Permissions[perm]
+	if !ok {
+		if perm == "owner" || perm == "active" {
+			return s, nil
+		}
+		return checkPermission(getAccount, id, "active", keys, reenter)
+	}
+	s.Threshold = p.Threshold
+
+	items := append([]*rpcpb.Account_Item{}, p.Items...)
+	for _, g := range p.GroupNames {
+		if grp, ok := a.Groups[g]; ok {
+			items = append(items, grp.Items...)
+		}
+	}
+	for _, item := range items {
+		is := &ItemStatus{ID: item.Id, IsKeyPair: item.IsKeyPair, Weight: item.Weight}
+		if item.IsKeyPair {
+			is.Signed = keys[item.Id]
+		} else {
+			is.ID = item.Id + "@" + item.Permission
+			is.Permission, err = checkPermission(getAccount, item.Id, item.Permission, keys, reenter)
+			if err != nil {
+				return nil, err
+			}
+			is.Signed = is.Permission.Satisfied
+		}
+		if is.Signed {
+			s.Weight += item.Weight
+		}
+		s.Items = append(s.Items, is)
+	}
+	s.Satisfied = s.Weight >= s.Threshold
+
+	if s.Satisfied || perm == "owner" {
+		return s, nil
+	}
+	fallback := "active"
+	if perm == "active" {
+		fallback = "owner"
+	}
+	s.Fallback, err = checkPermission(getAccount, id, fallback, keys, reenter)
+	if err != nil {
+		return nil, err
+	}
+	s.Satisfied = s.Fallback.Satisfied
+	return s, nil
+}
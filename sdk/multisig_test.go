@@ -0,0 +1,335 @@
+package sdk
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/crypto"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKeyPairs(t *testing.T, n int) []*account.KeyPair {
+	kps := make([]*account.KeyPair, n)
+	for i := range kps {
+		kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+		require.Nil(t, err)
+		kps[i] = kp
+	}
+	return kps
+}
+
+func newTestMultiSigTx() *MultiSigTx {
+	t := &rpcpb.TransactionRequest{
+		Time:       1,
+		Expiration: 2,
+		GasRatio:   1,
+		GasLimit:   1000000,
+		ChainId:    1024,
+		Actions:    []*rpcpb.Action{NewAction("token.iost", "transfer", `["iost","bob","carol","1",""]`)},
+		Signers:    []string{"bob@active"},
+	}
+	return NewMultiSigTx(t, "alice")
+}
+
+func TestMultiSigTx_AddSignature(t *testing.T) {
+	kps := newKeyPairs(t, 3)
+	tests := []struct {
+		name string
+		// signatures added before, by key pair index, negative for publisher signatures
+		before      []int
+		sig         func(m *MultiSigTx) *rpcpb.Signature
+		asPublisher bool
+		err         error
+		signers     int
+		publishers  int
+	}{
+		{
+			name:    "signer",
+			sig:     func(m *MultiSigTx) *rpcpb.Signature { return GetSignatureOfTx(m.Tx, kps[0], false) },
+			signers: 1,
+		},
+		{
+			name:    "duplicate signer",
+			before:  []int{0},
+			sig:     func(m *MultiSigTx) *rpcpb.Signature { return GetSignatureOfTx(m.Tx, kps[0], false) },
+			signers: 1,
+		},
+		{
+			name: "wrong key",
+			sig: func(m *MultiSigTx) *rpcpb.Signature {
+				sig := GetSignatureOfTx(m.Tx, kps[0], false)
+				sig.PublicKey = kps[1].Pubkey
+				return sig
+			},
+			err: ErrInvalidSignature,
+		},
+		{
+			name: "publisher signature as signer",
+			sig:  func(m *MultiSigTx) *rpcpb.Signature { return GetSignatureOfTx(m.Tx, kps[0], true) },
+			err:  ErrInvalidSignature,
+		},
+		{
+			name:       "signer after publisher",
+			before:     []int{-2},
+			sig:        func(m *MultiSigTx) *rpcpb.Signature { return GetSignatureOfTx(m.Tx, kps[0], false) },
+			err:        ErrPublisherSigned,
+			publishers: 1,
+		},
+		{
+			name:        "publisher",
+			before:      []int{0, 1},
+			sig:         func(m *MultiSigTx) *rpcpb.Signature { return GetSignatureOfTx(m.Tx, kps[2], true) },
+			asPublisher: true,
+			signers:     2,
+			publishers:  1,
+		},
+		{
+			name:        "duplicate publisher",
+			before:      []int{0, -2},
+			sig:         func(m *MultiSigTx) *rpcpb.Signature { return GetSignatureOfTx(m.Tx, kps[2], true) },
+			asPublisher: true,
+			signers:     1,
+			publishers:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestMultiSigTx()
+			for _, i := range tt.before {
+				if i < 0 {
+					require.Nil(t, m.AddSignature(GetSignatureOfTx(m.Tx, kps[-i], true), true))
+				} else {
+					require.Nil(t, m.AddSignature(GetSignatureOfTx(m.Tx, kps[i], false), false))
+				}
+			}
+			err := m.AddSignature(tt.sig(m), tt.asPublisher)
+			assert.True(t, errors.Is(err, tt.err), "got %v, want %v", err, tt.err)
+			assert.Len(t, m.Tx.Signatures, tt.signers)
+			assert.Len(t, m.Tx.PublisherSigs, tt.publishers)
+			assert.Nil(t, VerifySignature(m.Tx))
+		})
+	}
+}
+
+func TestMultiSigTx_Combine(t *testing.T) {
+	kps := newKeyPairs(t, 3)
+	// partial returns an envelope signed by the signers, and then by the publishers
+	partial := func(signers []int, publishers []int) *MultiSigTx {
+		m := newTestMultiSigTx()
+		for _, i := range signers {
+			require.Nil(t, m.AddSignature(GetSignatureOfTx(m.Tx, kps[i], false), false))
+		}
+		for _, i := range publishers {
+			require.Nil(t, m.AddSignature(GetSignatureOfTx(m.Tx, kps[i], true), true))
+		}
+		return m
+	}
+	tests := []struct {
+		name       string
+		a, b       func() *MultiSigTx
+		err        error
+		signers    int
+		publishers int
+	}{
+		{
+			name:    "partial signatures",
+			a:       func() *MultiSigTx { return partial([]int{0}, nil) },
+			b:       func() *MultiSigTx { return partial([]int{1}, nil) },
+			signers: 2,
+		},
+		{
+			name:    "duplicate signers",
+			a:       func() *MultiSigTx { return partial([]int{0, 1}, nil) },
+			b:       func() *MultiSigTx { return partial([]int{1}, nil) },
+			signers: 2,
+		},
+		{
+			name: "different transactions",
+			a:    func() *MultiSigTx { return partial([]int{0}, nil) },
+			b: func() *MultiSigTx {
+				m := partial([]int{1}, nil)
+				m.Tx.GasLimit++
+				return m
+			},
+			err:     ErrTransactionMismatch,
+			signers: 1,
+		},
+		{
+			name: "wrong key",
+			a:    func() *MultiSigTx { return partial([]int{0}, nil) },
+			b: func() *MultiSigTx {
+				m := partial([]int{1}, nil)
+				m.Tx.Signatures[0].PublicKey = kps[2].Pubkey
+				return m
+			},
+			err:     ErrInvalidSignature,
+			signers: 1,
+		},
+		{
+			name:       "publisher signed after all signers",
+			a:          func() *MultiSigTx { return partial([]int{0, 1}, nil) },
+			b:          func() *MultiSigTx { return partial([]int{0, 1}, []int{2}) },
+			signers:    2,
+			publishers: 1,
+		},
+		{
+			name:       "publisher signed before all signers",
+			a:          func() *MultiSigTx { return partial([]int{0}, nil) },
+			b:          func() *MultiSigTx { return partial([]int{1}, []int{2}) },
+			err:        ErrPublisherSigned,
+			signers:    2,
+			publishers: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the partial envelopes are passed around as files
+			dir := t.TempDir()
+			fa, fb := filepath.Join(dir, "a.json"), filepath.Join(dir, "b.json")
+			require.Nil(t, SaveMultiSigTx(tt.a(), fa))
+			require.Nil(t, SaveMultiSigTx(tt.b(), fb))
+			a, err := LoadMultiSigTx(fa)
+			require.Nil(t, err)
+			b, err := LoadMultiSigTx(fb)
+			require.Nil(t, err)
+
+			err = a.Combine(b)
+			assert.True(t, errors.Is(err, tt.err), "got %v, want %v", err, tt.err)
+			assert.Len(t, a.Tx.Signatures, tt.signers)
+			assert.Len(t, a.Tx.PublisherSigs, tt.publishers)
+		})
+	}
+}
+
+func TestMultiSigTx_CheckPermissions(t *testing.T) {
+	kps := newKeyPairs(t, 5)
+	key := func(i int) string { return account.EncodePubkey(kps[i].Pubkey) }
+	keyItem := func(i int, weight int64) *rpcpb.Account_Item {
+		return &rpcpb.Account_Item{Id: key(i), IsKeyPair: true, Weight: weight}
+	}
+	accounts := map[string]*rpcpb.Account{
+		"alice": {
+			Name: "alice",
+			Permissions: map[string]*rpcpb.Account_Permission{
+				"active": {Name: "active", Threshold: 1, Items: []*rpcpb.Account_Item{keyItem(2, 1)}},
+				"owner":  {Name: "owner", Threshold: 1, Items: []*rpcpb.Account_Item{keyItem(2, 1)}},
+			},
+		},
+		"bob": {
+			Name: "bob",
+			Permissions: map[string]*rpcpb.Account_Permission{
+				"active": {Name: "active", Threshold: 2, Items: []*rpcpb.Account_Item{keyItem(0, 1), keyItem(1, 1)}},
+				"owner":  {Name: "owner", Threshold: 1, Items: []*rpcpb.Account_Item{keyItem(3, 1)}},
+			},
+		},
+	}
+	getAccount := func(name string) (*rpcpb.Account, error) {
+		a, ok := accounts[name]
+		if !ok {
+			return nil, errors.New("account not found")
+		}
+		return a, nil
+	}
+
+	tests := []struct {
+		name       string
+		signers    []int
+		publishers []int
+		// forged are the keys claimed by signatures made with another key
+		forged     []int
+		bob        bool
+		bobWeight  int64
+		alice      bool
+		aliceItems []bool
+	}{
+		{
+			name:       "threshold met",
+			signers:    []int{0, 1},
+			publishers: []int{2},
+			bob:        true,
+			bobWeight:  2,
+			alice:      true,
+			aliceItems: []bool{true},
+		},
+		{
+			name:       "threshold not met",
+			signers:    []int{0},
+			publishers: []int{2},
+			bobWeight:  1,
+			alice:      true,
+			aliceItems: []bool{true},
+		},
+		{
+			name:       "duplicate signers",
+			signers:    []int{0, 0},
+			bobWeight:  1,
+			aliceItems: []bool{false},
+		},
+		{
+			name:       "wrong key",
+			signers:    []int{0},
+			forged:     []int{1},
+			bobWeight:  1,
+			aliceItems: []bool{false},
+		},
+		{
+			name:       "key not in the permission",
+			signers:    []int{0, 4},
+			bobWeight:  1,
+			aliceItems: []bool{false},
+		},
+		{
+			name:       "owner fallback",
+			signers:    []int{3},
+			bob:        true,
+			aliceItems: []bool{false},
+		},
+		{
+			name:       "publisher key as signer",
+			signers:    []int{0, 1, 2},
+			bob:        true,
+			bobWeight:  2,
+			aliceItems: []bool{false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestMultiSigTx()
+			for _, i := range tt.signers {
+				m.Tx.Signatures = append(m.Tx.Signatures, GetSignatureOfTx(m.Tx, kps[i], false))
+			}
+			for _, i := range tt.forged {
+				sig := GetSignatureOfTx(m.Tx, kps[4], false)
+				sig.PublicKey = kps[i].Pubkey
+				m.Tx.Signatures = append(m.Tx.Signatures, sig)
+			}
+			for _, i := range tt.publishers {
+				m.Tx.PublisherSigs = append(m.Tx.PublisherSigs, GetSignatureOfTx(m.Tx, kps[i], true))
+			}
+
+			ss, err := m.CheckPermissions(getAccount)
+			require.Nil(t, err)
+			require.Len(t, ss, 2)
+			bob, alice := ss[0], ss[1]
+			assert.Equal(t, "bob", bob.Account)
+			assert.Equal(t, "active", bob.Permission)
+			assert.Equal(t, tt.bob, bob.Satisfied)
+			assert.Equal(t, tt.bobWeight, bob.Weight)
+			assert.Equal(t, "alice", alice.Account)
+			assert.Equal(t, tt.alice, alice.Satisfied)
+			require.Len(t, alice.Items, len(tt.aliceItems))
+			for i, signed := range tt.aliceItems {
+				assert.Equal(t, signed, alice.Items[i].Signed)
+			}
+		})
+	}
+
+	m := newTestMultiSigTx()
+	m.Tx.Signers = []string{"nobody@active"}
+	_, err := m.CheckPermissions(getAccount)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,138 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	blockpb "github.com/iost-official/go-iost/v3/core/block/pb"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/crypto"
+	sigpb "github.com/iost-official/go-iost/v3/crypto/pb"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/vm/database"
+)
+
+// GetStateProof returns a value of contract storage with the merkle proof of it.
+func (s *IOSTDevSDK) GetStateProof(r *rpcpb.GetStateProofRequest) (*rpcpb.GetStateProofResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetStateProof(context.Background(), r)
+}
+
+// VerifyStateProof checks the value in the response to the request against the state root, which should come from
+// a block head trusted by the caller rather than from the response.
+func VerifyStateProof(req *rpcpb.GetStateProofRequest, res *rpcpb.GetStateProofResponse, stateRoot string) error {
+	key := database.StateKey(req.Id, req.Key, req.Field)
+	if key != res.StateKey {
+		return fmt.Errorf("state key not match: %v != %v", res.StateKey, key)
+	}
+	if stateRoot != res.StateRoot {
+		return fmt.Errorf("state root not match: %v != %v", res.StateRoot, stateRoot)
+	}
+	proof := &merkletree.SparseMerkleProof{}
+	for _, s := range res.Siblings {
+		proof.Siblings = append(proof.Siblings, common.Base58Decode(s))
+	}
+	if res.LeafKey != "" {
+		proof.LeafKey = common.Base58Decode(res.LeafKey)
+		proof.LeafValue = common.Base58Decode(res.LeafValue)
+	}
+	var value []byte
+	if res.Exist {
+		value = []byte(res.Value)
+	}
+	return merkletree.VerifySparseMerkleProof(common.Base58Decode(stateRoot), []byte(key), value, proof)
+}
+
+// GetTxInclusionProof returns the inclusion proof of an irreversible tx.
+func (s *IOSTDevSDK) GetTxInclusionProof(txHash string) (*rpcpb.TxInclusionProofResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetTxInclusionProof(context.Background(), &rpcpb.TxHashRequest{Hash: txHash})
+}
+
+// GetReceiptInclusionProof returns the inclusion proof of the receipt of an irreversible tx.
+func (s *IOSTDevSDK) GetReceiptInclusionProof(txHash string) (*rpcpb.ReceiptInclusionProofResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetReceiptInclusionProof(context.Background(), &rpcpb.TxHashRequest{Hash: txHash})
+}
+
+// verifyBlockHead checks the head hashes to the trusted block hash and is signed by its witness.
+func verifyBlockHead(head *blockpb.BlockHead, sign *sigpb.Signature, blockHash string) (*block.BlockHead, error) {
+	if head == nil || sign == nil {
+		return nil, fmt.Errorf("block head or signature missing")
+	}
+	blk := &block.Block{
+		Head: (&block.BlockHead{}).FromPb(head),
+		Sign: (&crypto.Signature{}).FromPb(sign),
+	}
+	blk.CalculateHeadHash()
+	if hash := common.Base58Encode(blk.HeadHash()); hash != blockHash {
+		return nil, fmt.Errorf("block hash not match: %v != %v", hash, blockHash)
+	}
+	if err := blk.VerifySelf(); err != nil {
+		return nil, err
+	}
+	return blk.Head, nil
+}
+
+func base58DecodeAll(hashes []string) [][]byte {
+	ret := make([][]byte, 0, len(hashes))
+	for _, h := range hashes {
+		ret = append(ret, common.Base58Decode(h))
+	}
+	return ret
+}
+
+// VerifyTxInclusionProof checks the tx in the proof is included in the block of the hash, which should be trusted by
+// the caller rather than from the proof. It returns the tx and the head of the block.
+func VerifyTxInclusionProof(res *rpcpb.TxInclusionProofResponse, blockHash string) (*tx.Tx, *block.BlockHead, error) {
+	head, err := verifyBlockHead(res.BlockHead, res.BlockSign, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Transaction == nil {
+		return nil, nil, fmt.Errorf("transaction missing")
+	}
+	t := (&tx.Tx{}).FromPb(res.Transaction)
+	if !merkletree.VerifyMerklePath(head.TxMerkleHash, t.Hash(), res.Index, base58DecodeAll(res.MerklePath)) {
+		return nil, nil, fmt.Errorf("tx %v is not in the tx merkle hash of the block", common.Base58Encode(t.Hash()))
+	}
+	return t, head, nil
+}
+
+// VerifyReceiptInclusionProof checks the receipt in the proof is included in the block of the hash, which should be
+// trusted by the caller rather than from the proof. It returns the receipt and the head of the block.
+func VerifyReceiptInclusionProof(res *rpcpb.ReceiptInclusionProofResponse, blockHash string) (*tx.TxReceipt, *block.BlockHead, error) {
+	head, err := verifyBlockHead(res.BlockHead, res.BlockSign, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Receipt == nil {
+		return nil, nil, fmt.Errorf("receipt missing")
+	}
+	r := (&tx.TxReceipt{}).FromPb(res.Receipt)
+	if !merkletree.VerifyMerklePath(head.TxReceiptMerkleHash, r.Hash(), res.Index, base58DecodeAll(res.MerklePath)) {
+		return nil, nil, fmt.Errorf("receipt of tx %v is not in the tx receipt merkle hash of the block", common.Base58Encode(r.TxHash))
+	}
+	return r, head, nil
+}
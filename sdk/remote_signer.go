@@ -0,0 +1,180 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/account"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	jsonpb "google.golang.org/protobuf/encoding/protojson"
+)
+
+// Paths of the remote signer protocol. Requests and responses are json, and errors are
+// returned with a non 200 status code and a SignerErrorResponse.
+const (
+	SignerKeysPath = "/v1/keys"
+	SignerSignPath = "/v1/sign"
+)
+
+// SignerKey is a key held by a remote signer.
+type SignerKey struct {
+	PublicKey string `json:"public_key"`
+	Algorithm string `json:"algorithm"`
+}
+
+// SignerKeysResponse is the response of SignerKeysPath.
+type SignerKeysResponse struct {
+	Keys []*SignerKey `json:"keys"`
+}
+
+// SignerSignRequest is the request of SignerSignPath. The signer computes the hash to sign
+// from the transaction itself, so it can check the transaction against its policy.
+type SignerSignRequest struct {
+	PublicKey string          `json:"public_key"`
+	WithSign  bool            `json:"with_sign"`
+	Tx        json.RawMessage `json:"tx"`
+}
+
+// SignerSignResponse is the response of SignerSignPath.
+type SignerSignResponse struct {
+	Signature json.RawMessage `json:"signature"`
+}
+
+// SignerErrorResponse ...
+type SignerErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewSignerSignRequest ...
+func NewSignerSignRequest(t *rpcpb.TransactionRequest, pubkey []byte, withSign bool) (*SignerSignRequest, error) {
+	tx, err := jsonpb.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return &SignerSignRequest{
+		PublicKey: account.EncodePubkey(pubkey),
+		WithSign:  withSign,
+		Tx:        tx,
+	}, nil
+}
+
+// ParseTx ...
+func (r *SignerSignRequest) ParseTx() (*rpcpb.TransactionRequest, error) {
+	t := &rpcpb.TransactionRequest{}
+	err := jsonpb.Unmarshal(r.Tx, t)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// RemoteSigner signs with a key held by a remote signer, such as cmd/isigner.
+type RemoteSigner struct {
+	endpoint string
+	pubkey   []byte
+	token    string
+	client   *http.Client
+}
+
+// NewRemoteSigner returns a signer of the key pubkey held by the signer at endpoint.
+// The token is sent as a bearer token if not empty.
+func NewRemoteSigner(endpoint string, pubkey string, token string) *RemoteSigner {
+	return &RemoteSigner{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		pubkey:   account.DecodePubkey(pubkey),
+		token:    token,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func openRemoteSigner(u *url.URL) (Signer, error) {
+	q := u.Query()
+	pubkey := q.Get("public_key")
+	if pubkey == "" {
+		return nil, fmt.Errorf("public_key of the remote signer is not set")
+	}
+	token := q.Get("token")
+	q.Del("public_key")
+	q.Del("token")
+	u.RawQuery = q.Encode()
+	return NewRemoteSigner(u.String(), pubkey, token), nil
+}
+
+func (s *RemoteSigner) do(method, path string, req, res interface{}) error {
+	var body io.Reader
+	if req != nil {
+		b, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	r, err := http.NewRequest(method, s.endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		r.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	resp, err := s.client.Do(r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		e := &SignerErrorResponse{}
+		if json.Unmarshal(b, e) == nil && e.Error != "" {
+			return fmt.Errorf("remote signer error: %v", e.Error)
+		}
+		return fmt.Errorf("remote signer error: %v", resp.Status)
+	}
+	return json.Unmarshal(b, res)
+}
+
+// Keys returns the keys held by the remote signer.
+func (s *RemoteSigner) Keys() ([]*SignerKey, error) {
+	res := &SignerKeysResponse{}
+	err := s.do(http.MethodGet, SignerKeysPath, nil, res)
+	if err != nil {
+		return nil, err
+	}
+	return res.Keys, nil
+}
+
+// PublicKey ...
+func (s *RemoteSigner) PublicKey() []byte {
+	return s.pubkey
+}
+
+// SignTx ...
+func (s *RemoteSigner) SignTx(t *rpcpb.TransactionRequest, withSign bool) (*rpcpb.Signature, error) {
+	req, err := NewSignerSignRequest(t, s.pubkey, withSign)
+	if err != nil {
+		return nil, err
+	}
+	res := &SignerSignResponse{}
+	err = s.do(http.MethodPost, SignerSignPath, req, res)
+	if err != nil {
+		return nil, err
+	}
+	sig := &rpcpb.Signature{}
+	err = jsonpb.Unmarshal(res.Signature, sig)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(sig.PublicKey, s.pubkey) || !VerifySigForTx(t, sig, withSign) {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
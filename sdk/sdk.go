@@ -21,8 +21,8 @@ type IOSTDevSDK struct {
 	// the remote server to connect to
 	server string
 
-	// all available accounts keys, name->perm->keypair
-	accounts map[string]map[string]*account.KeyPair
+	// all available accounts signers, name->perm->signer
+	accounts map[string]map[string]Signer
 	// default account used for sending tx
 	accountName string
 	permission  string
@@ -59,7 +59,7 @@ func NewIOSTDevSDK() *IOSTDevSDK {
 		checkResult:         true,
 		checkResultDelay:    3,
 		checkResultMaxRetry: 20,
-		accounts:            make(map[string]map[string]*account.KeyPair),
+		accounts:            make(map[string]map[string]Signer),
 		gasLimit:            1000000,
 		gasRatio:            1.0,
 		amountLimit:         []*rpcpb.AmountLimit{{Token: "*", Value: "unlimited"}},
@@ -77,10 +77,17 @@ func (s *IOSTDevSDK) SetChainID(chainID uint32) {
 
 func (s *IOSTDevSDK) AddAccountWithPerm(name string, kp *account.KeyPair, perm string) {
 	if kp != nil {
+		s.AddAccountWithSigner(name, NewKeyPairSigner(kp), perm)
+	}
+}
+
+// AddAccountWithSigner adds the signer of the permission of an account, which signs transactions of the account.
+func (s *IOSTDevSDK) AddAccountWithSigner(name string, signer Signer, perm string) {
+	if signer != nil {
 		if s.accounts[name] == nil {
-			s.accounts[name] = make(map[string]*account.KeyPair)
+			s.accounts[name] = make(map[string]Signer)
 		}
-		s.accounts[name][perm] = kp
+		s.accounts[name][perm] = signer
 	}
 }
 func (s *IOSTDevSDK) UseAccountAndPerm(name string, perm string) {
@@ -181,6 +188,18 @@ func (s *IOSTDevSDK) GetContractStorage(r *rpcpb.GetContractStorageRequest) (*rp
 	return value, nil
 }
 
+// ListContractStorage ...
+func (s *IOSTDevSDK) ListContractStorage(r *rpcpb.ListContractStorageRequest) (*rpcpb.ListContractStorageResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.ListContractStorage(context.Background(), r)
+}
+
 // GetBatchContractStorage ...
 func (s *IOSTDevSDK) GetBatchContractStorage(r *rpcpb.GetBatchContractStorageRequest) (*rpcpb.GetBatchContractStorageResponse, error) {
 	if s.rpcConn == nil {
@@ -262,6 +281,18 @@ func (s *IOSTDevSDK) GetAccountInfo(id string) (*rpcpb.Account, error) {
 	return value, nil
 }
 
+// GetContract return the contract on chain
+func (s *IOSTDevSDK) GetContract(id string) (*rpcpb.Contract, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetContract(context.Background(), &rpcpb.GetContractRequest{Id: id, ByLongestChain: s.useLongestChain})
+}
+
 // GetTokenBalance return token balance
 func (s *IOSTDevSDK) GetTokenBalance(account string, token string) (*rpcpb.GetTokenBalanceResponse, error) {
 	if s.rpcConn == nil {
@@ -331,6 +362,53 @@ func (s *IOSTDevSDK) GetTxReceiptByTxHash(txHashStr string) (*rpcpb.TxReceipt, e
 	return client.GetTxReceiptByTxHash(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
 }
 
+// GetAccountTransactions returns the transactions of the account, newest first
+func (s *IOSTDevSDK) GetAccountTransactions(account string, limit int32, cursor string) (*rpcpb.GetAccountTransactionsResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	req := &rpcpb.GetAccountTransactionsRequest{
+		Account: account,
+		Limit:   limit,
+		Cursor:  cursor,
+	}
+	return client.GetAccountTransactions(context.Background(), req)
+}
+
+// ListPendingTransactions returns the pending transactions of the tx pool, ordered by priority
+func (s *IOSTDevSDK) ListPendingTransactions(publisher, contract string, offset, limit int32) (*rpcpb.ListPendingTransactionsResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	req := &rpcpb.ListPendingTransactionsRequest{
+		Publisher: publisher,
+		Contract:  contract,
+		Offset:    offset,
+		Limit:     limit,
+	}
+	return client.ListPendingTransactions(context.Background(), req)
+}
+
+// GetTxPoolStats returns the statistics of the tx pool
+func (s *IOSTDevSDK) GetTxPoolStats() (*rpcpb.TxPoolStatsResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetTxPoolStats(context.Background(), &rpcpb.EmptyRequest{})
+}
+
 // GetTokenInfo ...
 func (s *IOSTDevSDK) GetTokenInfo(token string) (*rpcpb.TokenInfo, error) {
 	if s.rpcConn == nil {
@@ -378,6 +456,30 @@ func (s *IOSTDevSDK) ExecTransaction(signedTx *rpcpb.TransactionRequest) (*rpcpb
 	return resp, nil
 }
 
+// EstimateTransaction send raw transaction to server to estimate its gas, ram and gas ratio
+func (s *IOSTDevSDK) EstimateTransaction(signedTx *rpcpb.TransactionRequest) (*rpcpb.EstimateTransactionResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.EstimateTransaction(context.Background(), signedTx)
+}
+
+// TraceTransaction send raw transaction to server to execute it and get the execution trace
+func (s *IOSTDevSDK) TraceTransaction(signedTx *rpcpb.TransactionRequest) (*rpcpb.TraceTransactionResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.TraceTransaction(context.Background(), signedTx)
+}
+
 ////////////////////////////////////// transaction related /////////////////////////////////
 
 // CreateTxFromActions ...
@@ -404,14 +506,14 @@ func (s *IOSTDevSDK) CreateTxFromActions(actions []*rpcpb.Action) (*rpcpb.Transa
 // SignTx ...
 func (s *IOSTDevSDK) SignTx(t *rpcpb.TransactionRequest) (*rpcpb.TransactionRequest, error) {
 	t.Publisher = s.accountName
-	kp := s.accounts[s.accountName][s.permission]
+	signer, ok := s.accounts[s.accountName][s.permission]
+	if !ok {
+		return nil, fmt.Errorf("no signer of %v@%v", s.accountName, s.permission)
+	}
 	if len(t.PublisherSigs) == 0 {
-		signAlgorithm := kp.Algorithm
-		txHashBytes := common.Sha3(txToBytes(t, true))
-		publishSig := &rpcpb.Signature{
-			Algorithm: rpcpb.Signature_Algorithm(signAlgorithm),
-			Signature: signAlgorithm.Sign(txHashBytes, kp.Seckey),
-			PublicKey: signAlgorithm.GetPubkey(kp.Seckey),
+		publishSig, err := signer.SignTx(t, true)
+		if err != nil {
+			return nil, err
 		}
 		t.PublisherSigs = []*rpcpb.Signature{publishSig}
 	}
@@ -483,13 +585,48 @@ func (s *IOSTDevSDK) TryTx(tx *rpcpb.TransactionRequest) (*rpcpb.TxReceipt, erro
 	return s.ExecTransaction(signedTx)
 }
 
+// EstimateTx signs the transaction and estimates it by the server
+func (s *IOSTDevSDK) EstimateTx(tx *rpcpb.TransactionRequest) (*rpcpb.EstimateTransactionResponse, error) {
+	signedTx, err := s.SignTx(tx)
+	if err != nil {
+		return nil, fmt.Errorf("sign tx error %v", err)
+	}
+	err = VerifySignature(signedTx)
+	if err != nil {
+		return nil, err
+	}
+	s.log("Estimating transaction:")
+	s.log(MarshalTextString(signedTx))
+	return s.EstimateTransaction(signedTx)
+}
+
+// TraceTx executes the tx on the server without creating it on chain, and returns its execution trace
+func (s *IOSTDevSDK) TraceTx(tx *rpcpb.TransactionRequest) (*rpcpb.TraceTransactionResponse, error) {
+	signedTx, err := s.SignTx(tx)
+	if err != nil {
+		return nil, fmt.Errorf("sign tx error %v", err)
+	}
+	err = VerifySignature(signedTx)
+	if err != nil {
+		return nil, err
+	}
+	s.log("Tracing transaction:")
+	s.log(MarshalTextString(signedTx))
+	return s.TraceTransaction(signedTx)
+}
+
 // SendTx send transaction and check result if sdk.checkResult is set
 func (s *IOSTDevSDK) SendTx(tx *rpcpb.TransactionRequest) (string, error) {
 	signedTx, err := s.SignTx(tx)
 	if err != nil {
 		return "", fmt.Errorf("sign tx error %v", err)
 	}
-	err = VerifySignature(signedTx)
+	return s.SendSignedTx(signedTx)
+}
+
+// SendSignedTx send a transaction already signed by its publisher and check result if sdk.checkResult is set
+func (s *IOSTDevSDK) SendSignedTx(signedTx *rpcpb.TransactionRequest) (string, error) {
+	err := VerifySignature(signedTx)
 	if err != nil {
 		return "", err
 	}
@@ -540,8 +677,8 @@ func (s *IOSTDevSDK) UpdateAccountKeysActions(account string, ownerKey string, a
 	if err != nil {
 		return nil, err
 	}
-	oldActiveKey := s.accounts[account]["active"].ReadablePubkey()
-	oldOwnerKey := s.accounts[account]["owner"].ReadablePubkey()
+	oldActiveKey := common.Base58Encode(s.accounts[account]["active"].PublicKey())
+	oldOwnerKey := common.Base58Encode(s.accounts[account]["owner"].PublicKey())
 	var acts []*rpcpb.Action
 	acts = append(acts, NewAction("auth.iost", "assignPermission", fmt.Sprintf(`["%v", "%v", "%v", %v]`, account, "active", activeKey, 100)))
 	acts = append(acts, NewAction("auth.iost", "assignPermission", fmt.Sprintf(`["%v", "%v", "%v", %v]`, account, "owner", ownerKey, 100)))
@@ -600,23 +737,20 @@ func (s *IOSTDevSDK) CreateNewAccount(newID string, ownerKey string, activeKey s
 
 // PublishContractActions makes actions for publishing contract.
 func (s *IOSTDevSDK) PublishContractActions(codePath string, abiPath string, conID string, update bool, updateID string) ([]*rpcpb.Action, error) {
-	fd, err := os.ReadFile(codePath)
+	src, err := os.ReadFile(codePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read source code file: %v", err)
 	}
-	code := string(fd)
+	code := string(src)
 
-	fd, err = os.ReadFile(abiPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read abi file: %v", err)
-	}
-	abi := string(fd)
-
-	var info *contract.Info
-	err = json.Unmarshal([]byte(abi), &info)
+	info, err := loadContractInfo(abiPath)
 	if err != nil {
 		return nil, err
 	}
+	if info.Lang == "wasm" {
+		// wasm binaries are stored base64 encoded
+		code = base64.StdEncoding.EncodeToString(src)
+	}
 	c := &contract.Contract{
 		ID:   conID,
 		Code: code,
@@ -653,6 +787,32 @@ func (s *IOSTDevSDK) PublishContractActions(codePath string, abiPath string, con
 	return []*rpcpb.Action{action}, nil
 }
 
+func loadContractInfo(abiPath string) (*contract.Info, error) {
+	fd, err := os.ReadFile(abiPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read abi file: %v", err)
+	}
+	var info *contract.Info
+	err = json.Unmarshal(fd, &info)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// CompareContractABI returns the abi changes of updating the contract on chain with the abi file.
+func (s *IOSTDevSDK) CompareContractABI(conID string, abiPath string) ([]*contract.ABIChange, error) {
+	info, err := loadContractInfo(abiPath)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.GetContract(conID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contract %v: %v", conID, err)
+	}
+	return contract.CompareABI(ContractInfoOf(c), info), nil
+}
+
 // PublishContract converts contract js code to transaction. If 'send', also send it to chain.
 func (s *IOSTDevSDK) PublishContract(codePath string, abiPath string, conID string, update bool, updateID string) (*rpcpb.TransactionRequest, string, error) {
 	acts, err := s.PublishContractActions(codePath, abiPath, conID, update, updateID)
@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/iost-official/go-iost/v3/account"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+)
+
+// Signer signs transactions without exposing the secret key, which may be held by another process or device.
+type Signer interface {
+	// PublicKey returns the public key of the signer.
+	PublicKey() []byte
+	// SignTx signs the transaction as a signer, or as the publisher if withSign is set.
+	SignTx(t *rpcpb.TransactionRequest, withSign bool) (*rpcpb.Signature, error)
+}
+
+// errors
+var (
+	ErrUnknownSigner = errors.New("unknown signer scheme")
+)
+
+// KeyPairSigner signs with a key pair held in memory.
+type KeyPairSigner struct {
+	kp *account.KeyPair
+}
+
+// NewKeyPairSigner ...
+func NewKeyPairSigner(kp *account.KeyPair) *KeyPairSigner {
+	return &KeyPairSigner{kp: kp}
+}
+
+// PublicKey ...
+func (s *KeyPairSigner) PublicKey() []byte {
+	return s.kp.Pubkey
+}
+
+// SignTx ...
+func (s *KeyPairSigner) SignTx(t *rpcpb.TransactionRequest, withSign bool) (*rpcpb.Signature, error) {
+	return GetSignatureOfTx(t, s.kp, withSign), nil
+}
+
+// KeystoreSigner signs with an encrypted key of the keystore. The key is only decrypted
+// while signing, so the secret key does not stay in memory.
+type KeystoreSigner struct {
+	info     *KeyPairInfo
+	password []byte
+}
+
+// NewKeystoreSigner returns a signer of an encrypted key, checking the password.
+func NewKeystoreSigner(info *KeyPairInfo, password []byte) (*KeystoreSigner, error) {
+	if info.EncryptedKey == "" {
+		return nil, fmt.Errorf("key %v is not encrypted", info.PubKey)
+	}
+	s := &KeystoreSigner{info: info, password: password}
+	if _, err := s.keyPair(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *KeystoreSigner) keyPair() (*account.KeyPair, error) {
+	k := *s.info
+	err := k.Decrypt(s.password)
+	if err != nil {
+		return nil, err
+	}
+	return k.ToKeyPair()
+}
+
+// PublicKey ...
+func (s *KeystoreSigner) PublicKey() []byte {
+	return account.DecodePubkey(s.info.PubKey)
+}
+
+// SignTx ...
+func (s *KeystoreSigner) SignTx(t *rpcpb.TransactionRequest, withSign bool) (*rpcpb.Signature, error) {
+	kp, err := s.keyPair()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for i := range kp.Seckey {
+			kp.Seckey[i] = 0
+		}
+	}()
+	return GetSignatureOfTx(t, kp, withSign), nil
+}
+
+// SignerPlugin opens a signer of the uri, for example a hardware module reached by a PKCS#11 library.
+type SignerPlugin func(uri *url.URL) (Signer, error)
+
+var (
+	signerPluginsMu sync.RWMutex
+	signerPlugins   = map[string]SignerPlugin{
+		"http":  openRemoteSigner,
+		"https": openRemoteSigner,
+	}
+)
+
+// RegisterSignerPlugin registers the plugin opening signers of the uri scheme.
+func RegisterSignerPlugin(scheme string, plugin SignerPlugin) {
+	signerPluginsMu.Lock()
+	defer signerPluginsMu.Unlock()
+	signerPlugins[scheme] = plugin
+}
+
+// OpenSigner opens the signer of the uri by the plugin registered for its scheme.
+// Remote signers are opened by http://host:port/?public_key=xxx.
+func OpenSigner(uri string) (Signer, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	signerPluginsMu.RLock()
+	plugin, ok := signerPlugins[u.Scheme]
+	signerPluginsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownSigner, u.Scheme)
+	}
+	return plugin(u)
+}
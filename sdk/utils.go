@@ -6,6 +6,7 @@ import (
 
 	"github.com/iost-official/go-iost/v3/account"
 	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/contract"
 	"github.com/iost-official/go-iost/v3/crypto"
 	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
 	jsonpb "google.golang.org/protobuf/encoding/protojson"
@@ -65,23 +66,21 @@ func toRPCSign(sig *crypto.Signature) *rpcpb.Signature {
 	}
 }
 
+// SignHashOfTx returns the hash signed by the signers of the transaction, or by the publisher if withSign is set.
+func SignHashOfTx(t *rpcpb.TransactionRequest, withSign bool) []byte {
+	return common.Sha3(txToBytes(t, withSign))
+}
+
 // GetSignatureOfTx ...
 func GetSignatureOfTx(t *rpcpb.TransactionRequest, kp *account.KeyPair, withSign bool) *rpcpb.Signature {
-	hash := common.Sha3(txToBytes(t, withSign))
+	hash := SignHashOfTx(t, withSign)
 	sig := toRPCSign(kp.Sign(hash))
 	return sig
 }
 
-// GetSignAlgoByName ...
-func GetSignAlgoByName(name string) crypto.Algorithm {
-	switch name {
-	case "secp256k1":
-		return crypto.Secp256k1
-	case "ed25519":
-		return crypto.Ed25519
-	default:
-		return crypto.Ed25519
-	}
+// GetSignAlgoByName returns the sign algorithm of name, or an error if the name is unknown.
+func GetSignAlgoByName(name string) (crypto.Algorithm, error) {
+	return crypto.ParseAlgorithm(name)
 }
 
 // GetSignAlgoByEnum ...
@@ -91,6 +90,10 @@ func GetSignAlgoByEnum(enum rpcpb.Signature_Algorithm) crypto.Algorithm {
 		return crypto.Secp256k1
 	case rpcpb.Signature_ED25519:
 		return crypto.Ed25519
+	case rpcpb.Signature_SECP256R1:
+		return crypto.Secp256r1
+	case rpcpb.Signature_BLS:
+		return crypto.BLS
 	default:
 		return crypto.Ed25519
 	}
@@ -98,13 +101,17 @@ func GetSignAlgoByEnum(enum rpcpb.Signature_Algorithm) crypto.Algorithm {
 
 // CheckPubKey check whether a string is a valid public key. Since it is not easy to check it fully, only check length here
 func CheckPubKey(k string) bool {
-	bytes := common.Base58Decode(k)
-	return len(bytes) == 32
+	switch len(common.Base58Decode(k)) {
+	case 32, 33, 96: // ed25519, compressed secp256k1 or secp256r1, bls
+		return true
+	default:
+		return false
+	}
 }
 
 // VerifySigForTx ...
 func VerifySigForTx(t *rpcpb.TransactionRequest, sig *rpcpb.Signature, withSign bool) bool {
-	hash := common.Sha3(txToBytes(t, withSign))
+	hash := SignHashOfTx(t, withSign)
 	return GetSignAlgoByEnum(sig.Algorithm).Verify(hash, sig.PublicKey, sig.Signature)
 }
 
@@ -132,6 +139,25 @@ func NewAction(contract string, name string, data string) *rpcpb.Action {
 	}
 }
 
+// ContractInfoOf returns the info of a contract got from rpc.
+func ContractInfoOf(c *rpcpb.Contract) *contract.Info {
+	info := &contract.Info{
+		Lang:    c.Language,
+		Version: c.Version,
+	}
+	for _, a := range c.Abis {
+		abi := &contract.ABI{
+			Name: a.Name,
+			Args: a.Args,
+		}
+		for _, l := range a.AmountLimit {
+			abi.AmountLimit = append(abi.AmountLimit, &contract.Amount{Token: l.Token, Val: l.Value})
+		}
+		info.Abi = append(info.Abi, abi)
+	}
+	return info
+}
+
 /////////////////////////////////// serialize deserialize ///////////////////////////////////////////
 
 func actionToBytes(a *rpcpb.Action) []byte {
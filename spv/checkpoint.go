@@ -0,0 +1,55 @@
+package spv
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Checkpoint is the latest block the light client trusts, with the producers allowed to sign the blocks after it.
+type Checkpoint struct {
+	Number int64  `json:"number"`
+	Hash   string `json:"hash"`
+	Time   int64  `json:"time"`
+	// Previous is the producer set elected by the vote before the latest one, which keeps producing blocks
+	// until the latest vote takes effect. It is empty once the latest vote takes effect.
+	Previous []string `json:"previous,omitempty"`
+	// Current is the producer set elected by the latest vote.
+	Current []string `json:"current"`
+	// Confirmers are the previous producers having signed the blocks since the latest vote, the vote takes effect
+	// once they are 2/3 of the previous producers.
+	Confirmers []string `json:"confirmers,omitempty"`
+}
+
+// LoadCheckpoint reads the checkpoint from a json file. It returns nil if the file does not exist.
+func LoadCheckpoint(fileName string) (*Checkpoint, error) {
+	b, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cp := &Checkpoint{}
+	err = json.Unmarshal(b, cp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid checkpoint file %v: %v", fileName, err)
+	}
+	if cp.Hash == "" || len(cp.Current) == 0 {
+		return nil, fmt.Errorf("invalid checkpoint file %v: hash or producers missing", fileName)
+	}
+	return cp, nil
+}
+
+// Save writes the checkpoint to a json file. The file is replaced at once so a crash never leaves it half written.
+func (cp *Checkpoint) Save(fileName string) error {
+	b, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := fileName + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, fileName)
+}
@@ -0,0 +1,523 @@
+// Package spv is a light client of the chain. It follows the irreversible blocks of a full node by their signed
+// headers only, tracking the producers elected every common.VoteInterval blocks, and verifies the txs served by
+// the node with inclusion proofs against the headers. The node is not trusted, only the checkpoint is.
+package spv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/blockcache"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/ilog"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	"github.com/iost-official/go-iost/v3/sdk"
+)
+
+// Errors of the light client.
+var (
+	ErrNoCheckpoint = errors.New("no checkpoint saved, a trusted vote block is required to start")
+	ErrNotVerified  = errors.New("block not verified by the light client")
+	ErrNotConfirmed = errors.New("blocks not confirmed by the producers")
+)
+
+// maxRange is the most blocks returned by GetBlockHeaderByRange.
+const maxRange = 110
+
+// Source is the full node followed by the light client, which is rpcpb.ApiServiceClient in general.
+type Source interface {
+	GetChainInfo(ctx context.Context, in *rpcpb.EmptyRequest, opts ...grpc.CallOption) (*rpcpb.ChainInfoResponse, error)
+	GetRawBlockByNumber(ctx context.Context, in *rpcpb.GetBlockByNumberRequest, opts ...grpc.CallOption) (*rpcpb.RawBlockResponse, error)
+	GetBlockHeaderByRange(ctx context.Context, in *rpcpb.GetBlockHeaderByRangeRequest, opts ...grpc.CallOption) (*rpcpb.BlockHeaderByRangeResponse, error)
+	GetTxInclusionProof(ctx context.Context, in *rpcpb.TxHashRequest, opts ...grpc.CallOption) (*rpcpb.TxInclusionProofResponse, error)
+	GetReceiptInclusionProof(ctx context.Context, in *rpcpb.TxHashRequest, opts ...grpc.CallOption) (*rpcpb.ReceiptInclusionProofResponse, error)
+}
+
+// producers are the producer sets around the latest vote. Like the block cache of a full node, the producers voted
+// take effect after 2/3 of the previous producers have signed the blocks since the vote block.
+type producers struct {
+	// previous is empty once current takes effect.
+	previous []string
+	current  []string
+	// confirmers are the previous producers having signed the blocks since the vote block.
+	confirmers []string
+}
+
+// active returns the producer set in effect.
+func (p *producers) active() []string {
+	if len(p.previous) > 0 {
+		return p.previous
+	}
+	return p.current
+}
+
+func (p *producers) has(witness string) bool {
+	return common.BelongsTo(witness, p.active())
+}
+
+// signed returns the producers after the block signed by the witness, which switch to current once the witness
+// makes 2/3 of the previous producers confirm the vote.
+func (p *producers) signed(witness string) *producers {
+	if len(p.previous) == 0 || !common.BelongsTo(witness, p.previous) || common.BelongsTo(witness, p.confirmers) {
+		return p
+	}
+	confirmers := append(append([]string{}, p.confirmers...), witness)
+	if len(confirmers) >= len(p.previous)*2/3+1 {
+		return &producers{current: p.current}
+	}
+	return &producers{
+		previous:   p.previous,
+		current:    p.current,
+		confirmers: confirmers,
+	}
+}
+
+// confirmedBy returns whether the witnesses of the blocks include 2/3 of the producer set in effect.
+func (p *producers) confirmedBy(blocks []*entry) bool {
+	set := p.active()
+	limit := len(set)*2/3 + 1
+	witnesses := make(map[string]bool)
+	for _, e := range blocks {
+		if common.BelongsTo(e.blk.Head.Witness, set) {
+			witnesses[e.blk.Head.Witness] = true
+		}
+		if len(witnesses) >= limit {
+			return true
+		}
+	}
+	return false
+}
+
+type entry struct {
+	blk *block.Block
+	// producers are the producers allowed to sign the children of the block.
+	producers *producers
+}
+
+// Client is the light client.
+type Client struct {
+	src       Source
+	file      string
+	cacheSize int
+
+	// syncMu guards the blocks verified but not confirmed yet, which are only touched by Sync.
+	syncMu      sync.Mutex
+	tip         *entry
+	unconfirmed []*entry
+
+	mu      sync.RWMutex
+	head    *entry
+	headers []*block.Block
+	byHash  map[string]*block.Block
+}
+
+// NewClient returns a light client following the source. The checkpoint is kept in the file, and the latest
+// cacheSize confirmed headers are kept in memory to serve queries.
+func NewClient(src Source, file string, cacheSize int) *Client {
+	if cacheSize <= 0 {
+		cacheSize = 1
+	}
+	return &Client{
+		src:       src,
+		file:      file,
+		cacheSize: cacheSize,
+	}
+}
+
+// Start restores the checkpoint saved in the file, or trusts the vote block of the number and hash if there is
+// none. The block of the hash should come from a trusted source.
+func (c *Client) Start(number int64, hash string) error {
+	cp, err := LoadCheckpoint(c.file)
+	if err != nil {
+		return err
+	}
+	if cp == nil {
+		if hash == "" {
+			return ErrNoCheckpoint
+		}
+		cp, err = c.trust(number, hash)
+		if err != nil {
+			return err
+		}
+		if err := cp.Save(c.file); err != nil {
+			return err
+		}
+	} else if hash != "" && cp.Number < number {
+		ilog.Warnf("Checkpoint %v in %v is older than the trusted block %v, remove the file to start from the block", cp.Number, c.file, number)
+	}
+	blk, err := c.fetchHeader(cp.Number)
+	if err != nil {
+		return err
+	}
+	if h := common.Base58Encode(blk.HeadHash()); h != cp.Hash {
+		return fmt.Errorf("block %v has hash %v instead of the checkpoint %v", cp.Number, h, cp.Hash)
+	}
+	head := &entry{
+		blk: blk,
+		producers: &producers{
+			previous:   cp.Previous,
+			current:    cp.Current,
+			confirmers: cp.Confirmers,
+		},
+	}
+	c.syncMu.Lock()
+	c.tip = head
+	c.unconfirmed = nil
+	c.syncMu.Unlock()
+
+	c.mu.Lock()
+	c.head = head
+	c.headers = []*block.Block{blk}
+	c.byHash = map[string]*block.Block{string(blk.HeadHash()): blk}
+	c.mu.Unlock()
+	ilog.Infof("Light client starts from block %v %v with producers %v", cp.Number, cp.Hash, cp.Current)
+	return nil
+}
+
+func (c *Client) trust(number int64, hash string) (*Checkpoint, error) {
+	if number <= 0 || number%common.VoteInterval != 0 {
+		return nil, fmt.Errorf("trusted block %v is not a vote block", number)
+	}
+	blk, err := c.fetchBlock(number)
+	if err != nil {
+		return nil, err
+	}
+	status, err := verifyVoteBlock(blk, common.Base58Decode(hash))
+	if err != nil {
+		return nil, err
+	}
+	p := (&producers{previous: status.CurrentList, current: status.PendingList}).signed(blk.Head.Witness)
+	return &Checkpoint{
+		Number:     number,
+		Hash:       hash,
+		Time:       blk.Head.Time,
+		Previous:   p.previous,
+		Current:    p.current,
+		Confirmers: p.confirmers,
+	}, nil
+}
+
+// verifyVoteBlock checks the block of the hash commits to its receipts, and returns the producers voted in it.
+func verifyVoteBlock(blk *block.Block, hash []byte) (*blockcache.WitnessStatus, error) {
+	if !bytes.Equal(blk.HeadHash(), hash) {
+		return nil, fmt.Errorf("block %v has hash %v instead of %v", blk.Head.Number,
+			common.Base58Encode(blk.HeadHash()), common.Base58Encode(hash))
+	}
+	if err := blk.VerifySelf(); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(blk.CalculateTxReceiptMerkleHash(), blk.Head.TxReceiptMerkleHash) {
+		return nil, fmt.Errorf("receipts of block %v not match the tx receipt merkle hash", blk.Head.Number)
+	}
+	status, err := blockcache.GetWitnessStatusFromBlock(blk)
+	if err != nil {
+		return nil, err
+	}
+	if len(status.PendingList) == 0 {
+		return nil, fmt.Errorf("no producers voted in block %v", blk.Head.Number)
+	}
+	return status, nil
+}
+
+func (c *Client) fetchBlock(number int64) (*block.Block, error) {
+	res, err := c.src.GetRawBlockByNumber(context.Background(), &rpcpb.GetBlockByNumberRequest{Number: number, Complete: true})
+	if err != nil {
+		return nil, err
+	}
+	if res.Block == nil || res.Block.Head == nil {
+		return nil, fmt.Errorf("block %v missing", number)
+	}
+	blk := &block.Block{}
+	blk.FromPb(res.Block)
+	return blk, nil
+}
+
+// fetchHeaders returns the headers in [start, end), which must be no more than maxRange.
+func (c *Client) fetchHeaders(start, end int64) ([]*block.Block, error) {
+	res, err := c.src.GetBlockHeaderByRange(context.Background(), &rpcpb.GetBlockHeaderByRangeRequest{Start: start, End: end})
+	if err != nil {
+		return nil, err
+	}
+	blks := make([]*block.Block, 0, len(res.BlockList))
+	for _, b := range res.BlockList {
+		if b.Head == nil {
+			return nil, errors.New("block head missing")
+		}
+		blk := &block.Block{}
+		blk.FromPb(b)
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+func (c *Client) fetchHeader(number int64) (*block.Block, error) {
+	blks, err := c.fetchHeaders(number, number+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(blks) != 1 || blks[0].Head.Number != number {
+		return nil, fmt.Errorf("block %v missing", number)
+	}
+	return blks[0], nil
+}
+
+// Sync follows the irreversible blocks of the source, and moves the checkpoint to the latest block confirmed by
+// the producers.
+func (c *Client) Sync() error {
+	c.syncMu.Lock()
+	defer c.syncMu.Unlock()
+	if c.tip == nil {
+		return ErrNoCheckpoint
+	}
+
+	info, err := c.src.GetChainInfo(context.Background(), &rpcpb.EmptyRequest{})
+	if err != nil {
+		return err
+	}
+	for c.tip.blk.Head.Number < info.LibBlock {
+		start := c.tip.blk.Head.Number + 1
+		end := start + maxRange
+		if end > info.LibBlock+1 {
+			end = info.LibBlock + 1
+		}
+		blks, err := c.fetchHeaders(start, end)
+		if err != nil {
+			return err
+		}
+		if len(blks) == 0 {
+			break
+		}
+		for _, blk := range blks {
+			if err := c.push(blk); err != nil {
+				return err
+			}
+		}
+		// the blocks of an epoch are enough to be confirmed
+		if len(c.unconfirmed) > int(common.VoteInterval) {
+			break
+		}
+	}
+	return c.confirm()
+}
+
+// push verifies the block is a child of the tip signed by the producers.
+func (c *Client) push(blk *block.Block) error {
+	parent := c.tip
+	if blk.Head.Number != parent.blk.Head.Number+1 || !bytes.Equal(blk.Head.ParentHash, parent.blk.HeadHash()) {
+		return fmt.Errorf("block %v %v is not a child of block %v %v", blk.Head.Number,
+			common.Base58Encode(blk.HeadHash()), parent.blk.Head.Number, common.Base58Encode(parent.blk.HeadHash()))
+	}
+	if blk.Head.Time <= parent.blk.Head.Time {
+		return fmt.Errorf("block %v is not later than its parent", blk.Head.Number)
+	}
+	if !parent.producers.has(blk.Head.Witness) {
+		return fmt.Errorf("block %v is signed by %v out of the producers", blk.Head.Number, blk.Head.Witness)
+	}
+	if err := blk.VerifySelf(); err != nil {
+		return err
+	}
+	e := &entry{
+		blk:       blk,
+		producers: parent.producers.signed(blk.Head.Witness),
+	}
+	if blk.Head.Number%common.VoteInterval == 0 {
+		full, err := c.fetchBlock(blk.Head.Number)
+		if err != nil {
+			return err
+		}
+		status, err := verifyVoteBlock(full, blk.HeadHash())
+		if err != nil {
+			return err
+		}
+		e.producers = (&producers{
+			previous: parent.producers.active(),
+			current:  status.PendingList,
+		}).signed(blk.Head.Witness)
+		ilog.Infof("Producers voted in block %v: %v", blk.Head.Number, status.PendingList)
+	}
+	c.unconfirmed = append(c.unconfirmed, e)
+	c.tip = e
+	return nil
+}
+
+// confirm moves the head to the latest block followed by the blocks of 2/3 of the producers allowed to sign it,
+// which confirms its parents as well. The blocks signed right before the producers voted take effect may only be
+// confirmed this way.
+func (c *Client) confirm() error {
+	c.mu.RLock()
+	signers := c.head.producers
+	c.mu.RUnlock()
+	n := 0
+	for i, e := range c.unconfirmed {
+		if signers.confirmedBy(c.unconfirmed[i+1:]) {
+			n = i + 1
+		}
+		signers = e.producers
+	}
+	if n == 0 {
+		if len(c.unconfirmed) > int(common.VoteInterval) {
+			// the producers never sign the blocks served by the source, start over from the head
+			c.unconfirmed = nil
+			c.tip = c.head
+			return ErrNotConfirmed
+		}
+		return nil
+	}
+	confirmed := c.unconfirmed[:n]
+	c.unconfirmed = c.unconfirmed[n:]
+
+	c.mu.Lock()
+	for _, e := range confirmed {
+		c.headers = append(c.headers, e.blk)
+		c.byHash[string(e.blk.HeadHash())] = e.blk
+	}
+	if len(c.headers) > c.cacheSize {
+		for _, blk := range c.headers[:len(c.headers)-c.cacheSize] {
+			delete(c.byHash, string(blk.HeadHash()))
+		}
+		c.headers = append([]*block.Block(nil), c.headers[len(c.headers)-c.cacheSize:]...)
+	}
+	c.head = confirmed[n-1]
+	c.mu.Unlock()
+	return c.Checkpoint().Save(c.file)
+}
+
+// Run syncs with the source every interval until the context is done.
+func (c *Client) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := c.Sync(); err != nil {
+			ilog.Warnf("Light client failed to sync: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Checkpoint returns the latest block confirmed by the producers.
+func (c *Client) Checkpoint() *Checkpoint {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.head == nil {
+		return nil
+	}
+	return &Checkpoint{
+		Number:     c.head.blk.Head.Number,
+		Hash:       common.Base58Encode(c.head.blk.HeadHash()),
+		Time:       c.head.blk.Head.Time,
+		Previous:   c.head.producers.previous,
+		Current:    c.head.producers.current,
+		Confirmers: c.head.producers.confirmers,
+	}
+}
+
+// Head returns the header of the latest block confirmed by the producers.
+func (c *Client) Head() *block.Block {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.head == nil {
+		return nil
+	}
+	return c.head.blk
+}
+
+// HeaderByHash returns the verified header of the hash among the cached headers.
+func (c *Client) HeaderByHash(hash string) (*block.Block, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	blk, ok := c.byHash[string(common.Base58Decode(hash))]
+	if !ok {
+		return nil, ErrNotVerified
+	}
+	return blk, nil
+}
+
+// HeaderByNumber returns the verified header of the number. The headers at most an epoch older than the cached ones
+// are verified backward by parent hashes from the oldest cached header.
+func (c *Client) HeaderByNumber(number int64) (*block.Block, error) {
+	c.mu.RLock()
+	if c.head == nil || number > c.head.blk.Head.Number || number < 0 {
+		c.mu.RUnlock()
+		return nil, ErrNotVerified
+	}
+	oldest := c.headers[0]
+	if number >= oldest.Head.Number {
+		blk := c.headers[number-oldest.Head.Number]
+		c.mu.RUnlock()
+		return blk, nil
+	}
+	c.mu.RUnlock()
+
+	if oldest.Head.Number-number > common.VoteInterval {
+		return nil, ErrNotVerified
+	}
+	child := oldest
+	for child.Head.Number > number {
+		start := child.Head.Number - maxRange
+		if start < number {
+			start = number
+		}
+		blks, err := c.fetchHeaders(start, child.Head.Number)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(blks)) != child.Head.Number-start {
+			return nil, fmt.Errorf("blocks %v to %v missing", start, child.Head.Number)
+		}
+		for i := len(blks) - 1; i >= 0; i-- {
+			if blks[i].Head.Number != child.Head.Number-1 || !bytes.Equal(blks[i].HeadHash(), child.Head.ParentHash) {
+				return nil, fmt.Errorf("block %v is not the parent of block %v", blks[i].Head.Number, child.Head.Number)
+			}
+			child = blks[i]
+		}
+	}
+	return child, nil
+}
+
+// VerifyTx returns the tx of the hash and its receipt from the source, which are verified to be included in a
+// block confirmed by the producers.
+func (c *Client) VerifyTx(hash string) (*tx.Tx, *tx.TxReceipt, *block.Block, error) {
+	txProof, err := c.src.GetTxInclusionProof(context.Background(), &rpcpb.TxHashRequest{Hash: hash})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if txProof.BlockHead == nil {
+		return nil, nil, nil, errors.New("block head missing")
+	}
+	blk, err := c.HeaderByNumber(txProof.BlockHead.Number)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	blockHash := common.Base58Encode(blk.HeadHash())
+	t, _, err := sdk.VerifyTxInclusionProof(txProof, blockHash)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid tx proof: %v", err)
+	}
+	if h := common.Base58Encode(t.Hash()); h != hash {
+		return nil, nil, nil, fmt.Errorf("proved tx %v instead of %v", h, hash)
+	}
+	receiptProof, err := c.src.GetReceiptInclusionProof(context.Background(), &rpcpb.TxHashRequest{Hash: hash})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	r, _, err := sdk.VerifyReceiptInclusionProof(receiptProof, blockHash)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid receipt proof: %v", err)
+	}
+	if h := common.Base58Encode(r.TxHash); h != hash {
+		return nil, nil, nil, fmt.Errorf("proved the receipt of tx %v instead of %v", h, hash)
+	}
+	return t, r, blk, nil
+}
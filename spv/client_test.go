@@ -0,0 +1,264 @@
+package spv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/iost-official/go-iost/v3/account"
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	blockpb "github.com/iost-official/go-iost/v3/core/block/pb"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/crypto"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+)
+
+type fakeNode struct {
+	blocks []*block.Block
+	lib    int64
+}
+
+func (n *fakeNode) GetChainInfo(ctx context.Context, in *rpcpb.EmptyRequest, opts ...grpc.CallOption) (*rpcpb.ChainInfoResponse, error) {
+	return &rpcpb.ChainInfoResponse{LibBlock: n.lib}, nil
+}
+
+func (n *fakeNode) GetRawBlockByNumber(ctx context.Context, in *rpcpb.GetBlockByNumberRequest, opts ...grpc.CallOption) (*rpcpb.RawBlockResponse, error) {
+	if in.Number > n.lib {
+		return nil, errors.New("block not found")
+	}
+	return &rpcpb.RawBlockResponse{Block: n.blocks[in.Number].ToPb(blockpb.BlockType_NORMAL)}, nil
+}
+
+func (n *fakeNode) GetBlockHeaderByRange(ctx context.Context, in *rpcpb.GetBlockHeaderByRangeRequest, opts ...grpc.CallOption) (*rpcpb.BlockHeaderByRangeResponse, error) {
+	if in.End <= in.Start || in.End > in.Start+maxRange {
+		return nil, errors.New("invalid range")
+	}
+	res := &rpcpb.BlockHeaderByRangeResponse{}
+	for bn := in.Start; bn < in.End && bn <= n.lib; bn++ {
+		blk := n.blocks[bn].ToPb(blockpb.BlockType_ONLYHASH)
+		blk.TxHashes = nil
+		blk.ReceiptHashes = nil
+		res.BlockList = append(res.BlockList, blk)
+	}
+	return res, nil
+}
+
+func (n *fakeNode) findTx(hash string) (*block.Block, int) {
+	for _, blk := range n.blocks {
+		for i, t := range blk.Txs {
+			if common.Base58Encode(t.Hash()) == hash {
+				return blk, i
+			}
+		}
+	}
+	return nil, 0
+}
+
+func (n *fakeNode) GetTxInclusionProof(ctx context.Context, in *rpcpb.TxHashRequest, opts ...grpc.CallOption) (*rpcpb.TxInclusionProofResponse, error) {
+	blk, i := n.findTx(in.Hash)
+	if blk == nil {
+		return nil, errors.New("tx not found")
+	}
+	m := merkletree.MerkleTree{}
+	m.Build([][]byte{blk.Txs[i].Hash()})
+	idx, mp, err := m.MerkleProof(blk.Txs[i].Hash())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.TxInclusionProofResponse{
+		BlockHead:   blk.Head.ToPb(),
+		BlockSign:   blk.Sign.ToPb(),
+		Transaction: blk.Txs[i].ToPb(),
+		Index:       idx,
+		MerklePath:  []string{common.Base58Encode(mp[0])},
+	}, nil
+}
+
+func (n *fakeNode) GetReceiptInclusionProof(ctx context.Context, in *rpcpb.TxHashRequest, opts ...grpc.CallOption) (*rpcpb.ReceiptInclusionProofResponse, error) {
+	blk, i := n.findTx(in.Hash)
+	if blk == nil {
+		return nil, errors.New("tx not found")
+	}
+	m := merkletree.MerkleTree{}
+	m.Build([][]byte{blk.Receipts[i].Hash()})
+	idx, mp, err := m.MerkleProof(blk.Receipts[i].Hash())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.ReceiptInclusionProofResponse{
+		BlockHead:  blk.Head.ToPb(),
+		BlockSign:  blk.Sign.ToPb(),
+		Receipt:    blk.Receipts[i].ToPb(),
+		Index:      idx,
+		MerklePath: []string{common.Base58Encode(mp[0])},
+	}, nil
+}
+
+func newKeys(t *testing.T, n int) []*account.KeyPair {
+	keys := make([]*account.KeyPair, 0, n)
+	for i := 0; i < n; i++ {
+		kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+		assert.Nil(t, err)
+		keys = append(keys, kp)
+	}
+	return keys
+}
+
+func pubkeys(keys []*account.KeyPair) []string {
+	ret := make([]string, 0, len(keys))
+	for _, kp := range keys {
+		ret = append(ret, kp.ReadablePubkey())
+	}
+	return ret
+}
+
+// newBlock returns a child of the parent with a tx in it. The receipt of the tx of a vote block carries the producers
+// voted.
+func newBlock(t *testing.T, parent *block.Block, number int64, witness *account.KeyPair, current, pending []string) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Version: block.V1,
+			Number:  number,
+			Witness: witness.ReadablePubkey(),
+			Time:    (number + 1) * common.BlockInterval.Nanoseconds(),
+		},
+	}
+	if parent != nil {
+		blk.Head.ParentHash = parent.HeadHash()
+	}
+	trx := tx.NewTx([]*tx.Action{tx.NewAction("token.iost", "transfer", `["iost","a","b","1",""]`)}, nil, 100000, 100, 0, 0, 1024)
+	trx.Time = number
+	receipt := tx.NewTxReceipt(trx.Hash())
+	if number%common.VoteInterval == 0 {
+		content, err := json.Marshal(map[string][]string{"pendingList": pending, "currentList": current})
+		assert.Nil(t, err)
+		receipt.Receipts = append(receipt.Receipts, &tx.Receipt{FuncName: "vote_producer.iost/stat", Content: string(content)})
+	}
+	blk.Txs = []*tx.Tx{trx}
+	blk.Receipts = []*tx.TxReceipt{receipt}
+	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+	blk.CalculateHeadHash()
+	blk.Sign = witness.Sign(blk.HeadHash())
+	return blk
+}
+
+// newChain returns blocks produced by the first 4 keys until the vote at block 2400 replaces the 4th by the 5th, which
+// takes effect after block 2402 signed by the 3rd of the first 4 keys since the vote.
+func newChain(t *testing.T, keys []*account.KeyPair, length int64) *fakeNode {
+	before := keys[:4]
+	after := append(append([]*account.KeyPair{}, keys[:3]...), keys[4])
+	n := &fakeNode{lib: length - 1}
+	var parent *block.Block
+	for i := int64(0); i < length; i++ {
+		producers := before
+		if i > 2*common.VoteInterval+2 {
+			producers = after
+		}
+		pending := pubkeys(before)
+		if i >= 2*common.VoteInterval {
+			pending = pubkeys(after)
+		}
+		parent = newBlock(t, parent, i, producers[i%4], pubkeys(producers), pending)
+		n.blocks = append(n.blocks, parent)
+	}
+	return n
+}
+
+func TestProducers(t *testing.T) {
+	keys := newKeys(t, 5)
+	before, after := pubkeys(keys[:4]), pubkeys(append(append([]*account.KeyPair{}, keys[:3]...), keys[4]))
+	p := &producers{previous: before, current: after}
+	assert.True(t, p.has(before[3]))
+	assert.False(t, p.has(after[3]))
+
+	for _, w := range []string{before[0], before[0], after[3], before[1]} {
+		p = p.signed(w)
+	}
+	assert.Equal(t, before, p.previous)
+	assert.Equal(t, []string{before[0], before[1]}, p.confirmers)
+
+	p = p.signed(before[2])
+	assert.Empty(t, p.previous)
+	assert.False(t, p.has(before[3]))
+	assert.True(t, p.has(after[3]))
+
+	blocks := make([]*entry, 0, 3)
+	for _, k := range []*account.KeyPair{keys[3], keys[0], keys[1]} {
+		blocks = append(blocks, &entry{blk: &block.Block{Head: &block.BlockHead{Witness: k.ReadablePubkey()}}})
+	}
+	assert.False(t, p.confirmedBy(blocks), "confirmed by the previous producers")
+	blocks = append(blocks, &entry{blk: &block.Block{Head: &block.BlockHead{Witness: keys[4].ReadablePubkey()}}})
+	assert.True(t, p.confirmedBy(blocks))
+}
+
+func TestClient(t *testing.T) {
+	keys := newKeys(t, 6)
+	node := newChain(t, keys, 2601)
+	file := filepath.Join(t.TempDir(), "checkpoint.json")
+	voteHash := common.Base58Encode(node.blocks[common.VoteInterval].HeadHash())
+
+	c := NewClient(node, file, 100)
+	assert.Equal(t, ErrNoCheckpoint, c.Start(0, ""))
+	assert.NotNil(t, c.Start(common.VoteInterval+1, common.Base58Encode(node.blocks[common.VoteInterval+1].HeadHash())))
+	assert.NotNil(t, c.Start(common.VoteInterval, common.Base58Encode(node.blocks[0].HeadHash())))
+	assert.Nil(t, c.Start(common.VoteInterval, voteHash))
+	assert.Equal(t, common.VoteInterval, c.Head().Head.Number)
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, c.Sync())
+	}
+	// the last 3 blocks are needed to confirm the blocks before them
+	assert.Equal(t, int64(2597), c.Head().Head.Number)
+	cp := c.Checkpoint()
+	assert.Empty(t, cp.Previous)
+	assert.Empty(t, cp.Confirmers)
+	assert.Equal(t, []string{keys[0].ReadablePubkey(), keys[1].ReadablePubkey(), keys[2].ReadablePubkey(), keys[4].ReadablePubkey()}, cp.Current)
+
+	for _, number := range []int64{2597, 2500, 2400} {
+		blk, err := c.HeaderByNumber(number)
+		assert.Nil(t, err)
+		assert.Equal(t, node.blocks[number].HeadHash(), blk.HeadHash())
+	}
+	_, err := c.HeaderByNumber(2598)
+	assert.Equal(t, ErrNotVerified, err)
+	_, err = c.HeaderByNumber(common.VoteInterval)
+	assert.Equal(t, ErrNotVerified, err)
+	_, err = c.HeaderByHash(common.Base58Encode(node.blocks[2500].HeadHash()))
+	assert.Nil(t, err)
+	_, err = c.HeaderByHash(common.Base58Encode(node.blocks[2400].HeadHash()))
+	assert.Equal(t, ErrNotVerified, err)
+
+	txHash := common.Base58Encode(node.blocks[2450].Txs[0].Hash())
+	trx, receipt, blk, err := c.VerifyTx(txHash)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2450), blk.Head.Number)
+	assert.Equal(t, int64(2450), trx.Time)
+	assert.Equal(t, tx.Success, receipt.Status.Code)
+	_, _, _, err = c.VerifyTx(common.Base58Encode(node.blocks[2599].Txs[0].Hash()))
+	assert.Equal(t, ErrNotVerified, err)
+
+	// restart from the saved checkpoint
+	c = NewClient(node, file, 100)
+	assert.Nil(t, c.Start(common.VoteInterval, voteHash))
+	assert.Equal(t, int64(2597), c.Head().Head.Number)
+
+	// a block signed by a key out of the producers is refused, as well as by a previous producer
+	forged := newBlock(t, node.blocks[2600], 2601, keys[3], nil, nil)
+	node.blocks = append(node.blocks, forged)
+	for i := int64(2602); i < 2700; i++ {
+		node.blocks = append(node.blocks, newBlock(t, node.blocks[i-1], i, keys[i%3], nil, nil))
+	}
+	node.lib = 2699
+	assert.NotNil(t, c.Sync())
+	assert.Equal(t, int64(2597), c.Head().Head.Number)
+	assert.True(t, bytes.Equal(node.blocks[2597].HeadHash(), c.Head().HeadHash()))
+}
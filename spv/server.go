@@ -0,0 +1,197 @@
+package spv
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/tx"
+	"github.com/iost-official/go-iost/v3/ilog"
+	rpcpb "github.com/iost-official/go-iost/v3/rpc/pb"
+	jsonpb "google.golang.org/protobuf/encoding/protojson"
+)
+
+// The paths served by Server. They follow the http api of the full node, but only the verified data are returned.
+const (
+	ChainInfoPath           = "/getChainInfo"
+	BlockHeaderByNumberPath = "/getBlockHeaderByNumber/"
+	BlockHeaderByHashPath   = "/getBlockHeaderByHash/"
+	TxByHashPath            = "/getTxByHash/"
+)
+
+// ChainInfo is the response of ChainInfoPath.
+type ChainInfo struct {
+	Checkpoint *Checkpoint `json:"checkpoint"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Server serves the headers and txs verified by the light client over http.
+type Server struct {
+	client *Client
+}
+
+// NewServer returns a server of the light client.
+func NewServer(client *Client) *Server {
+	return &Server{client: client}
+}
+
+// Handler returns the http handler of the server.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ChainInfoPath, s.get(s.handleChainInfo))
+	mux.HandleFunc(BlockHeaderByNumberPath, s.get(s.handleBlockHeaderByNumber))
+	mux.HandleFunc(BlockHeaderByHashPath, s.get(s.handleBlockHeaderByHash))
+	mux.HandleFunc(TxByHashPath, s.get(s.handleTxByHash))
+	return mux
+}
+
+func (s *Server) get(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %v not allowed", r.Method))
+			return
+		}
+		h(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		ilog.Warnf("Failed to write response: %v", err)
+	}
+}
+
+func writeProto(w http.ResponseWriter, m proto.Message) {
+	b, err := (&jsonpb.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}).Marshal(m)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(b); err != nil {
+		ilog.Warnf("Failed to write response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, &errorResponse{Error: err.Error()})
+}
+
+func errorStatus(err error) int {
+	if err == ErrNotVerified {
+		return http.StatusNotFound
+	}
+	return http.StatusBadGateway
+}
+
+func (s *Server) handleChainInfo(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &ChainInfo{Checkpoint: s.client.Checkpoint()})
+}
+
+func (s *Server) handleBlockHeaderByNumber(w http.ResponseWriter, r *http.Request) {
+	number, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, BlockHeaderByNumberPath), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	blk, err := s.client.HeaderByNumber(number)
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+	writeProto(w, toPbHeader(blk))
+}
+
+func (s *Server) handleBlockHeaderByHash(w http.ResponseWriter, r *http.Request) {
+	blk, err := s.client.HeaderByHash(strings.TrimPrefix(r.URL.Path, BlockHeaderByHashPath))
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+	writeProto(w, toPbHeader(blk))
+}
+
+func (s *Server) handleTxByHash(w http.ResponseWriter, r *http.Request) {
+	t, tr, blk, err := s.client.VerifyTx(strings.TrimPrefix(r.URL.Path, TxByHashPath))
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+	writeProto(w, &rpcpb.TransactionResponse{
+		Status:      rpcpb.TransactionResponse_IRREVERSIBLE,
+		Transaction: toPbTx(t, tr),
+		BlockNumber: blk.Head.Number,
+	})
+}
+
+func toPbHeader(blk *block.Block) *rpcpb.Block {
+	ret := &rpcpb.Block{
+		Hash:                common.Base58Encode(blk.HeadHash()),
+		Version:             blk.Head.Version,
+		ParentHash:          common.Base58Encode(blk.Head.ParentHash),
+		TxMerkleHash:        common.Base58Encode(blk.Head.TxMerkleHash),
+		TxReceiptMerkleHash: common.Base58Encode(blk.Head.TxReceiptMerkleHash),
+		Number:              blk.Head.Number,
+		Witness:             blk.Head.Witness,
+		Time:                blk.Head.Time,
+		GasUsage:            float64(blk.Head.GasUsage) / 100,
+	}
+	if blk.Head.Version >= block.V2 {
+		ret.StateRoot = common.Base58Encode(blk.Head.StateRoot)
+	}
+	return ret
+}
+
+func toPbTx(t *tx.Tx, tr *tx.TxReceipt) *rpcpb.Transaction {
+	ret := &rpcpb.Transaction{
+		Hash:       common.Base58Encode(t.Hash()),
+		Time:       t.Time,
+		Expiration: t.Expiration,
+		GasRatio:   float64(t.GasRatio) / 100,
+		GasLimit:   float64(t.GasLimit) / 100,
+		Delay:      t.Delay,
+		ChainId:    t.ChainID,
+		Signers:    t.Signers,
+		Publisher:  t.Publisher,
+		ReferredTx: common.Base58Encode(t.ReferredTx),
+		TxReceipt: &rpcpb.TxReceipt{
+			TxHash:     common.Base58Encode(tr.TxHash),
+			GasUsage:   float64(tr.GasUsage) / 100,
+			RamUsage:   tr.RAMUsage,
+			StatusCode: rpcpb.TxReceipt_StatusCode(tr.Status.Code),
+			Message:    tr.Status.Message,
+			Returns:    tr.Returns,
+		},
+	}
+	for _, a := range t.Actions {
+		ret.Actions = append(ret.Actions, &rpcpb.Action{
+			Contract:   a.Contract,
+			ActionName: a.ActionName,
+			Data:       a.Data,
+		})
+	}
+	for _, a := range t.AmountLimit {
+		ret.AmountLimit = append(ret.AmountLimit, &rpcpb.AmountLimit{
+			Token: a.Token,
+			Value: a.Val,
+		})
+	}
+	for _, r := range tr.Receipts {
+		ret.TxReceipt.Receipts = append(ret.TxReceipt.Receipts, &rpcpb.TxReceipt_Receipt{
+			FuncName: r.FuncName,
+			Content:  r.Content,
+		})
+	}
+	return ret
+}
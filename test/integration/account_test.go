@@ -3,8 +3,8 @@ package integration
 import (
 	"testing"
 
+	"github.com/iost-official/go-iost/v3/devchain"
 	"github.com/iost-official/go-iost/v3/ilog"
-	"github.com/iost-official/go-iost/v3/verifier"
 	"github.com/iost-official/go-iost/v3/vm/database"
 	"github.com/iost-official/go-iost/v3/vm/host"
 	"github.com/iost-official/go-iost/v3/vm/native"
@@ -14,7 +14,7 @@ import (
 
 func TestAccountInfo(t *testing.T) {
 	ilog.SetLevel(ilog.LevelInfo)
-	s := verifier.NewSimulator()
+	s := devchain.NewSimulator()
 	defer s.Clear()
 	Convey("test of Auth", t, func() {
 		ca, err := s.Compile("auth.iost", "../../config/genesis/contract/account", "../../config/genesis/contract/account.js")
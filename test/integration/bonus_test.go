@@ -8,7 +8,7 @@ import (
 	"github.com/iost-official/go-iost/v3/vm/database"
 
 	"github.com/iost-official/go-iost/v3/core/tx"
-	. "github.com/iost-official/go-iost/v3/verifier"
+	. "github.com/iost-official/go-iost/v3/devchain"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -14,7 +14,7 @@ import (
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/core/version"
 	"github.com/iost-official/go-iost/v3/db"
-	"github.com/iost-official/go-iost/v3/verifier"
+	"github.com/iost-official/go-iost/v3/devchain"
 	"github.com/iost-official/go-iost/v3/vm"
 	"github.com/iost-official/go-iost/v3/vm/database"
 	"github.com/iost-official/go-iost/v3/vm/host"
@@ -345,7 +345,7 @@ func TestGas_PledgeunpledgeForOther(t *testing.T) {
 
 func TestGas_Increase(t *testing.T) {
 	Convey("check gas increase rate", t, func() {
-		s := verifier.NewSimulator()
+		s := devchain.NewSimulator()
 		defer s.Clear()
 		createAccountsWithResource(s)
 		createToken(t, s, acc0)
@@ -370,7 +370,7 @@ func TestGas_Increase(t *testing.T) {
 
 func TestGas_Overflow(t *testing.T) {
 	Convey("check gas should not be negative", t, func() {
-		s := verifier.NewSimulator()
+		s := devchain.NewSimulator()
 		defer s.Clear()
 		createAccountsWithResource(s)
 		createToken(t, s, acc0)
@@ -9,7 +9,7 @@ import (
 	"github.com/iost-official/go-iost/v3/ilog"
 
 	"github.com/iost-official/go-iost/v3/common"
-	. "github.com/iost-official/go-iost/v3/verifier"
+	. "github.com/iost-official/go-iost/v3/devchain"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
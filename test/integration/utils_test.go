@@ -12,7 +12,7 @@ import (
 	"github.com/iost-official/go-iost/v3/core/contract"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/crypto"
-	. "github.com/iost-official/go-iost/v3/verifier"
+	. "github.com/iost-official/go-iost/v3/devchain"
 	"github.com/iost-official/go-iost/v3/vm/native"
 )
 
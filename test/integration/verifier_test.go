@@ -9,8 +9,8 @@ import (
 	"github.com/iost-official/go-iost/v3/core/contract"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/core/version"
+	. "github.com/iost-official/go-iost/v3/devchain"
 	"github.com/iost-official/go-iost/v3/ilog"
-	. "github.com/iost-official/go-iost/v3/verifier"
 	"github.com/iost-official/go-iost/v3/vm/database"
 	"github.com/iost-official/go-iost/v3/vm/native"
 	. "github.com/smartystreets/goconvey/convey"
@@ -5,8 +5,8 @@ import (
 	"testing"
 
 	"github.com/iost-official/go-iost/v3/core/tx"
+	. "github.com/iost-official/go-iost/v3/devchain"
 	"github.com/iost-official/go-iost/v3/ilog"
-	. "github.com/iost-official/go-iost/v3/verifier"
 	"github.com/iost-official/go-iost/v3/vm/database"
 	"github.com/stretchr/testify/assert"
 )
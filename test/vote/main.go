@@ -67,7 +67,11 @@ var accountsStore = sdk.NewFileAccountStore(".")
 
 func createAndSaveKeyPair(acc string) (*account.KeyPair, error) {
 	// generate new keypair locally
-	newKp, err := account.NewKeyPair(nil, sdk.GetSignAlgoByName(signAlgo))
+	algo, err := sdk.GetSignAlgoByName(signAlgo)
+	if err != nil {
+		log.Fatalf("unknown sign algorithm %v", err)
+	}
+	newKp, err := account.NewKeyPair(nil, algo)
 	if err != nil {
 		log.Fatalf("create key pair failed %v", err)
 	}
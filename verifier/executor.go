@@ -13,6 +13,7 @@ import (
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/vm"
 	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
 )
 
 type Executor struct {
@@ -43,23 +44,68 @@ func (v *Executor) Exec(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx,
 
 // Try exec tx and only return receipt
 func (v *Executor) Try(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx, limit time.Duration) (*tx.TxReceipt, error) {
+	_, r, err := try(bh, db, t, limit, nil)
+	return r, err
+}
+
+// TryWithStateDiff exec tx like Try, and also fills the contract storage changes of the receipt.
+func (v *Executor) TryWithStateDiff(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx, limit time.Duration) (*tx.TxReceipt, error) {
+	isolator, r, err := try(bh, db, t, limit, nil)
+	if err != nil {
+		return r, err
+	}
+	r.StateDiff = isolator.StateDiff()
+	return r, nil
+}
+
+// Estimate runs the tx like Try, and fills the gas and RAM usage of the receipt without paying them.
+// It also returns the gas used by the tx, which does not depend on the gas ratio.
+func (v *Executor) Estimate(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx, limit time.Duration) (*tx.TxReceipt, int64, error) {
+	isolator, r, err := try(bh, db, t, limit, nil)
+	if err != nil {
+		return r, 0, err
+	}
+	var gas int64
+	for k, c := range isolator.Costs() {
+		if k == t.Publisher {
+			gas = c.ToGas()
+		}
+		if c.Data != 0 {
+			r.RAMUsage[k] = c.Data
+		}
+	}
+	r.GasUsage = gas * t.GasRatio
+	return r, gas, nil
+}
+
+// Trace exec tx like Try, and returns the calls, storage accesses and logs recorded during execution.
+func (v *Executor) Trace(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx, limit time.Duration) (*tx.TxReceipt, *host.Tracer, error) {
+	tracer := host.NewTracer()
+	_, r, err := try(bh, db, t, limit, tracer)
+	return r, tracer, err
+}
+
+func try(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx, limit time.Duration, tracer *host.Tracer) (*vm.Isolator, *tx.TxReceipt, error) {
 	var isolator vm.Isolator
 	vi := database.NewVisitor(100, db, bh.Rules())
 	var l ilog.Logger
 	l.Stop()
 	err := isolator.Prepare(bh, vi, &l)
 	if err != nil {
-		return &tx.TxReceipt{}, err
+		return nil, &tx.TxReceipt{}, err
+	}
+	if tracer != nil {
+		isolator.SetTracer(tracer)
 	}
 	err = isolator.PrepareTx(t, limit)
 	if err != nil {
-		return &tx.TxReceipt{}, err
+		return nil, &tx.TxReceipt{}, err
 	}
 	r, err := isolator.Run()
 	if err != nil {
-		return &tx.TxReceipt{}, err
+		return nil, &tx.TxReceipt{}, err
 	}
-	return r, err
+	return &isolator, r, err
 }
 
 // Gen gen block
@@ -69,16 +115,28 @@ func (v *Executor) Gen(blk, parent *block.Block, witnessList *blockcache.Witness
 	if err != nil {
 		return nil, nil, err
 	}
-	r, err := blockBaseExec(blk, db, isolator, baseTx, c)
+	recorder := newStateRecorder(db)
+	r, err := blockBaseExec(blk, recorder, isolator, baseTx, c)
 	if err != nil {
 		return nil, nil, err
 	}
 	blk.Txs = append(blk.Txs, baseTx)
 	blk.Receipts = append(blk.Receipts, r)
 	var pi = NewProvider(iter)
-	err = baseGen(blk, db, pi, isolator, c)
+	err = baseGen(blk, recorder, pi, isolator, c)
 	droplist, errs = pi.List()
 	pi.Close()
+	if err != nil {
+		return
+	}
+	if !blk.Head.Rules().IsFork3_4_0 {
+		if perr := prepareStateRoot(db, recorder.keys); perr != nil {
+			ilog.Warnf("Prepare state root failed: %v", perr)
+		}
+		return
+	}
+	blk.Head.Version = block.V2
+	blk.Head.StateRoot, err = stateRoot(parent, db, recorder.keys)
 	return
 }
 
@@ -86,6 +144,8 @@ func blockBaseExec(blk *block.Block, db database.IMultiValue, isolator *vm.Isola
 	vi := database.NewVisitor(100, db, blk.Head.Rules())
 	isolator.Prepare(blk.Head, vi, getLogger(global.GetGlobalConf() != nil && global.GetGlobalConf().Log != nil && global.GetGlobalConf().Log.EnableContractLog))
 	isolator.TriggerBlockBaseMode()
+	isolator.SetStateDiff(stateDiffEnabled())
+	isolator.SetContractEvents(contractEventsEnabled())
 	err = isolator.PrepareTx(t, c.Timeout)
 	if err != nil {
 		return nil, err
@@ -196,6 +256,18 @@ L:
 	return err
 }
 
+// stateDiffEnabled returns whether the receipts of executed blocks should record the state diff.
+func stateDiffEnabled() bool {
+	conf := global.GetGlobalConf()
+	return conf != nil && conf.DB != nil && conf.DB.StateDiff
+}
+
+// contractEventsEnabled returns whether the receipts of executed blocks should record the contract events.
+func contractEventsEnabled() bool {
+	conf := global.GetGlobalConf()
+	return conf != nil && conf.DB != nil && conf.DB.EventStore
+}
+
 func getLogger(enableContractLog bool) *ilog.Logger {
 	if !enableContractLog {
 		var l ilog.Logger
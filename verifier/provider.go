@@ -12,11 +12,11 @@ type Provider interface {
 	Close()
 }
 
-// ProviderImpl impl of provider
+// ProviderImpl impl of provider, it provides the txs of different publishers in turn.
 type ProviderImpl struct {
 	cache    []*tx.Tx
 	pool     *txpool.SortedTxMap
-	iter     *txpool.Iterator
+	list     []*tx.Tx
 	droplist map[*tx.Tx]error
 }
 
@@ -26,7 +26,7 @@ func NewProvider(pool *txpool.SortedTxMap) *ProviderImpl {
 		cache:    make([]*tx.Tx, 0),
 		droplist: make(map[*tx.Tx]error),
 		pool:     pool,
-		iter:     pool.Iter(),
+		list:     pool.FairList(),
 	}
 }
 
@@ -37,10 +37,11 @@ func (p *ProviderImpl) Tx() *tx.Tx {
 		p.cache = p.cache[:len(p.cache)-1]
 		return t
 	}
-	t, ok := p.iter.Next()
-	if !ok {
+	if len(p.list) == 0 {
 		return nil
 	}
+	t := p.list[0]
+	p.list = p.list[1:]
 	return t
 }
 
@@ -0,0 +1,248 @@
+package verifier
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/iost-official/go-iost/v3/common"
+	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
+	"github.com/iost-official/go-iost/v3/core/version"
+	"github.com/iost-official/go-iost/v3/ilog"
+	"github.com/iost-official/go-iost/v3/vm/database"
+)
+
+// StateRootTable is the table of the nodes of the sparse merkle tree over the state.
+// The nodes are committed with the state of each block, so the tree of any block is read from the state db of it.
+// The nodes of the historical roots after the state root fork are never pruned, so the table keeps growing with the
+// writes to the state.
+const StateRootTable = "stateroot"
+
+// The tree is prepared in the blocks before the state root fork once the fork is scheduled, a batch of keys a block
+// in the order of the keys, so the first block committing to the state root only updates it with its own writes.
+// Only the nodes of the latest prepared root are kept. The progress is kept in
+// StateRootTable with the state of each block: the root of the tree over the state keys before the cursor, and the
+// cursor, which is maxStateKey once all the keys are in the tree. The keys are not hex, so they never clash with the
+// nodes.
+var (
+	preparedRootKey   = "/preparedroot"
+	preparedCursorKey = "/preparedcursor"
+	prepareBatch      = 1000
+	maxStateKey       = string([]byte{0xff})
+)
+
+// stateRecorder records the keys of the state written while executing a block.
+type stateRecorder struct {
+	database.IMultiValue
+	keys map[string]bool
+}
+
+func newStateRecorder(db database.IMultiValue) *stateRecorder {
+	return &stateRecorder{
+		IMultiValue: db,
+		keys:        make(map[string]bool),
+	}
+}
+
+func (r *stateRecorder) Put(table string, key string, value string) error {
+	if table == database.StateTable {
+		r.keys[key] = true
+	}
+	return r.IMultiValue.Put(table, key, value)
+}
+
+func (r *stateRecorder) Del(table string, key string) error {
+	if table == database.StateTable {
+		r.keys[key] = true
+	}
+	return r.IMultiValue.Del(table, key)
+}
+
+type stateNodeStore struct {
+	db database.IMultiValue
+}
+
+func (s *stateNodeStore) Get(hash []byte) ([]byte, error) {
+	node, err := s.db.Get(StateRootTable, hex.EncodeToString(hash))
+	if err != nil || node == "" {
+		return nil, err
+	}
+	return []byte(node), nil
+}
+
+func (s *stateNodeStore) Put(hash []byte, node []byte) error {
+	return s.db.Put(StateRootTable, hex.EncodeToString(hash), string(node))
+}
+
+func (s *stateNodeStore) Del(hash []byte) error {
+	return s.db.Del(StateRootTable, hex.EncodeToString(hash))
+}
+
+// NewStateTree returns the sparse merkle tree over the state in db.
+func NewStateTree(db database.IMultiValue) *merkletree.SparseMerkleTree {
+	return merkletree.NewSparseMerkleTree(&stateNodeStore{db: db})
+}
+
+type keyLister interface {
+	KeysByRange(table string, from string, to string, limit int) ([]string, error)
+}
+
+// stateRoot updates the state tree with the state written by the block, and returns the new root.
+// If the parent does not commit to the state root, the tree prepared in the blocks before is completed with the keys
+// not prepared yet, which takes a while if the preparation has not finished.
+func stateRoot(parent *block.Block, db database.IMultiValue, written map[string]bool) ([]byte, error) {
+	if parent != nil && parent.Head.Version >= block.V2 {
+		keys := make([]string, 0, len(written))
+		for k := range written {
+			keys = append(keys, k)
+		}
+		return updateStateTree(db, parent.Head.StateRoot, keys)
+	}
+	root, keys, cursor, err := preparedKeys(db, written)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != maxStateKey {
+		ilog.Warnf("The state tree is not prepared, adding the keys from %q.", cursor)
+		more, err := listStateKeys(db, cursor, 0)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, more...)
+	}
+	return updateStateTree(db, root, keys)
+}
+
+// prepareStateRoot adds the next batch of keys to the tree prepared for the state root fork, and updates it with the
+// state written by the block. It is done in the blocks before the fork if the fork is scheduled, and the nodes of
+// the previous prepared root are deleted.
+func prepareStateRoot(db database.IMultiValue, written map[string]bool) error {
+	if !version.IsScheduled3_4_0() {
+		return nil
+	}
+	root, keys, cursor, err := preparedKeys(db, written)
+	if err != nil {
+		return err
+	}
+	if cursor != maxStateKey {
+		more, err := listStateKeys(db, cursor, prepareBatch)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, more...)
+		cursor = maxStateKey
+		if len(more) == prepareBatch {
+			cursor = more[len(more)-1] + "\x00"
+		}
+	}
+	treeKeys, values, err := stateValues(db, keys)
+	if err != nil {
+		return err
+	}
+	store := &stateNodeStore{db: db}
+	root, stale, err := merkletree.NewSparseMerkleTree(store).UpdateStale(root, treeKeys, values)
+	if err != nil {
+		return err
+	}
+	for _, h := range stale {
+		if err := store.Del(h); err != nil {
+			return err
+		}
+	}
+	if err := db.Put(StateRootTable, preparedRootKey, hex.EncodeToString(root)); err != nil {
+		return err
+	}
+	return db.Put(StateRootTable, preparedCursorKey, cursor)
+}
+
+// preparedKeys returns the prepared root and cursor in the state db, and the written keys before the cursor, which
+// are in the prepared tree already.
+func preparedKeys(db database.IMultiValue, written map[string]bool) ([]byte, []string, string, error) {
+	root := merkletree.SMTEmptyRoot
+	r, err := db.Get(StateRootTable, preparedRootKey)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if r != "" {
+		root, err = hex.DecodeString(r)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+	cursor, err := db.Get(StateRootTable, preparedCursorKey)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	keys := make([]string, 0, len(written))
+	for k := range written {
+		if k < cursor {
+			keys = append(keys, k)
+		}
+	}
+	return root, keys, cursor, nil
+}
+
+func listStateKeys(db database.IMultiValue, from string, limit int) ([]string, error) {
+	lister, ok := db.(keyLister)
+	if !ok {
+		return nil, errors.New("can not list the keys of the state to build the state root")
+	}
+	return lister.KeysByRange(database.StateTable, from, maxStateKey, limit)
+}
+
+// updateStateTree sets the keys to their values in the state db in the tree of root, and returns the new root.
+func updateStateTree(db database.IMultiValue, root []byte, keys []string) ([]byte, error) {
+	treeKeys, values, err := stateValues(db, keys)
+	if err != nil {
+		return nil, err
+	}
+	return NewStateTree(db).Update(root, treeKeys, values)
+}
+
+// stateValues returns the keys and their values in the state db, a nil value for a deleted key.
+func stateValues(db database.IMultiValue, keys []string) ([][]byte, [][]byte, error) {
+	treeKeys := make([][]byte, 0, len(keys))
+	values := make([][]byte, 0, len(keys))
+	for _, k := range keys {
+		has, err := db.Has(database.StateTable, k)
+		if err != nil {
+			return nil, nil, err
+		}
+		var value []byte
+		if has {
+			v, err := db.Get(database.StateTable, k)
+			if err != nil {
+				return nil, nil, err
+			}
+			value = []byte(v)
+		}
+		treeKeys = append(treeKeys, []byte(k))
+		values = append(values, value)
+	}
+	return treeKeys, values, nil
+}
+
+func verifyStateRoot(blk, parent *block.Block, db database.IMultiValue, written map[string]bool) error {
+	if !blk.Head.Rules().IsFork3_4_0 {
+		if blk.Head.Version >= block.V2 {
+			return fmt.Errorf("block version %v is not allowed before the state root fork", blk.Head.Version)
+		}
+		if err := prepareStateRoot(db, written); err != nil {
+			ilog.Warnf("Prepare state root failed: %v", err)
+		}
+		return nil
+	}
+	if blk.Head.Version < block.V2 {
+		return fmt.Errorf("block version %v does not commit to the state root", blk.Head.Version)
+	}
+	root, err := stateRoot(parent, db, written)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root, blk.Head.StateRoot) {
+		return fmt.Errorf("state root not match: %v != %v", common.Base58Encode(blk.Head.StateRoot), common.Base58Encode(root))
+	}
+	return nil
+}
@@ -35,14 +35,21 @@ type Config struct {
 
 // Verify verify block generated by Verifier
 func (v *Verifier) Verify(blk, parent *block.Block, witnessList *blockcache.WitnessList, db database.IMultiValue, c *Config) error {
-	err := verifyBlockBase(blk, parent, witnessList, db, c)
+	recorder := newStateRecorder(db)
+	err := verifyBlockBase(blk, parent, witnessList, recorder, c)
 	if err != nil {
 		return err
 	}
 	isolator := vm.Isolator{}
-	vi := database.NewBatchVisitor(database.NewBatchVisitorRoot(100, db, blk.Head.Rules()))
+	vi := database.NewBatchVisitor(database.NewBatchVisitorRoot(100, recorder, blk.Head.Rules()))
 	isolator.Prepare(blk.Head, vi, getLogger(false))
-	return baseVerify(isolator, c, blk.Txs[1:], blk.Receipts[1:], blk)
+	isolator.SetStateDiff(stateDiffEnabled())
+	isolator.SetContractEvents(contractEventsEnabled())
+	err = baseVerify(isolator, c, blk.Txs[1:], blk.Receipts[1:], blk)
+	if err != nil {
+		return err
+	}
+	return verifyStateRoot(blk, parent, db, recorder.keys)
 }
 func verifyBlockBase(blk, parent *block.Block, witnessList *blockcache.WitnessList, db database.IMultiValue, c *Config) error {
 	if len(blk.Txs) < 1 || len(blk.Receipts) < 1 {
@@ -72,6 +79,8 @@ func verifyBlockBase(blk, parent *block.Block, witnessList *blockcache.WitnessLi
 	if err != nil {
 		return err
 	}
+	blk.Receipts[0].StateDiff = r.StateDiff
+	blk.Receipts[0].Events = r.Events
 
 	return nil
 }
@@ -114,6 +123,8 @@ func verify(isolator vm.Isolator, t *tx.Tx, r *tx.TxReceipt, timeout time.Durati
 		return err
 	}
 	isolator.Commit()
+	r.StateDiff = receipt.StateDiff
+	r.Events = receipt.Events
 	return nil
 }
 
@@ -1,14 +1,20 @@
 package verifier
 
 import (
+	"bytes"
+	"fmt"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/iost-official/go-iost/v3/common"
 	"github.com/iost-official/go-iost/v3/core/block"
+	"github.com/iost-official/go-iost/v3/core/merkletree"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/core/txpool"
+	"github.com/iost-official/go-iost/v3/core/version"
 	"github.com/iost-official/go-iost/v3/db"
+	"github.com/iost-official/go-iost/v3/vm/database"
 )
 
 type mockTxIter struct {
@@ -58,4 +64,177 @@ func TestVerifier_Gen(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if blk.Head.Version != block.V2 || len(blk.Head.StateRoot) == 0 {
+		t.Fatalf("block does not commit to the state root: %+v", blk.Head)
+	}
+	blk.Head.StateRoot = merkletree.SMTEmptyRoot
+	err = v.Verify(&blk, nil, nil, mvccdb, &Config{
+		Mode:        0,
+		Timeout:     time.Second,
+		TxTimeLimit: time.Millisecond * 100,
+	})
+	if err == nil {
+		t.Fatal("verify should fail with a wrong state root")
+	}
+}
+
+func TestStateRoot(t *testing.T) {
+	mvccdb, err := db.NewMVCCDB("mvcc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("mvcc")
+
+	for _, k := range []string{"b-c1-k1", "b-c1-k2", "m-c1-m-f1"} {
+		mvccdb.Put(database.StateTable, k, "s"+k)
+	}
+	mvccdb.Put("other", "b-c1-k3", "s")
+	root, err := stateRoot(nil, mvccdb, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent := &block.Block{Head: &block.BlockHead{Version: block.V2, StateRoot: root}}
+
+	recorder := newStateRecorder(mvccdb)
+	recorder.Put(database.StateTable, "b-c1-k2", "snew")
+	recorder.Del(database.StateTable, "b-c1-k1")
+	recorder.Put("other", "b-c1-k4", "s")
+	root, err = stateRoot(parent, mvccdb, recorder.keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fullRoot, err := stateRoot(nil, mvccdb, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(root, fullRoot) {
+		t.Fatalf("incremental state root %x != full state root %x", root, fullRoot)
+	}
+
+	proof, err := NewStateTree(mvccdb).Prove(root, []byte("b-c1-k2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := merkletree.VerifySparseMerkleProof(root, []byte("b-c1-k2"), []byte("snew"), proof); err != nil {
+		t.Fatal(err)
+	}
+	proof, err = NewStateTree(mvccdb).Prove(root, []byte("b-c1-k1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := merkletree.VerifySparseMerkleProof(root, []byte("b-c1-k1"), nil, proof); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrepareStateRoot(t *testing.T) {
+	mvccdb, err := db.NewMVCCDB("mvcc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("mvcc")
+	defer func(batch int) { prepareBatch = batch }(prepareBatch)
+	prepareBatch = 10
+
+	for i := 0; i < 25; i++ {
+		mvccdb.Put(database.StateTable, fmt.Sprintf("b-c1-k%02d", i), "s")
+	}
+	// every block before the fork writes the keys before and after the cursor, and prepares a batch of keys
+	blocks := [][]string{
+		{"b-c1-k01", "b-c1-k20", "b-c1-k30"},
+		{"b-c1-k02", "b-c1-k15", "b-c1-k22"},
+		{"b-c1-k03", "b-c1-k21", "b-c1-k31"},
+	}
+	for i, keys := range blocks {
+		recorder := newStateRecorder(mvccdb)
+		recorder.Put(database.StateTable, keys[0], fmt.Sprintf("s%d", i))
+		recorder.Del(database.StateTable, keys[1])
+		recorder.Put(database.StateTable, keys[2], fmt.Sprintf("s%d", i))
+		if err := prepareStateRoot(mvccdb, recorder.keys); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cursor, err := mvccdb.Get(StateRootTable, preparedCursorKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor != maxStateKey {
+		t.Fatalf("state tree is not prepared, cursor %q", cursor)
+	}
+	// only the nodes of the latest prepared root are kept
+	nodes, err := mvccdb.KeysByRange(StateRootTable, "", maxStateKey, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fresh, err := db.NewMVCCDB("mvcc_fresh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("mvcc_fresh")
+	defer fresh.Close()
+	keys, err := listStateKeys(mvccdb, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range keys {
+		v, err := mvccdb.Get(database.StateTable, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fresh.Put(database.StateTable, k, v)
+	}
+	if _, err := updateStateTree(fresh, merkletree.SMTEmptyRoot, keys); err != nil {
+		t.Fatal(err)
+	}
+	freshNodes, err := fresh.KeysByRange(StateRootTable, "", maxStateKey, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != len(freshNodes)+2 {
+		t.Fatalf("%v nodes of the prepared tree are kept, want %v", len(nodes)-2, len(freshNodes))
+	}
+
+	// the fork block only updates the prepared tree with its own writes
+	recorder := newStateRecorder(mvccdb)
+	recorder.Put(database.StateTable, "b-c1-k04", "snew")
+	recorder.Del(database.StateTable, "b-c1-k05")
+	root, err := stateRoot(&block.Block{Head: &block.BlockHead{}}, mvccdb, recorder.keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err = listStateKeys(mvccdb, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fullRoot, err := updateStateTree(mvccdb, merkletree.SMTEmptyRoot, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(root, fullRoot) {
+		t.Fatalf("prepared state root %x != full state root %x", root, fullRoot)
+	}
+}
+
+func TestPrepareStateRootNotScheduled(t *testing.T) {
+	mvccdb, err := db.NewMVCCDB("mvcc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("mvcc")
+	version.InitChainConf(&common.Config{P2P: &common.P2PConfig{ChainID: version.MainNetChainID}})
+	defer version.InitChainConf(&common.Config{P2P: &common.P2PConfig{}})
+
+	mvccdb.Put(database.StateTable, "b-c1-k00", "s")
+	recorder := newStateRecorder(mvccdb)
+	recorder.Put(database.StateTable, "b-c1-k01", "s")
+	if err := prepareStateRoot(mvccdb, recorder.keys); err != nil {
+		t.Fatal(err)
+	}
+	nodes, err := mvccdb.KeysByRange(StateRootTable, "", maxStateKey, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 0 {
+		t.Fatalf("state tree is prepared before the fork is scheduled: %v", nodes)
+	}
 }
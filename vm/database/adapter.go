@@ -17,6 +17,14 @@ const (
 	StateTable = "state"
 )
 
+// StateKey returns the key in the state table of the storage key of a contract, or of the field if the key is a map.
+func StateKey(contract, key, field string) string {
+	if field == "" {
+		return BasicPrefix + contract + Separator + key
+	}
+	return MapPrefix + contract + Separator + key + Separator + field
+}
+
 type chainbaseAdapter struct {
 	cb    IMultiValue
 	rules *version.Rules
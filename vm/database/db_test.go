@@ -178,6 +178,42 @@ func TestMultiVisitor(t *testing.T) {
 
 }
 
+func TestVisitor_StorageChanges(t *testing.T) {
+	mvccdb, err := db.NewMVCCDB("mvcc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer closeMVCCDB(mvccdb)
+	v := NewVisitor(100, mvccdb, version.NewRules(0))
+	v.Put("c1-a", "s1")
+	v.Put("c1-b", "s2")
+	v.Commit()
+
+	v.Put("c1-a", "s3")
+	v.Put("c1-b", "s2")
+	v.Del("c1-b")
+	v.MPut("c1-m", "f", "s4")
+	v.Put("c1-b", "s2")
+	changes := v.StorageChanges()
+	if len(changes) != 2 {
+		t.Fatal(changes)
+	}
+	if c := changes[0]; c.Contract != "c1" || c.Key != "a" || c.Field != "" || c.OldValue != "s1" || c.NewValue != "s3" {
+		t.Fatal(c)
+	}
+	if c := changes[1]; c.Contract != "c1" || c.Key != "m" || c.Field != "f" || c.OldValue != NilPrefix || c.NewValue != "s4" {
+		t.Fatal(c)
+	}
+	v.Commit()
+
+	v.MDel("c1-m", "f")
+	changes = v.StorageChanges()
+	if len(changes) != 1 || changes[0].OldValue != "s4" || changes[0].NewValue != NilPrefix || FormatValue(changes[0].OldValue) != "4" {
+		t.Fatal(changes)
+	}
+}
+
 func closeMVCCDB(m db.MVCCDB) {
 	m.Close()
 	os.RemoveAll("mvcc")
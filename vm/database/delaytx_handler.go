@@ -30,7 +30,17 @@ func (m *DelaytxHandler) GetDelaytx(txHash string) (string, string) {
 	if str == NilPrefix {
 		return "", ""
 	}
-	arr := strings.SplitN(str, deferSep, 2)
+	return ParseDelaytx(str)
+}
+
+// DelaytxStateKey returns the key of the delay tx in the state table.
+func DelaytxStateKey(txHash string) string {
+	return delaytxPrefix + txHash
+}
+
+// ParseDelaytx returns the publisher and the deferTxHash of the delay tx from its value in the state table.
+func ParseDelaytx(value string) (string, string) {
+	arr := strings.SplitN(value, deferSep, 2)
 	if len(arr) != 2 {
 		return "", ""
 	}
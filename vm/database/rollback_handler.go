@@ -1,5 +1,7 @@
 package database
 
+import "strings"
+
 // RollbackHandler rollback delegate
 type RollbackHandler struct {
 	lru *LRU
@@ -26,3 +28,44 @@ func (m *RollbackHandler) Rollback() {
 	m.wc.Drop()
 	//m.lru.Purge()
 }
+
+// StorageChange is a change of contract storage. Values are raw db values.
+type StorageChange struct {
+	Contract string
+	Key      string
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+// StorageChanges returns the contract storage changes of the uncommitted version, sorted by db key.
+// Contract codes, delaytxs and the field lists of maps are not included.
+func (m *RollbackHandler) StorageChanges() []*StorageChange {
+	changes := make([]*StorageChange, 0)
+	for _, c := range m.wc.Changes() {
+		var parts []string
+		switch {
+		case strings.HasPrefix(c.Key, BasicPrefix):
+			parts = strings.SplitN(c.Key[len(BasicPrefix):], Separator, 2)
+			if len(parts) != 2 {
+				continue
+			}
+			parts = append(parts, "")
+		case strings.HasPrefix(c.Key, MapPrefix):
+			parts = strings.SplitN(c.Key[len(MapPrefix):], Separator, 3)
+			if len(parts) != 3 {
+				continue
+			}
+		default:
+			continue
+		}
+		changes = append(changes, &StorageChange{
+			Contract: parts[0],
+			Key:      parts[1],
+			Field:    parts[2],
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return changes
+}
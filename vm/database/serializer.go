@@ -3,6 +3,7 @@ package database
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/iost-official/go-iost/v3/common"
@@ -123,6 +124,23 @@ func MustUnmarshalWithExtra(o string) (interface{}, string) {
 	return rtn, extra
 }
 
+// FormatValue formats a raw db value like a contract reads it, leaving absent values empty.
+func FormatValue(raw string) string {
+	if raw == "" || raw == NilPrefix {
+		return ""
+	}
+	switch v := Unmarshal(raw).(type) {
+	case error:
+		return raw
+	case SerializedJSON:
+		return string(v)
+	case *common.Fixed:
+		return v.ToString()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func int64ToRaw(i int64) string {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, uint64(i))
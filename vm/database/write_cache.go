@@ -1,6 +1,10 @@
 package database
 
-import "github.com/iost-official/go-iost/v3/core/version"
+import (
+	"sort"
+
+	"github.com/iost-official/go-iost/v3/core/version"
+)
 
 // WriteCache ...
 type WriteCache struct {
@@ -86,3 +90,36 @@ func (w *WriteCache) Flush() {
 func (w *WriteCache) Drop() {
 	w.m = make(map[string]*Record)
 }
+
+// Change is a write in the cache with the value it replaces. Absent values are NilPrefix.
+type Change struct {
+	Key      string
+	OldValue string
+	NewValue string
+}
+
+// Changes returns the writes in the cache sorted by key, skipping those which keep the value.
+func (w *WriteCache) Changes() []*Change {
+	keys := make([]string, 0, len(w.m))
+	for k := range w.m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	changes := make([]*Change, 0, len(keys))
+	for _, k := range keys {
+		newValue := w.m[k].value
+		if w.m[k].mode == Delete {
+			newValue = NilPrefix
+		}
+		oldValue := w.db.Get(k)
+		if oldValue == newValue {
+			continue
+		}
+		changes = append(changes, &Change{
+			Key:      k,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+	return changes
+}
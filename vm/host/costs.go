@@ -6,6 +6,7 @@ import "github.com/iost-official/go-iost/v3/core/contract"
 var (
 	Costs = map[string]contract.Cost{
 		"JSCost":           contract.NewCost(0, 0, 30000),
+		"WASMCost":         contract.NewCost(0, 0, 10000),
 		"PutCost":          contract.NewCost(0, 0, 300),
 		"GetCost":          contract.NewCost(0, 0, 300),
 		"DelCost":          contract.NewCost(0, 0, 300),
@@ -57,6 +57,7 @@ func (h *DBHandler) Put(key string, value interface{}, ramPayer ...string) (cont
 
 	h.payRAM(mk, sv, oldV, payer)
 	h.h.db.Put(mk, sv)
+	h.trace("put", mk, "", oldV, sv)
 
 	cost := contract.NewCost(0, 0, int64(len(sv)/10))
 	if cost.ToGas() < Costs["PutCost"].ToGas() {
@@ -68,8 +69,9 @@ func (h *DBHandler) Put(key string, value interface{}, ramPayer ...string) (cont
 // Get get value of key from db
 func (h *DBHandler) Get(key string) (value interface{}, cost contract.Cost) {
 	mk := h.modifyKey(key)
-	rtn := h.parseValue(h.h.db.Get(mk))
-	return rtn, Costs["GetCost"]
+	v := h.h.db.Get(mk)
+	h.trace("get", mk, "", v, "")
+	return h.parseValue(v), Costs["GetCost"]
 }
 
 // Del delete key
@@ -80,6 +82,9 @@ func (h *DBHandler) Del(key string) (contract.Cost, error) {
 	}
 	mk := h.modifyKey(key)
 	h.releaseRAM(mk)
+	if h.h.tracer != nil {
+		h.trace("del", mk, "", h.h.db.Get(mk), "")
+	}
 	h.h.db.Del(mk)
 	return Costs["DelCost"], nil
 }
@@ -116,6 +121,7 @@ func (h *DBHandler) MapPut(key, field string, value interface{}, ramPayer ...str
 
 	h.payRAMForMap(mk, field, sv, oldV, payer)
 	h.h.db.MPut(mk, field, sv)
+	h.trace("map_put", mk, field, oldV, sv)
 
 	cost := contract.NewCost(0, 0, int64(len(sv)/10))
 	if cost.ToGas() < Costs["PutCost"].ToGas() {
@@ -127,8 +133,9 @@ func (h *DBHandler) MapPut(key, field string, value interface{}, ramPayer ...str
 // MapGet get value by kf from db
 func (h *DBHandler) MapGet(key, field string) (value interface{}, cost contract.Cost) {
 	mk := h.modifyKey(key)
-	rtn := h.parseValue(h.h.db.MGet(mk, field))
-	return rtn, Costs["GetCost"]
+	v := h.h.db.MGet(mk, field)
+	h.trace("map_get", mk, field, v, "")
+	return h.parseValue(v), Costs["GetCost"]
 }
 
 // MapKeys list keys
@@ -149,6 +156,9 @@ func (h *DBHandler) MapDel(key, field string) (contract.Cost, error) {
 	}
 	mk := h.modifyKey(key)
 	h.releaseRAMForMap(mk, field)
+	if h.h.tracer != nil {
+		h.trace("map_del", mk, field, h.h.db.MGet(mk, field), "")
+	}
 	h.h.db.MDel(mk, field)
 	return Costs["DelCost"], nil
 }
@@ -174,8 +184,9 @@ func (h *DBHandler) GlobalHas(con, key string) (bool, contract.Cost) {
 // GlobalGet get another contract's data
 func (h *DBHandler) GlobalGet(con, key string) (value interface{}, cost contract.Cost) {
 	mk := h.modifyGlobalKey(con, key)
-	rtn := h.parseValue(h.h.db.Get(mk))
-	return rtn, Costs["GetCost"]
+	v := h.h.db.Get(mk)
+	h.trace("global_get", mk, "", v, "")
+	return h.parseValue(v), Costs["GetCost"]
 }
 
 // GlobalMapHas if another contract's map has field
@@ -187,8 +198,9 @@ func (h *DBHandler) GlobalMapHas(con, key, field string) (bool, contract.Cost) {
 // GlobalMapGet get another contract's map data
 func (h *DBHandler) GlobalMapGet(con, key, field string) (value interface{}, cost contract.Cost) {
 	mk := h.modifyGlobalKey(con, key)
-	rtn := h.parseValue(h.h.db.MGet(mk, field))
-	return rtn, Costs["GetCost"]
+	v := h.h.db.MGet(mk, field)
+	h.trace("global_map_get", mk, field, v, "")
+	return h.parseValue(v), Costs["GetCost"]
 }
 
 // GlobalMapKeys get another contract's map keys
@@ -203,6 +215,13 @@ func (h *DBHandler) GlobalMapLen(con, key string) (length int, cost contract.Cos
 	return len(k), cost
 }
 
+// trace records a value access when the host is tracing.
+func (h *DBHandler) trace(op, key, field, oldV, newV string) {
+	if h.h.tracer != nil {
+		h.h.tracer.Storage(op, key, field, oldV, newV)
+	}
+}
+
 func (h *DBHandler) modifyKey(key string) string {
 	contractName, ok := h.h.ctx.Value("contract_name").(string)
 	if !ok {
@@ -12,11 +12,13 @@ var (
 	ErrInvalidAmount    = errors.New("invalid amount")
 	ErrOutOfGas         = errors.New("out of gas")
 
-	ErrContractNotFound   = errors.New("contract not exists")
-	ErrContractExists     = errors.New("contract exists")
-	ErrAbiHasInternalFunc = errors.New("abi has internal function")
-	ErrUpdateRefused      = errors.New("update refused")
-	ErrDestroyRefused     = errors.New("destroy refused")
+	ErrContractNotFound    = errors.New("contract not exists")
+	ErrContractExists      = errors.New("contract exists")
+	ErrAbiHasInternalFunc  = errors.New("abi has internal function")
+	ErrUpdateRefused       = errors.New("update refused")
+	ErrDestroyRefused      = errors.New("destroy refused")
+	ErrWASMNotEnabled      = errors.New("wasm contract not enabled")
+	ErrAlgorithmNotEnabled = errors.New("signature algorithm not enabled")
 
 	ErrCoinExists         = errors.New("coin exists")
 	ErrCoinNotExists      = errors.New("coin not exists")
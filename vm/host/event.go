@@ -3,6 +3,7 @@ package host
 import (
 	"github.com/iost-official/go-iost/v3/core/contract"
 	"github.com/iost-official/go-iost/v3/core/event"
+	"github.com/iost-official/go-iost/v3/core/tx"
 )
 
 // EventPoster the event handler in host
@@ -18,7 +19,21 @@ func NewEventPoster(h *Host) EventPoster {
 // PostEvent post the event
 func (p *EventPoster) PostEvent(data string) contract.Cost {
 	e := event.NewEvent(event.ContractEvent, data)
-	event.GetCollector().Post(e,
-		&event.Meta{ContractID: p.h.Context().Value("contract_name").(string)})
+	event.GetCollector().Post(e, p.h.eventMeta(data))
+
+	// record the event for the receipt, so it can be stored with the block
+	if evs, ok := p.h.ctx.GValue("events").([]*tx.Receipt); ok {
+		contractName, _ := p.h.Context().Value("contract_name").(string)
+		abiName, _ := p.h.Context().Value("abi_name").(string)
+		p.h.ctx.GSet("events", append(evs, &tx.Receipt{FuncName: contractName + "/" + abiName, Content: data}))
+	}
 	return EventCost(len(data))
 }
+
+// eventMeta returns the meta of the event posted by the current contract action.
+func (h *Host) eventMeta(data string) *event.Meta {
+	contractName, _ := h.Context().Value("contract_name").(string)
+	abiName, _ := h.Context().Value("abi_name").(string)
+	publisher, _ := h.Context().Value("publisher").(string)
+	return event.NewReceiptMeta(contractName, abiName, data, publisher)
+}
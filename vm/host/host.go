@@ -10,6 +10,7 @@ import (
 	"github.com/iost-official/go-iost/v3/core/contract"
 	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/core/version"
+	"github.com/iost-official/go-iost/v3/crypto"
 	"github.com/iost-official/go-iost/v3/ilog"
 	"github.com/iost-official/go-iost/v3/vm/database"
 )
@@ -38,6 +39,7 @@ type Host struct {
 	ctx     *Context
 	db      *database.Visitor
 	monitor Monitor
+	tracer  *Tracer
 
 	deadline time.Time
 }
@@ -68,6 +70,16 @@ func (h *Host) Context() *Context {
 	return h.ctx
 }
 
+// SetTracer makes the host record the execution into t, tracing is off if t is nil.
+func (h *Host) SetTracer(t *Tracer) {
+	h.tracer = t
+}
+
+// Tracer returns the tracer of the host, nil if tracing is off.
+func (h *Host) Tracer() *Tracer {
+	return h.tracer
+}
+
 // SetContext set a new context to host
 func (h *Host) SetContext(ctx *Context) {
 	h.ctx = ctx
@@ -105,6 +117,17 @@ func (h *Host) CallWithAuth(contract, api, jarg string) ([]interface{}, contract
 
 func (h *Host) checkAbiValid(c *contract.Contract) (contract.Cost, error) {
 	cost := contract.Cost0()
+	if c.Info.Lang == "wasm" && !h.IsFork3_4_0 {
+		return cost, ErrWASMNotEnabled
+	}
+	if len(c.Info.Storage) > 0 {
+		if !h.IsFork3_4_0 {
+			// storage schemas were unknown fields of abi and dropped before
+			c.Info.Storage = nil
+		} else if err := c.Info.ValidateStorage(); err != nil {
+			return cost, err
+		}
+	}
 	err := h.monitor.Validate(c)
 	cost.AddAssign(CodeSavageCost(len(c.Encode())))
 	return cost, err
@@ -122,6 +145,19 @@ func (h *Host) checkAmountLimitValid(c *contract.Contract) (contract.Cost, error
 	return cost, nil
 }
 
+// checkUpgradeCompatible refuses breaking abi changes of contracts set strict upgrade by system.iost
+func (h *Host) checkUpgradeCompatible(oc, c *contract.Contract) (contract.Cost, error) {
+	if !h.IsFork3_4_0 {
+		return contract.Cost0(), nil
+	}
+	v, cost := h.DBHandler.GlobalMapGet("system.iost", "strict_upgrade", c.ID)
+	if strict, ok := v.(bool); !ok || !strict {
+		return cost, nil
+	}
+	cost.AddAssign(CommonOpCost(len(oc.Info.Abi) + len(c.Info.Abi)))
+	return cost, contract.CheckUpgrade(oc.Info, c.Info)
+}
+
 // CheckPublisher check publisher of tx
 func (h *Host) CheckPublisher(t *tx.Tx) error {
 	b, c := h.RequirePublisherAuth(t.Publisher)
@@ -132,6 +168,21 @@ func (h *Host) CheckPublisher(t *tx.Tx) error {
 	return nil
 }
 
+// CheckSignAlgorithms check the signature algorithms of tx are enabled
+func (h *Host) CheckSignAlgorithms(t *tx.Tx) error {
+	if h.IsFork3_4_0 {
+		return nil
+	}
+	for _, sigs := range [][]*crypto.Signature{t.Signs, t.PublishSigns} {
+		for _, sig := range sigs {
+			if sig.Algorithm == crypto.Secp256r1 || sig.Algorithm == crypto.BLS {
+				return fmt.Errorf("%w: %v", ErrAlgorithmNotEnabled, sig.Algorithm)
+			}
+		}
+	}
+	return nil
+}
+
 // CheckSigners check signers of tx
 func (h *Host) CheckSigners(t *tx.Tx) error {
 	for _, item := range t.Signers {
@@ -270,6 +321,12 @@ func (h *Host) UpdateCode(c *contract.Contract, id database.SerializedJSON) (con
 		return cost, err
 	}
 
+	cost0, err = h.checkUpgradeCompatible(oc, c)
+	cost.AddAssign(cost0)
+	if err != nil {
+		return cost, err
+	}
+
 	code, err := h.monitor.Compile(c)
 	cost.AddAssign(CodeSavageCost(len(c.Code)))
 	if err != nil {
@@ -1,11 +1,15 @@
 package host
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/core/tx"
 	"github.com/iost-official/go-iost/v3/core/version"
+	"github.com/iost-official/go-iost/v3/crypto"
 	"github.com/iost-official/go-iost/v3/vm/database"
 )
 
@@ -299,3 +303,102 @@ func TestHost_MapKeys_Owner(t *testing.T) {
 		t.Fatal(ans)
 	}
 }
+
+func TestHost_Trace(t *testing.T) {
+	ctx := NewContext(nil)
+	ctx.Set("commit", "abc")
+	ctx.Set("contract_name", "contractName")
+
+	mock, host := myinit(t, ctx)
+	tracer := NewTracer()
+	host.SetTracer(tracer)
+
+	mock.EXPECT().Put(Any(), Any(), Any()).AnyTimes()
+	mock.EXPECT().Get("state", "b-contractName-hello").Return("sold@contractName", nil)
+
+	tracer.Enter("contractName", "set", `["new"]`, "user0")
+	_, _ = host.Put("hello", "new")
+	_, _ = host.Get("hello")
+	tracer.Log("Info", "done")
+	tracer.Enter("token.iost", "transfer", "[]", "contractName")
+	tracer.Exit(nil, Costs["GetCost"], errors.New("Error: balance not enough\nStack tree: \nError: balance not enough\n    at transfer"))
+	tracer.Exit([]interface{}{"ok"}, Costs["PutCost"], nil)
+
+	if len(tracer.Frames) != 1 {
+		t.Fatal(tracer.Frames)
+	}
+	f := tracer.Frames[0]
+	if f.Returns != `["ok"]` || f.GasUsed != Costs["PutCost"].ToGas() || len(f.Logs) != 1 {
+		t.Fatal(f)
+	}
+	if len(f.Storage) != 2 {
+		t.Fatal(f.Storage)
+	}
+	put, get := f.Storage[0], f.Storage[1]
+	if put.Op != "put" || put.Key != "contractName-hello" || put.OldValue != "old" || put.NewValue != "new" {
+		t.Fatal(put)
+	}
+	if get.Op != "get" || get.OldValue != "new" || get.NewValue != "" {
+		t.Fatal(get)
+	}
+	if len(f.Calls) != 1 {
+		t.Fatal(f.Calls)
+	}
+	c := f.Calls[0]
+	if c.Depth != 1 || c.Error != "Error: balance not enough" || c.Stack != "Error: balance not enough\n    at transfer" {
+		t.Fatal(c)
+	}
+}
+
+func TestHost_CheckSignAlgorithms(t *testing.T) {
+	ctx := NewContext(nil)
+	_, host := myinit(t, ctx)
+	trx := &tx.Tx{
+		Signs:        []*crypto.Signature{{Algorithm: crypto.Ed25519}},
+		PublishSigns: []*crypto.Signature{{Algorithm: crypto.Secp256r1}},
+	}
+	if err := host.CheckSignAlgorithms(trx); err != nil {
+		t.Fatal(err)
+	}
+	host.IsFork3_4_0 = false
+	if err := host.CheckSignAlgorithms(trx); !errors.Is(err, ErrAlgorithmNotEnabled) {
+		t.Fatal(err)
+	}
+	trx.PublishSigns[0].Algorithm = crypto.Secp256k1
+	if err := host.CheckSignAlgorithms(trx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHost_CheckUpgradeCompatible(t *testing.T) {
+	ctx := NewContext(nil)
+	mock, host := myinit(t, ctx)
+	oc := &contract.Contract{ID: "Contractabc", Info: &contract.Info{Abi: []*contract.ABI{
+		{Name: "transfer", Args: []string{"string", "number"}},
+	}}}
+	c := &contract.Contract{ID: "Contractabc", Info: &contract.Info{Abi: []*contract.ABI{
+		{Name: "transfer", Args: []string{"string", "string"}},
+	}}}
+
+	mock.EXPECT().Get("state", "m-system.iost-strict_upgrade-Contractabc").Return("", nil)
+	if _, err := host.checkUpgradeCompatible(oc, c); err != nil {
+		t.Fatal(err)
+	}
+
+	_, host = myinit(t, ctx)
+	host.IsFork3_4_0 = false
+	if _, err := host.checkUpgradeCompatible(oc, c); err != nil {
+		t.Fatal(err)
+	}
+
+	mock, host = myinit(t, ctx)
+	mock.EXPECT().Get("state", "m-system.iost-strict_upgrade-Contractabc").Return(database.MustMarshal(true), nil)
+	if _, err := host.checkUpgradeCompatible(oc, c); !errors.Is(err, contract.ErrIncompatibleUpgrade) {
+		t.Fatal(err)
+	}
+	c.Info.Abi = append(c.Info.Abi, &contract.ABI{Name: "transferV2", Args: []string{"string", "string"}})
+	c.Info.Abi[0].Args = oc.Info.Abi[0].Args
+	if _, err := host.checkUpgradeCompatible(oc, c); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,122 @@
+package host
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/vm/database"
+)
+
+// StorageAccess is a storage read or write recorded by the tracer.
+// OldValue is the value before the access, which is the value read for reads.
+type StorageAccess struct {
+	Op       string `json:"op"`
+	Key      string `json:"key"`
+	Field    string `json:"field,omitempty"`
+	OldValue string `json:"old_value,omitempty"`
+	NewValue string `json:"new_value,omitempty"`
+}
+
+// LogEntry is a console log written by a contract.
+type LogEntry struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// CallFrame is a contract call recorded by the tracer. GasUsed includes the gas of nested calls.
+type CallFrame struct {
+	Contract string           `json:"contract"`
+	ABI      string           `json:"abi"`
+	Args     string           `json:"args"`
+	Caller   string           `json:"caller"`
+	Depth    int              `json:"depth"`
+	GasUsed  int64            `json:"gas_used"`
+	Returns  string           `json:"returns,omitempty"`
+	Error    string           `json:"error,omitempty"`
+	Stack    string           `json:"stack,omitempty"`
+	Storage  []*StorageAccess `json:"storage,omitempty"`
+	Logs     []*LogEntry      `json:"logs,omitempty"`
+	Calls    []*CallFrame     `json:"calls,omitempty"`
+}
+
+// Tracer records the call frames of a transaction, one root frame per action.
+type Tracer struct {
+	Frames []*CallFrame
+	stack  []*CallFrame
+}
+
+// NewTracer returns an empty tracer.
+func NewTracer() *Tracer {
+	return &Tracer{
+		Frames: make([]*CallFrame, 0),
+	}
+}
+
+func (t *Tracer) current() *CallFrame {
+	if len(t.stack) == 0 {
+		return nil
+	}
+	return t.stack[len(t.stack)-1]
+}
+
+// Enter opens a call frame.
+func (t *Tracer) Enter(contractName, api, args, caller string) {
+	f := &CallFrame{
+		Contract: contractName,
+		ABI:      api,
+		Args:     args,
+		Caller:   caller,
+		Depth:    len(t.stack),
+	}
+	if p := t.current(); p != nil {
+		p.Calls = append(p.Calls, f)
+	} else {
+		t.Frames = append(t.Frames, f)
+	}
+	t.stack = append(t.stack, f)
+}
+
+// Exit closes the current call frame with its result.
+func (t *Tracer) Exit(rtn []interface{}, cost contract.Cost, err error) {
+	f := t.current()
+	if f == nil {
+		return
+	}
+	t.stack = t.stack[:len(t.stack)-1]
+	f.GasUsed = cost.ToGas()
+	if err != nil {
+		msg := err.Error()
+		if i := strings.Index(msg, "Stack tree:"); i >= 0 {
+			f.Stack = strings.TrimSpace(msg[i+len("Stack tree:"):])
+			msg = strings.TrimSpace(msg[:i])
+		}
+		f.Error = msg
+		return
+	}
+	if rj, err := json.Marshal(rtn); err == nil {
+		f.Returns = string(rj)
+	}
+}
+
+// Log records a console log in the current call frame.
+func (t *Tracer) Log(level, message string) {
+	if f := t.current(); f != nil {
+		f.Logs = append(f.Logs, &LogEntry{Level: level, Message: message})
+	}
+}
+
+// Storage records a storage access in the current call frame. Values are raw db values.
+func (t *Tracer) Storage(op, key, field, oldValue, newValue string) {
+	f := t.current()
+	if f == nil {
+		return
+	}
+	f.Storage = append(f.Storage, &StorageAccess{
+		Op:       op,
+		Key:      key,
+		Field:    field,
+		OldValue: database.FormatValue(oldValue),
+		NewValue: database.FormatValue(newValue),
+	})
+}
@@ -27,8 +27,7 @@ func (h *APIDelegate) receipt(s string) {
 	h.h.ctx.GSet("receipts", append(rs, rec))
 
 	// post event for receipt
-	event.GetCollector().Post(event.NewEvent(event.ContractReceipt, rec.Content),
-		&event.Meta{ContractID: h.h.Context().Value("contract_name").(string)})
+	event.GetCollector().Post(event.NewEvent(event.ContractReceipt, rec.Content), h.h.eventMeta(rec.Content))
 }
 
 // Receipt ...
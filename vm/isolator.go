@@ -34,6 +34,8 @@ type Isolator struct {
 	genesisMode   bool
 	blockBaseMode bool
 	limit         time.Duration
+	stateDiff     bool
+	events        bool
 }
 
 var staticMonitor = NewMonitor()
@@ -61,6 +63,22 @@ func (i *Isolator) Prepare(bh *block.BlockHead, db *database.Visitor, logger *il
 	return nil
 }
 
+// SetTracer makes the isolator record the calls, storage accesses and logs of txs into t.
+// It should be called after Prepare.
+func (i *Isolator) SetTracer(t *host.Tracer) {
+	i.h.SetTracer(t)
+}
+
+// SetStateDiff makes the isolator record the contract storage changes of txs into their receipts on commit.
+func (i *Isolator) SetStateDiff(enable bool) {
+	i.stateDiff = enable
+}
+
+// SetContractEvents makes the isolator record the contract events of txs into their receipts.
+func (i *Isolator) SetContractEvents(enable bool) {
+	i.events = enable
+}
+
 // PrepareTx read tx and ready to run
 func (i *Isolator) PrepareTx(t *tx.Tx, limit time.Duration) error {
 	i.t = t
@@ -95,7 +113,11 @@ func (i *Isolator) PrepareTx(t *tx.Tx, limit time.Duration) error {
 }
 
 func (i *Isolator) checkAuth(t *tx.Tx) error {
-	err := i.h.CheckSigners(t)
+	err := i.h.CheckSignAlgorithms(t)
+	if err != nil {
+		return err
+	}
+	err = i.h.CheckSigners(t)
 	if err != nil {
 		return err
 	}
@@ -174,6 +196,7 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 	}
 	i.h.Context().GSet("gas_limit", vmGasLimit)
 	i.h.Context().GSet("receipts", make([]*tx.Receipt, 0))
+	i.h.Context().GSet("events", make([]*tx.Receipt, 0))
 	i.h.Context().GSet("amount_total", make(map[string]*common.Fixed))
 
 	i.tr = tx.NewTxReceipt(i.t.Hash())
@@ -216,6 +239,7 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 	}
 
 	for _, action := range i.t.Actions {
+		eLen := len(i.h.Context().GValue("events").([]*tx.Receipt))
 		actionCost, status, ret, receipts, err := i.runAction(*action)
 		ilog.Debugf("run action : %v, result is %v\n", action, status.Code)
 		ilog.Debugf("used cost %v\n", actionCost)
@@ -253,6 +277,7 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 				i.tr.Returns = nil
 			}
 			i.tr.Receipts = nil
+			i.tr.Events = nil
 			i.h.DB().Rollback()
 			i.h.ClearRAMCosts()
 			i.tr.RAMUsage = make(map[string]int64)
@@ -260,6 +285,9 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 		}
 
 		i.tr.Receipts = append(i.tr.Receipts, receipts...)
+		if i.events {
+			i.tr.Events = append(i.tr.Events, i.h.Context().GValue("events").([]*tx.Receipt)[eLen:]...)
+		}
 		i.tr.Returns = append(i.tr.Returns, ret)
 		vmGasLimit -= actionCost.ToGas()
 		i.h.Context().GSet("gas_limit", vmGasLimit)
@@ -287,6 +315,7 @@ func (i *Isolator) PayCost() (*tx.TxReceipt, error) {
 			i.tr.Returns = nil
 			i.tr.Receipts = nil
 		}
+		i.tr.Events = nil
 		i.h.DB().Rollback()
 		i.h.ClearRAMCosts()
 		i.tr.RAMUsage = make(map[string]int64)
@@ -307,8 +336,32 @@ func (i *Isolator) PayCost() (*tx.TxReceipt, error) {
 	return i.tr, nil
 }
 
+// Costs returns the costs of the tx paid by each account.
+func (i *Isolator) Costs() map[string]contract.Cost {
+	return i.h.Costs()
+}
+
+// StateDiff returns the contract storage changes of the tx which are not committed yet.
+func (i *Isolator) StateDiff() []*tx.StateChange {
+	changes := i.h.DB().StorageChanges()
+	diff := make([]*tx.StateChange, 0, len(changes))
+	for _, c := range changes {
+		diff = append(diff, &tx.StateChange{
+			Contract: c.Contract,
+			Key:      c.Key,
+			Field:    c.Field,
+			OldValue: database.FormatValue(c.OldValue),
+			NewValue: database.FormatValue(c.NewValue),
+		})
+	}
+	return diff
+}
+
 // Commit flush changes to db
 func (i *Isolator) Commit() {
+	if i.stateDiff && i.tr != nil {
+		i.tr.StateDiff = i.StateDiff()
+	}
 	i.h.DB().Commit()
 }
 
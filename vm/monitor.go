@@ -17,6 +17,7 @@ import (
 	"github.com/iost-official/go-iost/v3/vm/host"
 	"github.com/iost-official/go-iost/v3/vm/native"
 	v8 "github.com/iost-official/go-iost/v3/vm/v8vm"
+	"github.com/iost-official/go-iost/v3/vm/wasm"
 )
 
 // Monitor ...
@@ -31,6 +32,7 @@ func NewMonitor() *Monitor {
 	}
 	jsvm := Factory("javascript")
 	m.vms["javascript"] = jsvm
+	m.vms["wasm"] = Factory("wasm")
 	return m
 }
 
@@ -93,8 +95,18 @@ func getAmountLimitMap(h *host.Host, amountList []*contract.Amount) (map[string]
 }
 
 // Call ...
-// nolint
 func (m *Monitor) Call(h *host.Host, contractName, api string, jarg string) (rtn []interface{}, cost contract.Cost, err error) {
+	if t := h.Tracer(); t != nil {
+		t.Enter(contractName, api, jarg, h.Caller().Name)
+		defer func() {
+			t.Exit(rtn, cost, err)
+		}()
+	}
+	return m.call(h, contractName, api, jarg)
+}
+
+// nolint
+func (m *Monitor) call(h *host.Host, contractName, api string, jarg string) (rtn []interface{}, cost contract.Cost, err error) {
 	if h.IsFork3_3_0 {
 		// TODO: reorganize monitor to remove this code
 		callerName := h.Caller().Name
@@ -114,6 +126,8 @@ func (m *Monitor) Call(h *host.Host, contractName, api string, jarg string) (rtn
 	switch c.Info.Lang {
 	case "javascript":
 		cost.AddAssign(host.Costs["JSCost"])
+	case "wasm":
+		cost.AddAssign(host.Costs["WASMCost"])
 	}
 
 	vm, ok := m.vms[c.Info.Lang]
@@ -261,9 +275,8 @@ func (m *Monitor) Compile(con *contract.Contract) (string, error) {
 	switch con.Info.Lang {
 	case "native":
 		return "", nil
-	case "javascript":
-		jsvm := m.vms["javascript"]
-		return jsvm.Compile(con)
+	case "javascript", "wasm":
+		return m.vms[con.Info.Lang].Compile(con)
 	}
 	return "", errors.New("vm unsupported")
 }
@@ -273,9 +286,8 @@ func (m *Monitor) Validate(con *contract.Contract) error {
 	switch con.Info.Lang {
 	case "native":
 		return nil
-	case "javascript":
-		jsvm := m.vms["javascript"]
-		return jsvm.Validate(con)
+	case "javascript", "wasm":
+		return m.vms[con.Info.Lang].Validate(con)
 	}
 	return errors.New("vm unsupported")
 }
@@ -292,6 +304,10 @@ func Factory(lang string) VM {
 		vm.Init()
 		//vm.SetJSPath(jsPath)
 		return vm
+	case "wasm":
+		vm := wasm.NewVM()
+		vm.Init()
+		return vm
 	}
 	return nil
 }
@@ -60,6 +60,7 @@ func getABISetByVersion(conID string, version string) (aset *abiSet, err error)
 	abiMap := make(map[string]map[string]*abiSet)
 	abiMap["system.iost"] = make(map[string]*abiSet)
 	abiMap["system.iost"]["1.0.0"] = systemABIs
+	abiMap["system.iost"]["1.0.1"] = systemABIsV2
 	abiMap["domain.iost"] = make(map[string]*abiSet)
 	abiMap["domain.iost"]["0.0.0"] = domain0ABIs
 	abiMap["domain.iost"]["1.0.0"] = domainABIs
@@ -0,0 +1,39 @@
+package native
+
+import (
+	"github.com/iost-official/go-iost/v3/core/contract"
+)
+
+// storage schemas of native contracts, which are not kept in their abis on chain
+var storageSchemas = map[string][]*contract.StorageItem{
+	"system.iost": {
+		{Key: "contract_owner", Field: "{contract}", Type: contract.StorageString, Description: "owner of the contract"},
+		{Key: "strict_upgrade", Field: "{contract}", Type: contract.StorageBool, Description: "whether updates of the contract must keep its abi compatible"},
+		{Key: "settings", Field: "host", Type: contract.StorageJSON, Description: "host settings"},
+	},
+	"token.iost": {
+		{Key: TokenBalanceMapPrefix + "{account}", Field: "{symbol}", Type: contract.StorageNumber, Description: "balance of the account, in the smallest unit of the token"},
+		{Key: TokenFreezeMapPrefix + "{account}", Field: "{symbol}", Type: contract.StorageJSON, Description: "frozen balances of the account"},
+		{Key: TokenInfoMapPrefix + "{symbol}", Field: IssuerMapField, Type: contract.StorageString, Description: "issuer of the token"},
+		{Key: TokenInfoMapPrefix + "{symbol}", Field: SupplyMapField, Type: contract.StorageNumber, Description: "issued amount of the token, in its smallest unit"},
+		{Key: TokenInfoMapPrefix + "{symbol}", Field: TotalSupplyMapField, Type: contract.StorageNumber, Description: "max supply of the token, in its smallest unit"},
+		{Key: TokenInfoMapPrefix + "{symbol}", Field: CanTransferMapField, Type: contract.StorageBool},
+		{Key: TokenInfoMapPrefix + "{symbol}", Field: OnlyIssuerCanTransferMapField, Type: contract.StorageBool},
+		{Key: TokenInfoMapPrefix + "{symbol}", Field: DefaultRateMapField, Type: contract.StorageNumber},
+		{Key: TokenInfoMapPrefix + "{symbol}", Field: DecimalMapField, Type: contract.StorageNumber},
+		{Key: TokenInfoMapPrefix + "{symbol}", Field: FullNameMapField, Type: contract.StorageString},
+	},
+	"token721.iost": {
+		{Key: Token721BalanceMapPrefix + "{account}", Field: "{symbol}", Type: contract.StorageNumber, Description: "count of the tokens owned by the account"},
+		{Key: Token721MetadataMapPrefix + "{symbol}" + Token721MetadataKeySeparator + "{account}", Field: "{tokenID}", Type: contract.StorageString, Description: "metadata of the token"},
+		{Key: Token721InfoMapPrefix + "{symbol}", Field: Token721IssuerMapField, Type: contract.StorageString, Description: "issuer of the token"},
+		{Key: Token721InfoMapPrefix + "{symbol}", Field: SupplyMapField, Type: contract.StorageNumber, Description: "count of the tokens issued"},
+		{Key: Token721InfoMapPrefix + "{symbol}", Field: TotalSupplyMapField, Type: contract.StorageNumber, Description: "max count of the tokens"},
+		{Key: Token721InfoMapPrefix + "{symbol}", Field: "{tokenID}", Type: contract.StorageString, Description: "owner of the token"},
+	},
+}
+
+// StorageSchema returns the storage schema of a native contract.
+func StorageSchema(conID string) []*contract.StorageItem {
+	return storageSchemas[conID]
+}
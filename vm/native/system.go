@@ -56,7 +56,7 @@ var (
 			codeRaw := args[0].(string)
 
 			if codeRaw[0] == '{' {
-				err = json.Unmarshal([]byte(codeRaw), con)
+				err = con.DecodeJSON([]byte(codeRaw), h.IsFork3_4_0)
 				if err != nil {
 					return nil, host.CommonErrorCost(1), err
 				}
@@ -117,7 +117,7 @@ var (
 			}
 
 			if codeRaw[0] == '{' {
-				err = json.Unmarshal([]byte(codeRaw), con)
+				err = con.DecodeJSON([]byte(codeRaw), h.IsFork3_4_0)
 				if err != nil {
 					return nil, host.CommonErrorCost(1), err
 				}
@@ -198,7 +198,7 @@ var (
 				}
 			} else {
 				if codeRaw[0] == '{' {
-					err = json.Unmarshal([]byte(codeRaw), con)
+					err = con.DecodeJSON([]byte(codeRaw), h.IsFork3_4_0)
 					if err != nil {
 						return nil, host.CommonErrorCost(1), err
 					}
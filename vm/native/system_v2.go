@@ -0,0 +1,58 @@
+package native
+
+import (
+	"errors"
+
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+var systemABIsV2 *abiSet
+
+func init() {
+	systemABIsV2 = newAbiSet()
+	systemABIsV2.Register(requireAuth)
+	systemABIsV2.Register(receipt)
+	systemABIsV2.Register(setCode)
+	systemABIsV2.Register(updateCode)
+	systemABIsV2.Register(initSetCode)
+	systemABIsV2.Register(cancelDelaytx)
+	systemABIsV2.Register(hostSettings)
+	systemABIsV2.Register(updateNativeCode)
+	systemABIsV2.Register(setStrictUpgrade)
+}
+
+var (
+	// setStrictUpgrade sets whether updates of a contract must keep its abi compatible, so that the
+	// contracts calling it are not broken. Only the owner of the contract can set it.
+	setStrictUpgrade = &abi{
+		name: "setStrictUpgrade",
+		args: []string{"string", "bool"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			cost = contract.Cost0()
+			conID := args[0].(string)
+			strict := args[1].(bool)
+
+			owner, cost0 := h.MapGet("contract_owner", conID)
+			cost.AddAssign(cost0)
+			ownerID, ok := owner.(string)
+			if !ok || ownerID == "" {
+				return nil, cost, host.ErrContractNotFound
+			}
+			ok, cost0 = h.RequireAuth(ownerID, "active")
+			cost.AddAssign(cost0)
+			if !ok {
+				return nil, cost, errors.New("set strict upgrade need active permission of contract owner")
+			}
+
+			if strict {
+				publisher := h.Context().Value("publisher").(string)
+				cost0, err = h.MapPut("strict_upgrade", conID, true, publisher)
+			} else {
+				cost0, err = h.MapDel("strict_upgrade", conID)
+			}
+			cost.AddAssign(cost0)
+			return []interface{}{}, cost, err
+		},
+	}
+)
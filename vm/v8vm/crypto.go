@@ -10,7 +10,10 @@ import (
 	"github.com/iost-official/go-iost/v3/crypto"
 )
 
-const cryptGasBase = 100
+const (
+	cryptGasBase = 100
+	cryptGasBLS  = 3000 // extra gas of the pairings in a bls verification
+)
 
 //export goSha3
 func goSha3(cSbx C.SandboxPtr, msg C.CStr, gasUsed *C.size_t) C.CStr {
@@ -51,10 +54,20 @@ func goVerify(cSbx C.SandboxPtr, algo C.CStr, msg C.CStr, sig C.CStr, pubkey C.C
 	sigBytes := common.Base58Decode(sig.GoString())
 	pubkeyBytes := common.Base58Decode(pubkey.GoString())
 	*gasUsed = C.size_t(len(msgBytes) + cryptGasBase)
-	if algoStr != "secp256k1" && algoStr != "ed25519" {
+	a, err := crypto.ParseAlgorithm(algoStr)
+	if err != nil {
 		return 0
 	}
-	if !crypto.NewAlgorithm(algoStr).Verify(msgBytes, pubkeyBytes, sigBytes) {
+	if a != crypto.Secp256k1 && a != crypto.Ed25519 {
+		sbx, ok := GetSandbox(cSbx)
+		if !ok || !sbx.host.IsFork3_4_0 {
+			return 0
+		}
+	}
+	if a == crypto.BLS {
+		*gasUsed += cryptGasBLS
+	}
+	if !a.Verify(msgBytes, pubkeyBytes, sigBytes) {
 		return 0
 	}
 	return 1
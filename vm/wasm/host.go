@@ -0,0 +1,250 @@
+package wasm
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+// ErrInvalidDBValType error
+var ErrInvalidDBValType = errors.New("invalid db value type")
+
+// notFound is returned as i32 -1 by lookups that find nothing.
+const notFound = 1<<32 - 1
+
+// hostFunc is a function imported from the "iost" module. All parameters
+// and results are i32. Strings are passed as (ptr, len) pairs; functions
+// producing a string store it in the result buffer, return its length and
+// let the contract copy it with result_read.
+type hostFunc struct {
+	typ funcType
+	fn  func(in *instance, a []uint64) (uint64, bool)
+}
+
+func sig(params, results int) funcType {
+	t := funcType{params: make([]byte, params), results: make([]byte, results)}
+	for i := range t.params {
+		t.params[i] = typeI32
+	}
+	for i := range t.results {
+		t.results[i] = typeI32
+	}
+	return t
+}
+
+func (in *instance) str(ptr, n uint64) string {
+	return string(in.read(ptr, n))
+}
+
+// payer returns the optional ram payer argument.
+func (in *instance) payer(ptr, n uint64) []string {
+	if uint32(n) == 0 {
+		return nil
+	}
+	return []string{in.str(ptr, n)}
+}
+
+func (in *instance) pay(cost contract.Cost) {
+	in.charge(cost.CPU)
+}
+
+func (in *instance) fail(cost contract.Cost, err error) {
+	in.pay(cost)
+	if err != nil {
+		in.trap(err)
+	}
+}
+
+func (in *instance) setResult(s string) (uint64, bool) {
+	in.result = []byte(s)
+	return uint64(len(in.result)), true
+}
+
+func (in *instance) setValue(val interface{}, cost contract.Cost) (uint64, bool) {
+	in.pay(cost)
+	if val == nil {
+		in.result = nil
+		return notFound, true
+	}
+	s, err := dbValToString(val)
+	if err != nil {
+		in.trap(err)
+	}
+	return in.setResult(s)
+}
+
+func (in *instance) setJSON(v interface{}, cost contract.Cost) (uint64, bool) {
+	in.pay(cost)
+	j, err := json.Marshal(v)
+	if err != nil {
+		in.trap(host.ErrInvalidData)
+	}
+	return in.setResult(string(j))
+}
+
+func boolResult(b bool, cost contract.Cost, in *instance) (uint64, bool) {
+	in.pay(cost)
+	if b {
+		return 1, true
+	}
+	return 0, true
+}
+
+func (in *instance) contractCall(a []uint64, withAuth bool) (uint64, bool) {
+	c, api, args := in.str(a[0], a[1]), in.str(a[2], a[3]), in.str(a[4], a[5])
+	var rtn []interface{}
+	var cost contract.Cost
+	var err error
+	if withAuth {
+		rtn, cost, err = in.host.CallWithAuth(c, api, args)
+	} else {
+		rtn, cost, err = in.host.Call(c, api, args)
+	}
+	in.fail(cost, err)
+	return in.setJSON(rtn, contract.Cost0())
+}
+
+var hostFuncs = map[string]*hostFunc{
+	"args_len": {sig(0, 1), func(in *instance, a []uint64) (uint64, bool) {
+		return uint64(len(in.args)), true
+	}},
+	"args_read": {sig(1, 0), func(in *instance, a []uint64) (uint64, bool) {
+		in.write(a[0], in.args)
+		return 0, false
+	}},
+	"result_read": {sig(1, 0), func(in *instance, a []uint64) (uint64, bool) {
+		in.write(a[0], in.result)
+		return 0, false
+	}},
+	"ret": {sig(2, 0), func(in *instance, a []uint64) (uint64, bool) {
+		if uint32(a[1]) > resultMaxLength {
+			in.trap(ErrResultTooLong)
+		}
+		in.ret = append([]byte(nil), in.read(a[0], a[1])...)
+		return 0, false
+	}},
+	"abort": {sig(2, 0), func(in *instance, a []uint64) (uint64, bool) {
+		in.trap(errors.New(in.str(a[0], a[1])))
+		return 0, false
+	}},
+
+	"put": {sig(6, 0), func(in *instance, a []uint64) (uint64, bool) {
+		in.fail(in.host.Put(in.str(a[0], a[1]), in.str(a[2], a[3]), in.payer(a[4], a[5])...))
+		return 0, false
+	}},
+	"get": {sig(2, 1), func(in *instance, a []uint64) (uint64, bool) {
+		return in.setValue(in.host.Get(in.str(a[0], a[1])))
+	}},
+	"has": {sig(2, 1), func(in *instance, a []uint64) (uint64, bool) {
+		ok, cost := in.host.Has(in.str(a[0], a[1]))
+		return boolResult(ok, cost, in)
+	}},
+	"del": {sig(2, 0), func(in *instance, a []uint64) (uint64, bool) {
+		in.fail(in.host.Del(in.str(a[0], a[1])))
+		return 0, false
+	}},
+	"map_put": {sig(8, 0), func(in *instance, a []uint64) (uint64, bool) {
+		in.fail(in.host.MapPut(in.str(a[0], a[1]), in.str(a[2], a[3]), in.str(a[4], a[5]), in.payer(a[6], a[7])...))
+		return 0, false
+	}},
+	"map_get": {sig(4, 1), func(in *instance, a []uint64) (uint64, bool) {
+		return in.setValue(in.host.MapGet(in.str(a[0], a[1]), in.str(a[2], a[3])))
+	}},
+	"map_has": {sig(4, 1), func(in *instance, a []uint64) (uint64, bool) {
+		ok, cost := in.host.MapHas(in.str(a[0], a[1]), in.str(a[2], a[3]))
+		return boolResult(ok, cost, in)
+	}},
+	"map_del": {sig(4, 0), func(in *instance, a []uint64) (uint64, bool) {
+		in.fail(in.host.MapDel(in.str(a[0], a[1]), in.str(a[2], a[3])))
+		return 0, false
+	}},
+	"map_keys": {sig(2, 1), func(in *instance, a []uint64) (uint64, bool) {
+		keys, cost := in.host.MapKeys(in.str(a[0], a[1]))
+		return in.setJSON(keys, cost)
+	}},
+	"map_len": {sig(2, 1), func(in *instance, a []uint64) (uint64, bool) {
+		n, cost := in.host.MapLen(in.str(a[0], a[1]))
+		in.pay(cost)
+		return uint64(n), true
+	}},
+
+	"global_has": {sig(4, 1), func(in *instance, a []uint64) (uint64, bool) {
+		ok, cost := in.host.GlobalHas(in.str(a[0], a[1]), in.str(a[2], a[3]))
+		return boolResult(ok, cost, in)
+	}},
+	"global_get": {sig(4, 1), func(in *instance, a []uint64) (uint64, bool) {
+		return in.setValue(in.host.GlobalGet(in.str(a[0], a[1]), in.str(a[2], a[3])))
+	}},
+	"global_map_has": {sig(6, 1), func(in *instance, a []uint64) (uint64, bool) {
+		ok, cost := in.host.GlobalMapHas(in.str(a[0], a[1]), in.str(a[2], a[3]), in.str(a[4], a[5]))
+		return boolResult(ok, cost, in)
+	}},
+	"global_map_get": {sig(6, 1), func(in *instance, a []uint64) (uint64, bool) {
+		return in.setValue(in.host.GlobalMapGet(in.str(a[0], a[1]), in.str(a[2], a[3]), in.str(a[4], a[5])))
+	}},
+	"global_map_keys": {sig(4, 1), func(in *instance, a []uint64) (uint64, bool) {
+		keys, cost := in.host.GlobalMapKeys(in.str(a[0], a[1]), in.str(a[2], a[3]))
+		return in.setJSON(keys, cost)
+	}},
+	"global_map_len": {sig(4, 1), func(in *instance, a []uint64) (uint64, bool) {
+		n, cost := in.host.GlobalMapLen(in.str(a[0], a[1]), in.str(a[2], a[3]))
+		in.pay(cost)
+		return uint64(n), true
+	}},
+
+	"call": {sig(6, 1), func(in *instance, a []uint64) (uint64, bool) {
+		return in.contractCall(a, false)
+	}},
+	"call_with_auth": {sig(6, 1), func(in *instance, a []uint64) (uint64, bool) {
+		return in.contractCall(a, true)
+	}},
+	"require_auth": {sig(4, 1), func(in *instance, a []uint64) (uint64, bool) {
+		ok, cost := in.host.RequireAuth(in.str(a[0], a[1]), in.str(a[2], a[3]))
+		return boolResult(ok, cost, in)
+	}},
+	"receipt": {sig(2, 0), func(in *instance, a []uint64) (uint64, bool) {
+		in.pay(in.host.Receipt(in.str(a[0], a[1])))
+		return 0, false
+	}},
+	"event": {sig(2, 0), func(in *instance, a []uint64) (uint64, bool) {
+		in.pay(in.host.PostEvent(in.str(a[0], a[1])))
+		return 0, false
+	}},
+
+	"block_info": {sig(0, 1), func(in *instance, a []uint64) (uint64, bool) {
+		info, cost := in.host.BlockInfo()
+		in.pay(cost)
+		return in.setResult(string(info))
+	}},
+	"tx_info": {sig(0, 1), func(in *instance, a []uint64) (uint64, bool) {
+		info, cost := in.host.TxInfo()
+		in.pay(cost)
+		return in.setResult(string(info))
+	}},
+	"context_info": {sig(0, 1), func(in *instance, a []uint64) (uint64, bool) {
+		info, cost := in.host.ContextInfo()
+		in.pay(cost)
+		return in.setResult(string(info))
+	}},
+}
+
+func dbValToString(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case []byte:
+		return string(v), nil
+	case database.SerializedJSON:
+		return string(v), nil
+	default:
+		return "", ErrInvalidDBValType
+	}
+}
@@ -0,0 +1,639 @@
+package wasm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"runtime"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+// gas schedule of the interpreter
+const (
+	instrGas     = 1
+	callGas      = 10
+	hostCallGas  = 20
+	pageGas      = 1000
+	byteCopyRate = 8
+)
+
+// execution limits
+const (
+	maxCallDepth  = 512
+	maxStackSize  = 1 << 16
+	deadlineCheck = 1024
+)
+
+// runtime errors
+var (
+	ErrExecutionKilled    = errors.New("execution killed")
+	ErrUnreachable        = errors.New("unreachable executed")
+	ErrDivideByZero       = errors.New("integer divide by zero")
+	ErrIntegerOverflow    = errors.New("integer overflow")
+	ErrMemoryOutOfBounds  = errors.New("out of bounds memory access")
+	ErrTableOutOfBounds   = errors.New("out of bounds table access")
+	ErrUndefinedElement   = errors.New("undefined table element")
+	ErrIndirectCallType   = errors.New("indirect call type mismatch")
+	ErrCallStackExhausted = errors.New("call stack exhausted")
+	ErrStackExhausted     = errors.New("operand stack exhausted")
+	ErrResultTooLong      = errors.New("result too long")
+)
+
+type trap struct {
+	err error
+}
+
+type label struct {
+	cont   int
+	arity  int
+	height int
+	loop   bool
+}
+
+// instance is a module instantiated for a single contract call.
+type instance struct {
+	mod     *Module
+	host    *host.Host
+	mem     []byte
+	maxMem  uint32
+	globals []uint64
+	table   []int64
+	stack   []uint64
+	depth   int
+
+	gas      int64
+	gasLimit int64
+	steps    int64
+	deadline time.Time
+
+	args   []byte
+	result []byte
+	ret    []byte
+}
+
+func (in *instance) trap(err error) {
+	panic(trap{err})
+}
+
+// catch converts traps and runtime faults raised by malformed code into errors.
+func catch(err *error) {
+	if r := recover(); r != nil {
+		switch t := r.(type) {
+		case trap:
+			*err = t.err
+		case runtime.Error:
+			*err = fmt.Errorf("wasm trap: %v", t)
+		default:
+			panic(r)
+		}
+	}
+}
+
+func (in *instance) charge(gas int64) {
+	in.gas += gas
+	if in.gas > in.gasLimit {
+		in.trap(host.ErrOutOfGas)
+	}
+}
+
+func (in *instance) step() {
+	in.charge(instrGas)
+	in.steps++
+	if in.steps%deadlineCheck == 0 && time.Now().After(in.deadline) {
+		in.trap(ErrExecutionKilled)
+	}
+}
+
+func (in *instance) push(v uint64) {
+	if len(in.stack) >= maxStackSize {
+		in.trap(ErrStackExhausted)
+	}
+	in.stack = append(in.stack, v)
+}
+
+func (in *instance) pop() uint64 {
+	n := len(in.stack) - 1
+	v := in.stack[n]
+	in.stack = in.stack[:n]
+	return v
+}
+
+func (in *instance) pushBool(b bool) {
+	if b {
+		in.push(1)
+	} else {
+		in.push(0)
+	}
+}
+
+// addr returns the effective address of an access of size bytes.
+func (in *instance) addr(base, offset, size uint64) uint64 {
+	ea := uint64(uint32(base)) + offset
+	if ea+size > uint64(len(in.mem)) {
+		in.trap(ErrMemoryOutOfBounds)
+	}
+	return ea
+}
+
+func (in *instance) read(ptr, n uint64) []byte {
+	ea := in.addr(ptr, 0, uint64(uint32(n)))
+	return in.mem[ea : ea+uint64(uint32(n))]
+}
+
+func (in *instance) write(ptr uint64, b []byte) {
+	ea := in.addr(ptr, 0, uint64(len(b)))
+	in.charge(int64(len(b) / byteCopyRate))
+	copy(in.mem[ea:], b)
+}
+
+// instantiate allocates memory, globals and table and runs the start function.
+func instantiate(m *Module, h *host.Host, gasLimit int64, deadline time.Time) (in *instance, err error) {
+	in = &instance{
+		mod:      m,
+		host:     h,
+		gasLimit: gasLimit,
+		deadline: deadline,
+		stack:    make([]uint64, 0, 64),
+	}
+	defer catch(&err)
+	if m.memory != nil {
+		in.maxMem = maxPages
+		if m.memory.has && m.memory.max < maxPages {
+			in.maxMem = m.memory.max
+		}
+		in.charge(int64(m.memory.min) * pageGas)
+		in.mem = make([]byte, int(m.memory.min)*pageSize)
+	}
+	in.globals = make([]uint64, len(m.globals))
+	for i, g := range m.globals {
+		in.globals[i] = g.init
+	}
+	if m.table != nil {
+		in.table = make([]int64, m.table.min)
+		for i := range in.table {
+			in.table[i] = -1
+		}
+	}
+	for _, e := range m.elems {
+		if uint64(e.offset)+uint64(len(e.funcs)) > uint64(len(in.table)) {
+			in.trap(ErrTableOutOfBounds)
+		}
+		for i, f := range e.funcs {
+			in.table[int(e.offset)+i] = int64(f)
+		}
+	}
+	for _, d := range m.data {
+		in.write(uint64(d.offset), d.data)
+	}
+	if m.start != nil {
+		in.invoke(*m.start)
+	}
+	return in, nil
+}
+
+// call runs the exported function name, which must take no parameters.
+func (in *instance) call(name string) (err error) {
+	idx, ok := in.mod.ExportedFunc(name)
+	if !ok {
+		return fmt.Errorf("function %s not exported", name)
+	}
+	defer catch(&err)
+	in.invoke(idx)
+	return nil
+}
+
+func (in *instance) invoke(idx uint32) {
+	m := in.mod
+	if int(idx) < len(m.imports) {
+		in.callHost(hostFuncs[m.imports[idx].name])
+		return
+	}
+	f := &m.funcs[int(idx)-len(m.imports)]
+	t := &m.types[f.typ]
+	if in.depth >= maxCallDepth {
+		in.trap(ErrCallStackExhausted)
+	}
+	in.depth++
+	np := len(t.params)
+	locals := make([]uint64, np+len(f.locals))
+	in.charge(callGas + int64(len(locals)))
+	copy(locals, in.stack[len(in.stack)-np:])
+	in.stack = in.stack[:len(in.stack)-np]
+	in.run(f, locals, len(t.results))
+	in.depth--
+}
+
+func (in *instance) callHost(hf *hostFunc) {
+	np := len(hf.typ.params)
+	args := make([]uint64, np)
+	copy(args, in.stack[len(in.stack)-np:])
+	in.stack = in.stack[:len(in.stack)-np]
+	in.charge(hostCallGas)
+	if r, ok := hf.fn(in, args); ok {
+		in.push(r)
+	}
+}
+
+// branch unwinds to the label at depth d and returns the continuation.
+func (in *instance) branch(labels *[]label, d int) int {
+	ls := *labels
+	l := ls[len(ls)-1-d]
+	s := in.stack
+	copy(s[l.height:], s[len(s)-l.arity:])
+	in.stack = s[:l.height+l.arity]
+	if l.loop {
+		*labels = ls[:len(ls)-d]
+	} else {
+		*labels = ls[:len(ls)-1-d]
+	}
+	return l.cont
+}
+
+// nolint: gocyclo
+func (in *instance) run(f *function, locals []uint64, results int) {
+	body := f.body
+	labels := make([]label, 1, 8)
+	labels[0] = label{cont: len(body), arity: results, height: len(in.stack)}
+	pc := 0
+	for pc < len(body) {
+		ins := &body[pc]
+		pc++
+		in.step()
+		switch ins.op {
+		case 0x00:
+			in.trap(ErrUnreachable)
+		case 0x01:
+		case opBlock:
+			labels = append(labels, label{cont: ins.end + 1, arity: int(ins.arity), height: len(in.stack) - int(ins.params)})
+		case opLoop:
+			labels = append(labels, label{cont: pc, arity: int(ins.params), height: len(in.stack) - int(ins.params), loop: true})
+		case opIf:
+			c := uint32(in.pop())
+			labels = append(labels, label{cont: ins.end + 1, arity: int(ins.arity), height: len(in.stack) - int(ins.params)})
+			if c == 0 {
+				if ins.els != ins.end {
+					pc = ins.els + 1
+				} else {
+					pc = ins.end
+				}
+			}
+		case opElse:
+			pc = ins.end
+		case opEnd:
+			labels = labels[:len(labels)-1]
+		case 0x0c:
+			pc = in.branch(&labels, int(ins.imm))
+		case 0x0d:
+			if uint32(in.pop()) != 0 {
+				pc = in.branch(&labels, int(ins.imm))
+			}
+		case opBrTable:
+			i := uint32(in.pop())
+			d := ins.imm
+			if int(i) < len(ins.table) {
+				d = uint64(ins.table[i])
+			}
+			pc = in.branch(&labels, int(d))
+		case 0x0f:
+			pc = in.branch(&labels, len(labels)-1)
+		case 0x10:
+			in.invoke(uint32(ins.imm))
+		case opCallIndir:
+			i := uint32(in.pop())
+			if int(i) >= len(in.table) {
+				in.trap(ErrTableOutOfBounds)
+			}
+			fi := in.table[i]
+			if fi < 0 {
+				in.trap(ErrUndefinedElement)
+			}
+			t, _ := in.mod.funcTypeOf(uint32(fi))
+			if !t.equal(&in.mod.types[ins.imm]) {
+				in.trap(ErrIndirectCallType)
+			}
+			in.invoke(uint32(fi))
+
+		case 0x1a:
+			in.pop()
+		case 0x1b:
+			c := uint32(in.pop())
+			b := in.pop()
+			a := in.pop()
+			if c != 0 {
+				in.push(a)
+			} else {
+				in.push(b)
+			}
+
+		case 0x20:
+			in.push(locals[ins.imm])
+		case 0x21:
+			locals[ins.imm] = in.pop()
+		case 0x22:
+			locals[ins.imm] = in.stack[len(in.stack)-1]
+		case 0x23:
+			in.push(in.globals[ins.imm])
+		case 0x24:
+			in.globals[ins.imm] = in.pop()
+
+		case 0x28:
+			ea := in.addr(in.pop(), ins.imm, 4)
+			in.push(uint64(binary.LittleEndian.Uint32(in.mem[ea:])))
+		case 0x29:
+			ea := in.addr(in.pop(), ins.imm, 8)
+			in.push(binary.LittleEndian.Uint64(in.mem[ea:]))
+		case 0x2c:
+			ea := in.addr(in.pop(), ins.imm, 1)
+			in.push(uint64(uint32(int32(int8(in.mem[ea])))))
+		case 0x2d:
+			ea := in.addr(in.pop(), ins.imm, 1)
+			in.push(uint64(in.mem[ea]))
+		case 0x2e:
+			ea := in.addr(in.pop(), ins.imm, 2)
+			in.push(uint64(uint32(int32(int16(binary.LittleEndian.Uint16(in.mem[ea:]))))))
+		case 0x2f:
+			ea := in.addr(in.pop(), ins.imm, 2)
+			in.push(uint64(binary.LittleEndian.Uint16(in.mem[ea:])))
+		case 0x30:
+			ea := in.addr(in.pop(), ins.imm, 1)
+			in.push(uint64(int64(int8(in.mem[ea]))))
+		case 0x31:
+			ea := in.addr(in.pop(), ins.imm, 1)
+			in.push(uint64(in.mem[ea]))
+		case 0x32:
+			ea := in.addr(in.pop(), ins.imm, 2)
+			in.push(uint64(int64(int16(binary.LittleEndian.Uint16(in.mem[ea:])))))
+		case 0x33:
+			ea := in.addr(in.pop(), ins.imm, 2)
+			in.push(uint64(binary.LittleEndian.Uint16(in.mem[ea:])))
+		case 0x34:
+			ea := in.addr(in.pop(), ins.imm, 4)
+			in.push(uint64(int64(int32(binary.LittleEndian.Uint32(in.mem[ea:])))))
+		case 0x35:
+			ea := in.addr(in.pop(), ins.imm, 4)
+			in.push(uint64(binary.LittleEndian.Uint32(in.mem[ea:])))
+		case 0x36, 0x3e:
+			v := in.pop()
+			ea := in.addr(in.pop(), ins.imm, 4)
+			binary.LittleEndian.PutUint32(in.mem[ea:], uint32(v))
+		case 0x37:
+			v := in.pop()
+			ea := in.addr(in.pop(), ins.imm, 8)
+			binary.LittleEndian.PutUint64(in.mem[ea:], v)
+		case 0x3a, 0x3c:
+			v := in.pop()
+			ea := in.addr(in.pop(), ins.imm, 1)
+			in.mem[ea] = byte(v)
+		case 0x3b, 0x3d:
+			v := in.pop()
+			ea := in.addr(in.pop(), ins.imm, 2)
+			binary.LittleEndian.PutUint16(in.mem[ea:], uint16(v))
+		case 0x3f:
+			in.push(uint64(len(in.mem) / pageSize))
+		case 0x40:
+			n := uint32(in.pop())
+			old := uint32(len(in.mem) / pageSize)
+			if uint64(old)+uint64(n) > uint64(in.maxMem) {
+				in.push(math.MaxUint32)
+				break
+			}
+			in.charge(int64(n) * pageGas)
+			in.mem = append(in.mem, make([]byte, int(n)*pageSize)...)
+			in.push(uint64(old))
+		case internalCopy:
+			n := uint64(uint32(in.pop()))
+			src := in.addr(in.pop(), 0, n)
+			dst := in.addr(in.pop(), 0, n)
+			in.charge(int64(n / byteCopyRate))
+			copy(in.mem[dst:dst+n], in.mem[src:src+n])
+		case internalFill:
+			n := uint64(uint32(in.pop()))
+			v := byte(in.pop())
+			dst := in.addr(in.pop(), 0, n)
+			in.charge(int64(n / byteCopyRate))
+			for i := dst; i < dst+n; i++ {
+				in.mem[i] = v
+			}
+
+		case 0x41, 0x42:
+			in.push(ins.imm)
+
+		case 0x45:
+			in.pushBool(uint32(in.pop()) == 0)
+		case 0x50:
+			in.pushBool(in.pop() == 0)
+		case 0x46, 0x47, 0x48, 0x49, 0x4a, 0x4b, 0x4c, 0x4d, 0x4e, 0x4f:
+			b := uint32(in.pop())
+			a := uint32(in.pop())
+			in.pushBool(cmp32(ins.op, a, b))
+		case 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5a:
+			b := in.pop()
+			a := in.pop()
+			in.pushBool(cmp64(ins.op-0x0b, a, b))
+
+		case 0x67:
+			in.push(uint64(bits.LeadingZeros32(uint32(in.pop()))))
+		case 0x68:
+			in.push(uint64(bits.TrailingZeros32(uint32(in.pop()))))
+		case 0x69:
+			in.push(uint64(bits.OnesCount32(uint32(in.pop()))))
+		case 0x79:
+			in.push(uint64(bits.LeadingZeros64(in.pop())))
+		case 0x7a:
+			in.push(uint64(bits.TrailingZeros64(in.pop())))
+		case 0x7b:
+			in.push(uint64(bits.OnesCount64(in.pop())))
+		case 0x6a, 0x6b, 0x6c, 0x6d, 0x6e, 0x6f, 0x70, 0x71, 0x72, 0x73, 0x74, 0x75, 0x76, 0x77, 0x78:
+			b := uint32(in.pop())
+			a := uint32(in.pop())
+			in.push(uint64(in.arith32(ins.op, a, b)))
+		case 0x7c, 0x7d, 0x7e, 0x7f, 0x80, 0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x8a:
+			b := in.pop()
+			a := in.pop()
+			in.push(in.arith64(ins.op-0x12, a, b))
+
+		case 0xa7:
+			in.push(uint64(uint32(in.pop())))
+		case 0xac:
+			in.push(uint64(int64(int32(uint32(in.pop())))))
+		case 0xad:
+			in.push(uint64(uint32(in.pop())))
+		case 0xc0:
+			in.push(uint64(uint32(int32(int8(in.pop())))))
+		case 0xc1:
+			in.push(uint64(uint32(int32(int16(in.pop())))))
+		case 0xc2:
+			in.push(uint64(int64(int8(in.pop()))))
+		case 0xc3:
+			in.push(uint64(int64(int16(in.pop()))))
+		case 0xc4:
+			in.push(uint64(int64(int32(in.pop()))))
+		default:
+			in.trap(fmt.Errorf("unsupported opcode 0x%x", ins.op))
+		}
+	}
+}
+
+// cmp32 evaluates i32 comparisons, op ranges over i32.eq..i32.ge_u.
+func cmp32(op byte, a, b uint32) bool {
+	switch op {
+	case 0x46:
+		return a == b
+	case 0x47:
+		return a != b
+	case 0x48:
+		return int32(a) < int32(b)
+	case 0x49:
+		return a < b
+	case 0x4a:
+		return int32(a) > int32(b)
+	case 0x4b:
+		return a > b
+	case 0x4c:
+		return int32(a) <= int32(b)
+	case 0x4d:
+		return a <= b
+	case 0x4e:
+		return int32(a) >= int32(b)
+	default:
+		return a >= b
+	}
+}
+
+// cmp64 evaluates i64 comparisons, op is shifted into the i32 opcode range.
+func cmp64(op byte, a, b uint64) bool {
+	switch op {
+	case 0x46:
+		return a == b
+	case 0x47:
+		return a != b
+	case 0x48:
+		return int64(a) < int64(b)
+	case 0x49:
+		return a < b
+	case 0x4a:
+		return int64(a) > int64(b)
+	case 0x4b:
+		return a > b
+	case 0x4c:
+		return int64(a) <= int64(b)
+	case 0x4d:
+		return a <= b
+	case 0x4e:
+		return int64(a) >= int64(b)
+	default:
+		return a >= b
+	}
+}
+
+// arith32 evaluates i32 binary operators, op ranges over i32.add..i32.rotr.
+func (in *instance) arith32(op byte, a, b uint32) uint32 {
+	switch op {
+	case 0x6a:
+		return a + b
+	case 0x6b:
+		return a - b
+	case 0x6c:
+		return a * b
+	case 0x6d:
+		if b == 0 {
+			in.trap(ErrDivideByZero)
+		}
+		if int32(a) == math.MinInt32 && int32(b) == -1 {
+			in.trap(ErrIntegerOverflow)
+		}
+		return uint32(int32(a) / int32(b))
+	case 0x6e:
+		if b == 0 {
+			in.trap(ErrDivideByZero)
+		}
+		return a / b
+	case 0x6f:
+		if b == 0 {
+			in.trap(ErrDivideByZero)
+		}
+		if int32(b) == -1 {
+			return 0
+		}
+		return uint32(int32(a) % int32(b))
+	case 0x70:
+		if b == 0 {
+			in.trap(ErrDivideByZero)
+		}
+		return a % b
+	case 0x71:
+		return a & b
+	case 0x72:
+		return a | b
+	case 0x73:
+		return a ^ b
+	case 0x74:
+		return a << (b & 31)
+	case 0x75:
+		return uint32(int32(a) >> (b & 31))
+	case 0x76:
+		return a >> (b & 31)
+	case 0x77:
+		return bits.RotateLeft32(a, int(b&31))
+	default:
+		return bits.RotateLeft32(a, -int(b&31))
+	}
+}
+
+// arith64 evaluates i64 binary operators, op is shifted into the i32 opcode range.
+func (in *instance) arith64(op byte, a, b uint64) uint64 {
+	switch op {
+	case 0x6a:
+		return a + b
+	case 0x6b:
+		return a - b
+	case 0x6c:
+		return a * b
+	case 0x6d:
+		if b == 0 {
+			in.trap(ErrDivideByZero)
+		}
+		if int64(a) == math.MinInt64 && int64(b) == -1 {
+			in.trap(ErrIntegerOverflow)
+		}
+		return uint64(int64(a) / int64(b))
+	case 0x6e:
+		if b == 0 {
+			in.trap(ErrDivideByZero)
+		}
+		return a / b
+	case 0x6f:
+		if b == 0 {
+			in.trap(ErrDivideByZero)
+		}
+		if int64(b) == -1 {
+			return 0
+		}
+		return uint64(int64(a) % int64(b))
+	case 0x70:
+		if b == 0 {
+			in.trap(ErrDivideByZero)
+		}
+		return a % b
+	case 0x71:
+		return a & b
+	case 0x72:
+		return a | b
+	case 0x73:
+		return a ^ b
+	case 0x74:
+		return a << (b & 63)
+	case 0x75:
+		return uint64(int64(a) >> (b & 63))
+	case 0x76:
+		return a >> (b & 63)
+	case 0x77:
+		return bits.RotateLeft64(a, int(b&63))
+	default:
+		return bits.RotateLeft64(a, -int(b&63))
+	}
+}
@@ -0,0 +1,1011 @@
+package wasm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// value types
+const (
+	typeI32     byte = 0x7f
+	typeI64     byte = 0x7e
+	typeF32     byte = 0x7d
+	typeF64     byte = 0x7c
+	typeFuncRef byte = 0x70
+)
+
+// export kinds
+const (
+	externFunc   byte = 0x00
+	externTable  byte = 0x01
+	externMemory byte = 0x02
+	externGlobal byte = 0x03
+)
+
+// module limits
+const (
+	pageSize      = 65536
+	maxPages      = 64
+	maxTableSize  = 65536
+	maxLocals     = 50000
+	maxFunctions  = 100000
+	wasmMagic     = 0x6d736100
+	wasmVersion   = 1
+	hostModule    = "iost"
+	internalCopy  = 0xf0
+	internalFill  = 0xf1
+	opPrefixBulk  = 0xfc
+	opBlock       = 0x02
+	opLoop        = 0x03
+	opIf          = 0x04
+	opElse        = 0x05
+	opEnd         = 0x0b
+	opBrTable     = 0x0e
+	opCallIndir   = 0x11
+	opSelectTyped = 0x1c
+)
+
+// decode errors
+var (
+	ErrInvalidMagic   = errors.New("invalid wasm magic or version")
+	ErrUnexpectedEOF  = errors.New("unexpected end of wasm binary")
+	ErrFloatForbidden = errors.New("floating point is not allowed in contracts")
+	ErrLEBOverflow    = errors.New("leb128 integer overflow")
+)
+
+type funcType struct {
+	params  []byte
+	results []byte
+}
+
+func (t *funcType) equal(o *funcType) bool {
+	return string(t.params) == string(o.params) && string(t.results) == string(o.results)
+}
+
+type importFunc struct {
+	module string
+	name   string
+	typ    uint32
+}
+
+type function struct {
+	typ    uint32
+	locals []byte
+	body   []instr
+}
+
+type global struct {
+	typ     byte
+	mutable bool
+	init    uint64
+}
+
+type export struct {
+	kind  byte
+	index uint32
+}
+
+type limits struct {
+	min uint32
+	max uint32
+	has bool
+}
+
+type dataSegment struct {
+	offset uint32
+	data   []byte
+}
+
+type elemSegment struct {
+	offset uint32
+	funcs  []uint32
+}
+
+// instr is a pre-decoded instruction. Structured control instructions keep
+// the positions of their matching else and end so that branches are O(1).
+type instr struct {
+	op     byte
+	imm    uint64
+	arity  uint32
+	params uint32
+	els    int
+	end    int
+	table  []uint32
+}
+
+// Module is a decoded WebAssembly module.
+type Module struct {
+	types   []funcType
+	imports []importFunc
+	funcs   []function
+	table   *limits
+	memory  *limits
+	globals []global
+	exports map[string]export
+	start   *uint32
+	elems   []elemSegment
+	data    []dataSegment
+}
+
+// funcTypeOf returns the signature of function idx, imports first.
+func (m *Module) funcTypeOf(idx uint32) (*funcType, error) {
+	if int(idx) < len(m.imports) {
+		return &m.types[m.imports[idx].typ], nil
+	}
+	i := int(idx) - len(m.imports)
+	if i >= len(m.funcs) {
+		return nil, fmt.Errorf("function index %d out of range", idx)
+	}
+	return &m.types[m.funcs[i].typ], nil
+}
+
+func (m *Module) numFuncs() int {
+	return len(m.imports) + len(m.funcs)
+}
+
+// ExportedFunc returns the index of the exported function name.
+func (m *Module) ExportedFunc(name string) (uint32, bool) {
+	e, ok := m.exports[name]
+	if !ok || e.kind != externFunc {
+		return 0, false
+	}
+	return e.index, true
+}
+
+type reader struct {
+	buf []byte
+	pos int
+}
+
+func (r *reader) eof() bool {
+	return r.pos >= len(r.buf)
+}
+
+func (r *reader) byte() (byte, error) {
+	if r.pos >= len(r.buf) {
+		return 0, ErrUnexpectedEOF
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *reader) bytes(n uint32) ([]byte, error) {
+	if uint64(r.pos)+uint64(n) > uint64(len(r.buf)) {
+		return nil, ErrUnexpectedEOF
+	}
+	b := r.buf[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b, nil
+}
+
+func (r *reader) u32() (uint32, error) {
+	var result uint64
+	var shift uint
+	for {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		result |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			break
+		}
+		shift += 7
+		if shift >= 35 {
+			return 0, ErrLEBOverflow
+		}
+	}
+	if result > math.MaxUint32 {
+		return 0, ErrLEBOverflow
+	}
+	return uint32(result), nil
+}
+
+func (r *reader) sleb(size uint) (int64, error) {
+	var result int64
+	var shift uint
+	var b byte
+	var err error
+	for {
+		b, err = r.byte()
+		if err != nil {
+			return 0, err
+		}
+		result |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			break
+		}
+		if shift >= size {
+			return 0, ErrLEBOverflow
+		}
+	}
+	if shift < 64 && b&0x40 != 0 {
+		result |= -1 << shift
+	}
+	return result, nil
+}
+
+func (r *reader) name() (string, error) {
+	n, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.bytes(n)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (r *reader) valType() (byte, error) {
+	t, err := r.byte()
+	if err != nil {
+		return 0, err
+	}
+	switch t {
+	case typeI32, typeI64:
+		return t, nil
+	case typeF32, typeF64:
+		return 0, ErrFloatForbidden
+	}
+	return 0, fmt.Errorf("unsupported value type 0x%x", t)
+}
+
+func (r *reader) limits() (*limits, error) {
+	flag, err := r.byte()
+	if err != nil {
+		return nil, err
+	}
+	l := &limits{}
+	if l.min, err = r.u32(); err != nil {
+		return nil, err
+	}
+	switch flag {
+	case 0:
+	case 1:
+		l.has = true
+		if l.max, err = r.u32(); err != nil {
+			return nil, err
+		}
+		if l.max < l.min {
+			return nil, errors.New("limits maximum below minimum")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported limits flag 0x%x", flag)
+	}
+	return l, nil
+}
+
+// Decode parses and structurally validates a wasm binary.
+func Decode(code []byte) (*Module, error) {
+	if len(code) < 8 || binary.LittleEndian.Uint32(code) != wasmMagic || binary.LittleEndian.Uint32(code[4:]) != wasmVersion {
+		return nil, ErrInvalidMagic
+	}
+	m := &Module{exports: make(map[string]export)}
+	r := &reader{buf: code, pos: 8}
+	var funcTypes []uint32
+	var lastOrder int
+	for !r.eof() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		payload, err := r.bytes(size)
+		if err != nil {
+			return nil, err
+		}
+		if id == 0 {
+			continue
+		}
+		// the data count section (12) sits between element and code
+		order := int(id) * 2
+		if id == 12 {
+			order = 19
+		}
+		if order <= lastOrder {
+			return nil, fmt.Errorf("section %d out of order", id)
+		}
+		lastOrder = order
+		sr := &reader{buf: payload}
+		switch id {
+		case 1:
+			err = m.decodeTypes(sr)
+		case 2:
+			err = m.decodeImports(sr)
+		case 3:
+			funcTypes, err = m.decodeFuncDecls(sr)
+		case 4:
+			err = m.decodeTable(sr)
+		case 5:
+			err = m.decodeMemory(sr)
+		case 6:
+			err = m.decodeGlobals(sr)
+		case 7:
+			err = m.decodeExports(sr)
+		case 8:
+			var idx uint32
+			if idx, err = sr.u32(); err == nil {
+				m.start = &idx
+			}
+		case 9:
+			err = m.decodeElems(sr)
+		case 10:
+			err = m.decodeCode(sr, funcTypes)
+			funcTypes = nil
+		case 11:
+			err = m.decodeData(sr)
+		case 12:
+			_, err = sr.u32()
+		default:
+			err = fmt.Errorf("unknown section %d", id)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("section %d: %v", id, err)
+		}
+		if !sr.eof() {
+			return nil, fmt.Errorf("section %d: size mismatch", id)
+		}
+	}
+	if len(funcTypes) != 0 {
+		return nil, errors.New("function and code section mismatch")
+	}
+	return m, m.check()
+}
+
+func (m *Module) decodeTypes(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		form, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if form != 0x60 {
+			return fmt.Errorf("invalid func type form 0x%x", form)
+		}
+		var ft funcType
+		for _, dst := range []*[]byte{&ft.params, &ft.results} {
+			cnt, err := r.u32()
+			if err != nil {
+				return err
+			}
+			if cnt > maxLocals {
+				return errors.New("too many types in signature")
+			}
+			for j := uint32(0); j < cnt; j++ {
+				t, err := r.valType()
+				if err != nil {
+					return err
+				}
+				*dst = append(*dst, t)
+			}
+		}
+		m.types = append(m.types, ft)
+	}
+	return nil
+}
+
+func (m *Module) decodeImports(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		mod, err := r.name()
+		if err != nil {
+			return err
+		}
+		name, err := r.name()
+		if err != nil {
+			return err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if kind != externFunc {
+			return fmt.Errorf("import %s.%s: only functions can be imported", mod, name)
+		}
+		typ, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if int(typ) >= len(m.types) {
+			return fmt.Errorf("import %s.%s: type index out of range", mod, name)
+		}
+		m.imports = append(m.imports, importFunc{module: mod, name: name, typ: typ})
+	}
+	return nil
+}
+
+func (m *Module) decodeFuncDecls(r *reader) ([]uint32, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	if n > maxFunctions {
+		return nil, errors.New("too many functions")
+	}
+	types := make([]uint32, n)
+	for i := range types {
+		if types[i], err = r.u32(); err != nil {
+			return nil, err
+		}
+		if int(types[i]) >= len(m.types) {
+			return nil, errors.New("type index out of range")
+		}
+	}
+	return types, nil
+}
+
+func (m *Module) decodeTable(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if n > 1 {
+		return errors.New("at most one table is allowed")
+	}
+	if n == 0 {
+		return nil
+	}
+	t, err := r.byte()
+	if err != nil {
+		return err
+	}
+	if t != typeFuncRef {
+		return fmt.Errorf("unsupported table type 0x%x", t)
+	}
+	l, err := r.limits()
+	if err != nil {
+		return err
+	}
+	if l.min > maxTableSize {
+		return errors.New("table too large")
+	}
+	m.table = l
+	return nil
+}
+
+func (m *Module) decodeMemory(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if n > 1 {
+		return errors.New("at most one memory is allowed")
+	}
+	if n == 0 {
+		return nil
+	}
+	l, err := r.limits()
+	if err != nil {
+		return err
+	}
+	if l.min > maxPages {
+		return fmt.Errorf("memory exceeds %d pages", maxPages)
+	}
+	m.memory = l
+	return nil
+}
+
+// constExpr evaluates an initializer expression. Only integer constants
+// and reads of previously defined immutable globals are accepted.
+func (m *Module) constExpr(r *reader) (byte, uint64, error) {
+	op, err := r.byte()
+	if err != nil {
+		return 0, 0, err
+	}
+	var typ byte
+	var v uint64
+	switch op {
+	case 0x41:
+		x, err := r.sleb(32)
+		if err != nil {
+			return 0, 0, err
+		}
+		typ, v = typeI32, uint64(uint32(int32(x)))
+	case 0x42:
+		x, err := r.sleb(64)
+		if err != nil {
+			return 0, 0, err
+		}
+		typ, v = typeI64, uint64(x)
+	case 0x23:
+		idx, err := r.u32()
+		if err != nil {
+			return 0, 0, err
+		}
+		if int(idx) >= len(m.globals) || m.globals[idx].mutable {
+			return 0, 0, errors.New("invalid global in constant expression")
+		}
+		typ, v = m.globals[idx].typ, m.globals[idx].init
+	case 0x43, 0x44:
+		return 0, 0, ErrFloatForbidden
+	default:
+		return 0, 0, fmt.Errorf("unsupported constant expression opcode 0x%x", op)
+	}
+	end, err := r.byte()
+	if err != nil {
+		return 0, 0, err
+	}
+	if end != opEnd {
+		return 0, 0, errors.New("constant expression not terminated")
+	}
+	return typ, v, nil
+}
+
+func (m *Module) decodeGlobals(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		t, err := r.valType()
+		if err != nil {
+			return err
+		}
+		mut, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if mut > 1 {
+			return errors.New("invalid global mutability")
+		}
+		typ, v, err := m.constExpr(r)
+		if err != nil {
+			return err
+		}
+		if typ != t {
+			return errors.New("global initializer type mismatch")
+		}
+		m.globals = append(m.globals, global{typ: t, mutable: mut == 1, init: v})
+	}
+	return nil
+}
+
+func (m *Module) decodeExports(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		name, err := r.name()
+		if err != nil {
+			return err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+		idx, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if kind > externGlobal {
+			return fmt.Errorf("export %s: invalid kind", name)
+		}
+		if _, ok := m.exports[name]; ok {
+			return fmt.Errorf("duplicate export %s", name)
+		}
+		m.exports[name] = export{kind: kind, index: idx}
+	}
+	return nil
+}
+
+func (m *Module) decodeElems(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		flag, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if flag != 0 {
+			return fmt.Errorf("unsupported element segment flag %d", flag)
+		}
+		typ, off, err := m.constExpr(r)
+		if err != nil {
+			return err
+		}
+		if typ != typeI32 {
+			return errors.New("element offset must be i32")
+		}
+		cnt, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if cnt > maxTableSize {
+			return errors.New("element segment too large")
+		}
+		seg := elemSegment{offset: uint32(off), funcs: make([]uint32, cnt)}
+		for j := range seg.funcs {
+			if seg.funcs[j], err = r.u32(); err != nil {
+				return err
+			}
+		}
+		m.elems = append(m.elems, seg)
+	}
+	return nil
+}
+
+func (m *Module) decodeData(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		flag, err := r.u32()
+		if err != nil {
+			return err
+		}
+		switch flag {
+		case 0:
+		case 2:
+			idx, err := r.u32()
+			if err != nil {
+				return err
+			}
+			if idx != 0 {
+				return errors.New("data segment memory index out of range")
+			}
+		default:
+			return fmt.Errorf("unsupported data segment flag %d", flag)
+		}
+		typ, off, err := m.constExpr(r)
+		if err != nil {
+			return err
+		}
+		if typ != typeI32 {
+			return errors.New("data offset must be i32")
+		}
+		size, err := r.u32()
+		if err != nil {
+			return err
+		}
+		b, err := r.bytes(size)
+		if err != nil {
+			return err
+		}
+		m.data = append(m.data, dataSegment{offset: uint32(off), data: b})
+	}
+	return nil
+}
+
+func (m *Module) decodeCode(r *reader, types []uint32) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if int(n) != len(types) {
+		return errors.New("function and code section mismatch")
+	}
+	for i := uint32(0); i < n; i++ {
+		size, err := r.u32()
+		if err != nil {
+			return err
+		}
+		b, err := r.bytes(size)
+		if err != nil {
+			return err
+		}
+		br := &reader{buf: b}
+		f := function{typ: types[i]}
+		groups, err := br.u32()
+		if err != nil {
+			return err
+		}
+		total := uint64(len(m.types[f.typ].params))
+		for j := uint32(0); j < groups; j++ {
+			cnt, err := br.u32()
+			if err != nil {
+				return err
+			}
+			t, err := br.valType()
+			if err != nil {
+				return err
+			}
+			total += uint64(cnt)
+			if total > maxLocals {
+				return errors.New("too many locals")
+			}
+			for k := uint32(0); k < cnt; k++ {
+				f.locals = append(f.locals, t)
+			}
+		}
+		if f.body, err = m.decodeBody(br); err != nil {
+			return fmt.Errorf("function %d: %v", int(i)+len(m.imports), err)
+		}
+		m.funcs = append(m.funcs, f)
+	}
+	return nil
+}
+
+// blockType returns the param and result counts of a block.
+func (m *Module) blockType(r *reader) (uint32, uint32, error) {
+	x, err := r.sleb(33)
+	if err != nil {
+		return 0, 0, err
+	}
+	switch {
+	case x == -0x40:
+		return 0, 0, nil
+	case x == -0x01 || x == -0x02:
+		return 0, 1, nil
+	case x == -0x03 || x == -0x04:
+		return 0, 0, ErrFloatForbidden
+	case x >= 0 && int(x) < len(m.types):
+		return uint32(len(m.types[x].params)), uint32(len(m.types[x].results)), nil
+	}
+	return 0, 0, fmt.Errorf("invalid block type %d", x)
+}
+
+func memarg(r *reader) (uint64, error) {
+	if _, err := r.u32(); err != nil {
+		return 0, err
+	}
+	off, err := r.u32()
+	return uint64(off), err
+}
+
+func isFloatOp(op byte) bool {
+	switch {
+	case op == 0x2a, op == 0x2b, op == 0x38, op == 0x39, op == 0x43, op == 0x44:
+		return true
+	case op >= 0x5b && op <= 0x66:
+		return true
+	case op >= 0x8b && op <= 0xa6:
+		return true
+	case op >= 0xa8 && op <= 0xab, op >= 0xae && op <= 0xbf:
+		return true
+	}
+	return false
+}
+
+// decodeBody turns raw bytecode into instrs and resolves block structure.
+func (m *Module) decodeBody(r *reader) ([]instr, error) {
+	var body []instr
+	var open []int
+	for {
+		op, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		if isFloatOp(op) {
+			return nil, ErrFloatForbidden
+		}
+		in := instr{op: op}
+		switch {
+		case op == opBlock || op == opLoop || op == opIf:
+			if in.params, in.arity, err = m.blockType(r); err != nil {
+				return nil, err
+			}
+			open = append(open, len(body))
+		case op == opElse:
+			if len(open) == 0 || body[open[len(open)-1]].op != opIf || body[open[len(open)-1]].els != 0 {
+				return nil, errors.New("else without if")
+			}
+			body[open[len(open)-1]].els = len(body)
+		case op == opEnd:
+			if len(open) == 0 {
+				body = append(body, in)
+				if !r.eof() {
+					return nil, errors.New("trailing bytes after function end")
+				}
+				return body, nil
+			}
+			start := open[len(open)-1]
+			open = open[:len(open)-1]
+			body[start].end = len(body)
+			if body[start].els == 0 {
+				body[start].els = len(body)
+			} else {
+				body[body[start].els].end = len(body)
+			}
+		case op == 0x0c || op == 0x0d:
+			d, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			in.imm = uint64(d)
+		case op == opBrTable:
+			n, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			if n > maxTableSize {
+				return nil, errors.New("br_table too large")
+			}
+			in.table = make([]uint32, n)
+			for i := range in.table {
+				if in.table[i], err = r.u32(); err != nil {
+					return nil, err
+				}
+			}
+			d, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			in.imm = uint64(d)
+		case op == 0x10:
+			idx, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			in.imm = uint64(idx)
+		case op == opCallIndir:
+			idx, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			if int(idx) >= len(m.types) {
+				return nil, errors.New("call_indirect type out of range")
+			}
+			tbl, err := r.byte()
+			if err != nil {
+				return nil, err
+			}
+			if tbl != 0 {
+				return nil, errors.New("call_indirect table index out of range")
+			}
+			in.imm = uint64(idx)
+		case op == opSelectTyped:
+			n, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			for i := uint32(0); i < n; i++ {
+				if _, err := r.valType(); err != nil {
+					return nil, err
+				}
+			}
+			in.op = 0x1b
+		case op >= 0x20 && op <= 0x24:
+			idx, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			in.imm = uint64(idx)
+		case op >= 0x28 && op <= 0x3e:
+			if in.imm, err = memarg(r); err != nil {
+				return nil, err
+			}
+		case op == 0x3f || op == 0x40:
+			if b, err := r.byte(); err != nil || b != 0 {
+				return nil, errors.New("invalid memory index")
+			}
+		case op == 0x41:
+			x, err := r.sleb(32)
+			if err != nil {
+				return nil, err
+			}
+			in.imm = uint64(uint32(int32(x)))
+		case op == 0x42:
+			x, err := r.sleb(64)
+			if err != nil {
+				return nil, err
+			}
+			in.imm = uint64(x)
+		case op == opPrefixBulk:
+			sub, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			switch sub {
+			case 10:
+				a, err1 := r.byte()
+				b, err2 := r.byte()
+				if err1 != nil || err2 != nil || a != 0 || b != 0 {
+					return nil, errors.New("invalid memory.copy")
+				}
+				in.op = internalCopy
+			case 11:
+				if b, err := r.byte(); err != nil || b != 0 {
+					return nil, errors.New("invalid memory.fill")
+				}
+				in.op = internalFill
+			default:
+				if sub <= 7 {
+					return nil, ErrFloatForbidden
+				}
+				return nil, fmt.Errorf("unsupported opcode 0xfc %d", sub)
+			}
+		case op <= 0x01, op == 0x0f, op == 0x1a, op == 0x1b:
+		case op >= 0x45 && op <= 0x5a, op >= 0x67 && op <= 0x8a:
+		case op == 0xa7, op == 0xac, op == 0xad, op >= 0xc0 && op <= 0xc4:
+		default:
+			return nil, fmt.Errorf("unsupported opcode 0x%x", op)
+		}
+		body = append(body, in)
+	}
+}
+
+// check verifies cross references between sections.
+func (m *Module) check() error {
+	for _, f := range m.funcs {
+		for _, in := range f.body {
+			if in.op == 0x10 && int(in.imm) >= m.numFuncs() {
+				return fmt.Errorf("call to undefined function %d", in.imm)
+			}
+			if (in.op == 0x23 || in.op == 0x24) && int(in.imm) >= len(m.globals) {
+				return fmt.Errorf("global index %d out of range", in.imm)
+			}
+			if in.op == 0x24 && !m.globals[in.imm].mutable {
+				return fmt.Errorf("global %d is immutable", in.imm)
+			}
+			if in.op == opCallIndir && m.table == nil {
+				return errors.New("call_indirect without table")
+			}
+			if ((in.op >= 0x28 && in.op <= 0x40) || in.op == internalCopy || in.op == internalFill) && m.memory == nil {
+				return errors.New("memory access without memory")
+			}
+		}
+	}
+	for name, e := range m.exports {
+		switch e.kind {
+		case externFunc:
+			if int(e.index) >= m.numFuncs() {
+				return fmt.Errorf("export %s: function index out of range", name)
+			}
+		case externMemory:
+			if e.index != 0 || m.memory == nil {
+				return fmt.Errorf("export %s: memory index out of range", name)
+			}
+		case externTable:
+			if e.index != 0 || m.table == nil {
+				return fmt.Errorf("export %s: table index out of range", name)
+			}
+		case externGlobal:
+			if int(e.index) >= len(m.globals) {
+				return fmt.Errorf("export %s: global index out of range", name)
+			}
+		}
+	}
+	if m.start != nil {
+		t, err := m.funcTypeOf(*m.start)
+		if err != nil {
+			return err
+		}
+		if len(t.params) != 0 || len(t.results) != 0 {
+			return errors.New("start function must have type [] -> []")
+		}
+	}
+	for _, e := range m.elems {
+		if m.table == nil {
+			return errors.New("element segment without table")
+		}
+		for _, idx := range e.funcs {
+			if int(idx) >= m.numFuncs() {
+				return fmt.Errorf("element references undefined function %d", idx)
+			}
+		}
+	}
+	if len(m.data) > 0 && m.memory == nil {
+		return errors.New("data segment without memory")
+	}
+	for _, imp := range m.imports {
+		if imp.module != hostModule {
+			return fmt.Errorf("import %s.%s: unknown module", imp.module, imp.name)
+		}
+		hf, ok := hostFuncs[imp.name]
+		if !ok {
+			return fmt.Errorf("import %s.%s: unknown function", imp.module, imp.name)
+		}
+		if !hf.typ.equal(&m.types[imp.typ]) {
+			return fmt.Errorf("import %s.%s: signature mismatch", imp.module, imp.name)
+		}
+	}
+	return nil
+}
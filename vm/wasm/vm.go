@@ -0,0 +1,154 @@
+// Package wasm implements a deterministic WebAssembly contract runtime.
+//
+// Contracts are wasm binaries stored base64 encoded in contract.Code with
+// Info.Lang "wasm". Every ABI is an exported function of type [] -> [];
+// arguments are read as a JSON array through the "iost" host module and
+// the return value is set with ret. Floating point types and instructions
+// are rejected at deploy time so that execution is identical on every node.
+package wasm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+const (
+	resultMaxLength = 65536 // byte
+	moduleCacheSize = 256
+)
+
+// VM is the wasm implementation of vm.VM.
+type VM struct {
+	mu      sync.Mutex
+	modules map[string]*Module
+}
+
+// NewVM returns a wasm vm.
+func NewVM() *VM {
+	return &VM{}
+}
+
+// Init ...
+func (v *VM) Init() error {
+	v.modules = make(map[string]*Module)
+	return nil
+}
+
+// Validate checks the binary and that every abi is exported with type [] -> [].
+func (v *VM) Validate(c *contract.Contract) error {
+	m, err := decodeCode(c.Code)
+	if err != nil {
+		return fmt.Errorf("validate code error: %v", err)
+	}
+	names := []string{"init"}
+	for _, abi := range c.Info.Abi {
+		names = append(names, abi.Name)
+	}
+	for _, name := range names {
+		idx, ok := m.ExportedFunc(name)
+		if !ok {
+			return fmt.Errorf("validate code error: abi %s not exported", name)
+		}
+		t, err := m.funcTypeOf(idx)
+		if err != nil {
+			return fmt.Errorf("validate code error: %v", err)
+		}
+		if len(t.params) != 0 || len(t.results) != 0 {
+			return fmt.Errorf("validate code error: abi %s must have type [] -> []", name)
+		}
+	}
+	return nil
+}
+
+// Compile returns the code unchanged, modules are decoded lazily and cached.
+func (v *VM) Compile(c *contract.Contract) (string, error) {
+	if _, err := decodeCode(c.Code); err != nil {
+		return "", err
+	}
+	return c.Code, nil
+}
+
+// LoadAndCall instantiates the contract and calls api with args.
+func (v *VM) LoadAndCall(h *host.Host, c *contract.Contract, api string, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+	if !h.Deadline().After(time.Now()) {
+		return nil, contract.Cost0(), ErrExecutionKilled
+	}
+	m, err := v.module(c.Code)
+	if err != nil {
+		return nil, contract.Cost0(), err
+	}
+	jargs, err := formatArgs(args)
+	if err != nil {
+		return nil, contract.Cost0(), err
+	}
+	in, err := instantiate(m, h, h.GasLimitValue(), h.Deadline())
+	if err != nil {
+		return nil, contract.NewCost(0, 0, in.gas), err
+	}
+	in.args = jargs
+	err = in.call(api)
+	cost = contract.NewCost(0, 0, in.gas)
+	if err != nil {
+		return nil, cost, err
+	}
+	return []interface{}{string(in.ret)}, cost, nil
+}
+
+// Release ...
+func (v *VM) Release() {
+	v.mu.Lock()
+	v.modules = make(map[string]*Module)
+	v.mu.Unlock()
+}
+
+func (v *VM) module(code string) (*Module, error) {
+	v.mu.Lock()
+	m, ok := v.modules[code]
+	v.mu.Unlock()
+	if ok {
+		return m, nil
+	}
+	m, err := decodeCode(code)
+	if err != nil {
+		return nil, err
+	}
+	v.mu.Lock()
+	if len(v.modules) >= moduleCacheSize {
+		v.modules = make(map[string]*Module)
+	}
+	v.modules[code] = m
+	v.mu.Unlock()
+	return m, nil
+}
+
+func decodeCode(code string) (*Module, error) {
+	b, err := base64.StdEncoding.DecodeString(code)
+	if err != nil {
+		return nil, fmt.Errorf("code is not base64 encoded: %v", err)
+	}
+	return Decode(b)
+}
+
+func formatArgs(args []interface{}) ([]byte, error) {
+	strArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch a := arg.(type) {
+		case []byte:
+			strArgs = append(strArgs, string(a))
+		default:
+			b, err := json.Marshal(a)
+			if err != nil {
+				return nil, err
+			}
+			strArgs = append(strArgs, string(b))
+		}
+	}
+	return []byte("[" + strings.Join(strArgs, ",") + "]"), nil
+}
@@ -0,0 +1,244 @@
+package wasm
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/v3/core/contract"
+	"github.com/iost-official/go-iost/v3/core/version"
+	"github.com/iost-official/go-iost/v3/vm/database"
+	"github.com/iost-official/go-iost/v3/vm/host"
+)
+
+func uleb(v uint32) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			b = append(b, c|0x80)
+			continue
+		}
+		return append(b, c)
+	}
+}
+
+func vec(items ...[]byte) []byte {
+	b := uleb(uint32(len(items)))
+	for _, it := range items {
+		b = append(b, it...)
+	}
+	return b
+}
+
+func str(s string) []byte {
+	return append(uleb(uint32(len(s))), s...)
+}
+
+func section(id byte, payload []byte) []byte {
+	return append(append([]byte{id}, uleb(uint32(len(payload)))...), payload...)
+}
+
+func cat(parts ...[]byte) []byte {
+	var b []byte
+	for _, p := range parts {
+		b = append(b, p...)
+	}
+	return b
+}
+
+func body(locals []byte, code ...byte) []byte {
+	b := cat(locals, code)
+	return append(uleb(uint32(len(b))), b...)
+}
+
+// testModule assembles a contract importing args_len, args_read, ret and put.
+//
+//	func 4 init: nop
+//	func 5 echo: put("k", args); ret(args)
+//	func 6 spin: loop { br 0 }
+//	func 7 div0: 1 / 0
+//	func 8 sum: sum of 1..10 with a loop
+//	func 9 fact(i64) i64: recursive factorial
+func testModule() []byte {
+	const (
+		argsLen  = 0x00
+		argsRead = 0x01
+		ret      = 0x02
+		put      = 0x03
+		fact     = 0x09
+	)
+	i32, i64 := typeI32, typeI64
+	types := section(1, vec(
+		[]byte{0x60, 0, 0},
+		[]byte{0x60, 1, i32, 0},
+		[]byte{0x60, 0, 1, i32},
+		[]byte{0x60, 2, i32, i32, 0},
+		[]byte{0x60, 6, i32, i32, i32, i32, i32, i32, 0},
+		[]byte{0x60, 1, i64, 1, i64},
+	))
+	imports := section(2, vec(
+		cat(str("iost"), str("args_len"), []byte{0, 2}),
+		cat(str("iost"), str("args_read"), []byte{0, 1}),
+		cat(str("iost"), str("ret"), []byte{0, 3}),
+		cat(str("iost"), str("put"), []byte{0, 4}),
+	))
+	funcs := section(3, vec([]byte{0}, []byte{0}, []byte{0}, []byte{0}, []byte{2}, []byte{5}))
+	memory := section(5, vec([]byte{0, 1}))
+	exports := section(7, vec(
+		cat(str("init"), []byte{0, 4}),
+		cat(str("echo"), []byte{0, 5}),
+		cat(str("spin"), []byte{0, 6}),
+		cat(str("div0"), []byte{0, 7}),
+		cat(str("sum"), []byte{0, 8}),
+		cat(str("fact"), []byte{0, 9}),
+		cat(str("memory"), []byte{2, 0}),
+	))
+	code := section(10, vec(
+		body([]byte{0}, 0x01, 0x0b),
+		body([]byte{0},
+			0x41, 16, 0x10, argsRead,
+			0x41, 0, 0x41, 1, 0x41, 16, 0x10, argsLen, 0x41, 0, 0x41, 0, 0x10, put,
+			0x41, 16, 0x10, argsLen, 0x10, ret,
+			0x0b),
+		body([]byte{0}, 0x03, 0x40, 0x0c, 0, 0x0b, 0x0b),
+		body([]byte{0}, 0x41, 1, 0x41, 0, 0x6d, 0x1a, 0x0b),
+		body([]byte{1, 2, i32},
+			0x03, 0x40,
+			0x20, 0, 0x41, 1, 0x6a, 0x22, 0, 0x20, 1, 0x6a, 0x21, 1,
+			0x20, 0, 0x41, 10, 0x48, 0x0d, 0,
+			0x0b,
+			0x20, 1,
+			0x0b),
+		body([]byte{0},
+			0x20, 0, 0x50, 0x04, i64,
+			0x42, 1,
+			0x05,
+			0x20, 0, 0x20, 0, 0x42, 1, 0x7d, 0x10, fact, 0x7e,
+			0x0b,
+			0x0b),
+	))
+	data := section(11, vec(cat([]byte{0, 0x41, 0, 0x0b}, str("k"))))
+	return cat([]byte{0x00, 0x61, 0x73, 0x6d, 1, 0, 0, 0}, types, imports, funcs, memory, exports, code, data)
+}
+
+func testContract(code []byte, abis ...string) *contract.Contract {
+	c := &contract.Contract{
+		ID:   "Contractwasm",
+		Code: base64.StdEncoding.EncodeToString(code),
+		Info: &contract.Info{Lang: "wasm", Version: "1.0.0"},
+	}
+	for _, name := range abis {
+		c.Info.Abi = append(c.Info.Abi, &contract.ABI{Name: name, Args: []string{"string"}})
+	}
+	return c
+}
+
+func testHost(t *testing.T, gasLimit int64) *host.Host {
+	mc := NewController(t)
+	db := database.NewMockIMultiValue(mc)
+	kv := make(map[string]string)
+	db.EXPECT().Get(Any(), Any()).AnyTimes().DoAndReturn(func(table, key string) (string, error) {
+		return kv[key], nil
+	})
+	db.EXPECT().Put(Any(), Any(), Any()).AnyTimes().DoAndReturn(func(table, key, value string) error {
+		kv[key] = value
+		return nil
+	})
+	vi := database.NewVisitor(100, db, version.NewRules(0))
+	ctx := host.NewContext(nil)
+	ctx.Set("contract_name", "Contractwasm")
+	ctx.GSet("gas_limit", gasLimit)
+	h := host.NewHost(ctx, vi, version.NewRules(0), nil, nil)
+	h.SetDeadline(time.Now().Add(time.Second))
+	return h
+}
+
+func TestVM_Validate(t *testing.T) {
+	vm := NewVM()
+	vm.Init()
+	if err := vm.Validate(testContract(testModule(), "echo", "spin")); err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Validate(testContract(testModule(), "missing")); err == nil || !strings.Contains(err.Error(), "not exported") {
+		t.Fatal(err)
+	}
+	if err := vm.Validate(testContract(testModule(), "sum")); err == nil || !strings.Contains(err.Error(), "[] -> []") {
+		t.Fatal(err)
+	}
+	float := cat([]byte{0x00, 0x61, 0x73, 0x6d, 1, 0, 0, 0}, section(1, vec([]byte{0x60, 1, typeF64, 0})))
+	if err := vm.Validate(testContract(float)); err == nil || !strings.Contains(err.Error(), ErrFloatForbidden.Error()) {
+		t.Fatal(err)
+	}
+	if err := vm.Validate(&contract.Contract{Code: "not base64", Info: &contract.Info{}}); err == nil {
+		t.Fatal("invalid base64 accepted")
+	}
+}
+
+func TestVM_LoadAndCall(t *testing.T) {
+	vm := NewVM()
+	vm.Init()
+	c := testContract(testModule(), "echo")
+
+	h := testHost(t, 1000000)
+	rtn, cost, err := vm.LoadAndCall(h, c, "echo", "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rtn[0].(string) != `["hello"]` {
+		t.Fatal(rtn)
+	}
+	if cost.CPU <= 0 {
+		t.Fatal(cost)
+	}
+	if v, _ := h.Get("k"); v != `["hello"]` {
+		t.Fatal(v)
+	}
+
+	h = testHost(t, 100000)
+	_, cost, err = vm.LoadAndCall(h, c, "spin")
+	if err != host.ErrOutOfGas || cost.CPU <= 100000 {
+		t.Fatal(err, cost)
+	}
+
+	h = testHost(t, 100000)
+	if _, _, err = vm.LoadAndCall(h, c, "div0"); err != ErrDivideByZero {
+		t.Fatal(err)
+	}
+
+	h = testHost(t, 100000)
+	h.SetDeadline(time.Now())
+	if _, _, err = vm.LoadAndCall(h, c, "echo", "x"); err != ErrExecutionKilled {
+		t.Fatal(err)
+	}
+}
+
+func TestInstance_Control(t *testing.T) {
+	m, err := Decode(testModule())
+	if err != nil {
+		t.Fatal(err)
+	}
+	in, err := instantiate(m, nil, 1000000, time.Now().Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := in.call("sum"); err != nil {
+		t.Fatal(err)
+	}
+	if v := in.pop(); v != 55 {
+		t.Fatal(v)
+	}
+	in.push(10)
+	if err := in.call("fact"); err != nil {
+		t.Fatal(err)
+	}
+	if v := in.pop(); v != 3628800 {
+		t.Fatal(v)
+	}
+	if len(in.stack) != 0 {
+		t.Fatal(in.stack)
+	}
+}